@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetOrderRateLimitService get the current order count usage of all intervals
+type GetOrderRateLimitService struct {
+	c *Client
+}
+
+// Do send Request
+func (s *GetOrderRateLimitService) Do(ctx context.Context, opts ...common.RequestOption) (res []*OrderRateLimit, err error) {
+	r := common.NewGetRequestSigned("/api/v3/rateLimit/order")
+	res = make([]*OrderRateLimit, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OrderRateLimit define an order rate limit and the orders counted against it
+type OrderRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// GetAccountCommissionService get the commission rates of a symbol for the account
+type GetAccountCommissionService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetAccountCommissionService) Symbol(symbol string) *GetAccountCommissionService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send Request
+func (s *GetAccountCommissionService) Do(ctx context.Context, opts ...common.RequestOption) (res *AccountCommission, err error) {
+	r := common.NewGetRequestSigned("/api/v3/account/commission")
+	r.SetQuery("symbol", s.symbol)
+	res = new(AccountCommission)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountCommission define the commission rates of a symbol. The rate paid on
+// a trade is the sum of the standard, tax and special rates, the standard
+// rate being reduced by the discount when it is paid in the discount asset.
+type AccountCommission struct {
+	Symbol             string             `json:"symbol"`
+	StandardCommission CommissionRates    `json:"standardCommission"`
+	TaxCommission      CommissionRates    `json:"taxCommission"`
+	SpecialCommission  CommissionRates    `json:"specialCommission"`
+	Discount           CommissionDiscount `json:"discount"`
+}
+
+// CommissionRates define the commission rates by role
+type CommissionRates struct {
+	Maker  string `json:"maker"`
+	Taker  string `json:"taker"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+}
+
+// CommissionDiscount define the discount applied when paying commissions in DiscountAsset
+type CommissionDiscount struct {
+	EnabledForAccount bool   `json:"enabledForAccount"`
+	EnabledForSymbol  bool   `json:"enabledForSymbol"`
+	DiscountAsset     string `json:"discountAsset"`
+	Discount          string `json:"discount"`
+}
+
+// ListPreventedMatchesService list the orders expired because of self-trade prevention
+type ListPreventedMatchesService struct {
+	c                    *Client
+	symbol               string
+	preventedMatchID     *int64
+	orderID              *int64
+	fromPreventedMatchID *int64
+	limit                *int
+}
+
+// Symbol set symbol
+func (s *ListPreventedMatchesService) Symbol(symbol string) *ListPreventedMatchesService {
+	s.symbol = symbol
+	return s
+}
+
+// PreventedMatchID set preventedMatchId
+func (s *ListPreventedMatchesService) PreventedMatchID(preventedMatchID int64) *ListPreventedMatchesService {
+	s.preventedMatchID = &preventedMatchID
+	return s
+}
+
+// OrderID set orderId
+func (s *ListPreventedMatchesService) OrderID(orderID int64) *ListPreventedMatchesService {
+	s.orderID = &orderID
+	return s
+}
+
+// FromPreventedMatchID set fromPreventedMatchId, only used along with OrderID
+func (s *ListPreventedMatchesService) FromPreventedMatchID(fromPreventedMatchID int64) *ListPreventedMatchesService {
+	s.fromPreventedMatchID = &fromPreventedMatchID
+	return s
+}
+
+// Limit set limit
+func (s *ListPreventedMatchesService) Limit(limit int) *ListPreventedMatchesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *ListPreventedMatchesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*PreventedMatch, err error) {
+	r := common.NewGetRequestSigned("/api/v3/myPreventedMatches")
+	r.SetQuery("symbol", s.symbol)
+	if s.preventedMatchID != nil {
+		r.SetQuery("preventedMatchId", *s.preventedMatchID)
+	}
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.fromPreventedMatchID != nil {
+		r.SetQuery("fromPreventedMatchId", *s.fromPreventedMatchID)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	res = make([]*PreventedMatch, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PreventedMatch define a match prevented by self-trade prevention
+type PreventedMatch struct {
+	Symbol                  string                      `json:"symbol"`
+	PreventedMatchID        int64                       `json:"preventedMatchId"`
+	TakerOrderID            int64                       `json:"takerOrderId"`
+	MakerSymbol             string                      `json:"makerSymbol"`
+	MakerOrderID            int64                       `json:"makerOrderId"`
+	TradeGroupID            int64                       `json:"tradeGroupId"`
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+	Price                   string                      `json:"price"`
+	MakerPreventedQuantity  string                      `json:"makerPreventedQuantity"`
+	TransactTime            int64                       `json:"transactTime"`
+}
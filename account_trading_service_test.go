@@ -0,0 +1,149 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountTradingServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountTradingService(t *testing.T) {
+	suite.Run(t, new(accountTradingServiceTestSuite))
+}
+
+func (s *accountTradingServiceTestSuite) TestGetOrderRateLimit() {
+	data := []byte(`[
+		{
+			"rateLimitType": "ORDERS",
+			"interval": "SECOND",
+			"intervalNum": 10,
+			"limit": 50,
+			"count": 3
+		},
+		{
+			"rateLimitType": "ORDERS",
+			"interval": "DAY",
+			"intervalNum": 1,
+			"limit": 160000,
+			"count": 1271
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	res, err := s.client.NewGetOrderRateLimitService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*OrderRateLimit{
+		{RateLimitType: "ORDERS", Interval: "SECOND", IntervalNum: 10, Limit: 50, Count: 3},
+		{RateLimitType: "ORDERS", Interval: "DAY", IntervalNum: 1, Limit: 160000, Count: 1271},
+	}, res)
+}
+
+func (s *accountTradingServiceTestSuite) TestGetAccountCommission() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"standardCommission": {
+			"maker": "0.00000010",
+			"taker": "0.00000020",
+			"buyer": "0.00000030",
+			"seller": "0.00000040"
+		},
+		"specialCommission": {
+			"maker": "0.01000000",
+			"taker": "0.02000000",
+			"buyer": "0.03000000",
+			"seller": "0.04000000"
+		},
+		"taxCommission": {
+			"maker": "0.00000112",
+			"taker": "0.00000114",
+			"buyer": "0.00000118",
+			"seller": "0.00000116"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.75000000"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountCommissionService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AccountCommission{
+		Symbol: "BTCUSDT",
+		StandardCommission: CommissionRates{
+			Maker: "0.00000010", Taker: "0.00000020", Buyer: "0.00000030", Seller: "0.00000040",
+		},
+		TaxCommission: CommissionRates{
+			Maker: "0.00000112", Taker: "0.00000114", Buyer: "0.00000118", Seller: "0.00000116",
+		},
+		SpecialCommission: CommissionRates{
+			Maker: "0.01000000", Taker: "0.02000000", Buyer: "0.03000000", Seller: "0.04000000",
+		},
+		Discount: CommissionDiscount{
+			EnabledForAccount: true,
+			EnabledForSymbol:  true,
+			DiscountAsset:     "BNB",
+			Discount:          "0.75000000",
+		},
+	}, res)
+}
+
+func (s *accountTradingServiceTestSuite) TestListPreventedMatches() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"preventedMatchId": 1,
+			"takerOrderId": 5,
+			"makerSymbol": "BTCUSDT",
+			"makerOrderId": 3,
+			"tradeGroupId": 1,
+			"selfTradePreventionMode": "EXPIRE_MAKER",
+			"price": "1.100000",
+			"makerPreventedQuantity": "1.300000",
+			"transactTime": 1669101687094
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":               "BTCUSDT",
+			"orderId":              5,
+			"fromPreventedMatchId": 1,
+			"limit":                10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListPreventedMatchesService().Symbol("BTCUSDT").OrderID(5).
+		FromPreventedMatchID(1).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*PreventedMatch{{
+		Symbol:                  "BTCUSDT",
+		PreventedMatchID:        1,
+		TakerOrderID:            5,
+		MakerSymbol:             "BTCUSDT",
+		MakerOrderID:            3,
+		TradeGroupID:            1,
+		SelfTradePreventionMode: SelfTradePreventionModeTypeExpireMaker,
+		Price:                   "1.100000",
+		MakerPreventedQuantity:  "1.300000",
+		TransactTime:            1669101687094,
+	}}, res)
+}
@@ -0,0 +1,440 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MarketDataAPI define the public market data operations of the spot API.
+type MarketDataAPI interface {
+	ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error)
+	Depth(ctx context.Context, symbol string, limit int, opts ...common.RequestOption) (*DepthResponse, error)
+	Klines(ctx context.Context, params KlinesParams, opts ...common.RequestOption) ([]*Kline, error)
+	ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*SymbolPrice, error)
+	ListBookTickers(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*BookTicker, error)
+	ExchangeInfo(ctx context.Context, symbols []string, opts ...common.RequestOption) (*ExchangeInfo, error)
+}
+
+// TradingAPI define the order operations of the spot API.
+type TradingAPI interface {
+	CreateOrder(ctx context.Context, params CreateOrderParams, opts ...common.RequestOption) (*CreateOrderResponse, error)
+	GetOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*Order, error)
+	CancelOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*CancelOrderResponse, error)
+	CancelOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) (*CancelOpenOrdersResponse, error)
+	ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*Order, error)
+	ListOrders(ctx context.Context, params ListOrdersParams, opts ...common.RequestOption) ([]*Order, error)
+}
+
+// AccountAPI define the account operations of the spot API.
+type AccountAPI interface {
+	GetAccount(ctx context.Context, opts ...common.RequestOption) (*Account, error)
+	ListTrades(ctx context.Context, params ListTradesParams, opts ...common.RequestOption) ([]*TradeV3, error)
+}
+
+// MarginAPI define the margin account operations of the spot API.
+type MarginAPI interface {
+	GetMarginAccount(ctx context.Context, opts ...common.RequestOption) (*MarginAccount, error)
+	GetIsolatedMarginAccount(ctx context.Context, symbols []string,
+		opts ...common.RequestOption) (*IsolatedMarginAccount, error)
+	CancelMarginOpenOrders(ctx context.Context, symbol string, isIsolated bool,
+		opts ...common.RequestOption) (*CancelOpenOrdersResponse, error)
+	MarginRepay(ctx context.Context, params MarginRepayParams, opts ...common.RequestOption) (*TransactionResponse, error)
+	MarginTransfer(ctx context.Context, params MarginTransferParams,
+		opts ...common.RequestOption) (*TransactionResponse, error)
+	IsolatedMarginTransfer(ctx context.Context, params IsolatedMarginTransferParams,
+		opts ...common.RequestOption) (*TransactionResponse, error)
+	ListMarginLoans(ctx context.Context, params MarginHistoryParams,
+		opts ...common.RequestOption) (*MarginLoanResponse, error)
+	ListMarginRepays(ctx context.Context, params MarginHistoryParams,
+		opts ...common.RequestOption) (*MarginRepayResponse, error)
+	ListMarginInterestHistory(ctx context.Context, params MarginHistoryParams,
+		opts ...common.RequestOption) (*MarginInterestHistory, error)
+}
+
+// API define all the operations above, it is implemented by *Client and can be
+// replaced by a mock in tests.
+type API interface {
+	MarketDataAPI
+	TradingAPI
+	AccountAPI
+	MarginAPI
+}
+
+var _ API = (*Client)(nil)
+
+// KlinesParams define the parameters of Klines, zero values are not sent.
+type KlinesParams struct {
+	Symbol    string
+	Interval  string
+	Limit     int
+	StartTime int64
+	EndTime   int64
+}
+
+// CreateOrderParams define the parameters of CreateOrder, zero values are not sent.
+type CreateOrderParams struct {
+	Symbol           string
+	Side             SideType
+	Type             OrderType
+	TimeInForce      TimeInForceType
+	Quantity         string
+	QuoteOrderQty    string
+	Price            string
+	NewClientOrderID string
+	StopPrice        string
+	IcebergQuantity  string
+	NewOrderRespType NewOrderRespType
+}
+
+// OrderQuery identify an order by OrderID or OrigClientOrderID.
+type OrderQuery struct {
+	Symbol            string
+	OrderID           int64
+	OrigClientOrderID string
+}
+
+// ListOrdersParams define the parameters of ListOrders, zero values are not sent.
+type ListOrdersParams struct {
+	Symbol    string
+	OrderID   int64
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// ListTradesParams define the parameters of ListTrades, zero values are not sent.
+type ListTradesParams struct {
+	Symbol    string
+	FromID    int64
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// MarginRepayParams define the parameters of MarginRepay, IsolatedSymbol is
+// empty for the cross margin account.
+type MarginRepayParams struct {
+	Asset          string
+	Amount         string
+	IsolatedSymbol string
+}
+
+// MarginTransferParams define the parameters of MarginTransfer.
+type MarginTransferParams struct {
+	Asset  string
+	Amount string
+	Type   MarginTransferType
+}
+
+// IsolatedMarginTransferParams define the parameters of IsolatedMarginTransfer.
+type IsolatedMarginTransferParams struct {
+	Asset     string
+	Symbol    string
+	TransFrom IsolatedMarginAccountType
+	TransTo   IsolatedMarginAccountType
+	Amount    string
+}
+
+// MarginHistoryParams define the parameters of the margin history lists, zero
+// values are not sent. Asset is mandatory for the loans and the repays.
+type MarginHistoryParams struct {
+	Asset          string
+	IsolatedSymbol string
+	StartTime      int64
+	EndTime        int64
+	Current        int64
+	Size           int64
+}
+
+// ServerTime get server time
+func (c *Client) ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error) {
+	return c.NewServerTimeService().Do(ctx, opts...)
+}
+
+// Depth get order book
+func (c *Client) Depth(ctx context.Context, symbol string, limit int,
+	opts ...common.RequestOption,
+) (*DepthResponse, error) {
+	s := c.NewDepthService().Symbol(symbol)
+	if limit > 0 {
+		s.Limit(limit)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// Klines list klines
+func (c *Client) Klines(ctx context.Context, params KlinesParams, opts ...common.RequestOption) ([]*Kline, error) {
+	s := c.NewKlinesService().Symbol(params.Symbol).Interval(params.Interval)
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListPrices list latest prices of symbol, or of all symbols when empty
+func (c *Client) ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*SymbolPrice, error) {
+	s := c.NewListPricesService()
+	if symbol != "" {
+		s.Symbol(symbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListBookTickers list best price and quantity of symbol, or of all symbols when empty
+func (c *Client) ListBookTickers(ctx context.Context, symbol string,
+	opts ...common.RequestOption,
+) ([]*BookTicker, error) {
+	s := c.NewListBookTickersService()
+	if symbol != "" {
+		s.Symbol(symbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ExchangeInfo get exchange info of symbols, or of all symbols when empty
+func (c *Client) ExchangeInfo(ctx context.Context, symbols []string,
+	opts ...common.RequestOption,
+) (*ExchangeInfo, error) {
+	s := c.NewExchangeInfoService()
+	if len(symbols) > 0 {
+		s.Symbols(symbols...)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// CreateOrder create order
+func (c *Client) CreateOrder(ctx context.Context, params CreateOrderParams,
+	opts ...common.RequestOption,
+) (*CreateOrderResponse, error) {
+	s := c.NewCreateOrderService().Symbol(params.Symbol).Side(params.Side).Type(params.Type)
+	if params.TimeInForce != "" {
+		s.TimeInForce(params.TimeInForce)
+	}
+	if params.Quantity != "" {
+		s.Quantity(params.Quantity)
+	}
+	if params.QuoteOrderQty != "" {
+		s.QuoteOrderQty(params.QuoteOrderQty)
+	}
+	if params.Price != "" {
+		s.Price(params.Price)
+	}
+	if params.NewClientOrderID != "" {
+		s.NewClientOrderID(params.NewClientOrderID)
+	}
+	if params.StopPrice != "" {
+		s.StopPrice(params.StopPrice)
+	}
+	if params.IcebergQuantity != "" {
+		s.IcebergQuantity(params.IcebergQuantity)
+	}
+	if params.NewOrderRespType != "" {
+		s.NewOrderRespType(params.NewOrderRespType)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// GetOrder get an order
+func (c *Client) GetOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*Order, error) {
+	s := c.NewGetOrderService().Symbol(query.Symbol)
+	if query.OrderID != 0 {
+		s.OrderID(query.OrderID)
+	}
+	if query.OrigClientOrderID != "" {
+		s.OrigClientOrderID(query.OrigClientOrderID)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// CancelOrder cancel an order
+func (c *Client) CancelOrder(ctx context.Context, query OrderQuery,
+	opts ...common.RequestOption,
+) (*CancelOrderResponse, error) {
+	s := c.NewCancelOrderService().Symbol(query.Symbol)
+	if query.OrderID != 0 {
+		s.OrderID(query.OrderID)
+	}
+	if query.OrigClientOrderID != "" {
+		s.OrigClientOrderID(query.OrigClientOrderID)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// CancelOpenOrders cancel all open orders of symbol
+func (c *Client) CancelOpenOrders(ctx context.Context, symbol string,
+	opts ...common.RequestOption,
+) (*CancelOpenOrdersResponse, error) {
+	return c.NewCancelOpenOrdersService().Symbol(symbol).Do(ctx, opts...)
+}
+
+// ListOpenOrders list open orders of symbol, or of all symbols when empty
+func (c *Client) ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*Order, error) {
+	return c.NewListOpenOrdersService().Symbol(symbol).Do(ctx, opts...)
+}
+
+// ListOrders list all orders of a symbol
+func (c *Client) ListOrders(ctx context.Context, params ListOrdersParams, opts ...common.RequestOption) ([]*Order, error) {
+	s := c.NewListOrdersService().Symbol(params.Symbol)
+	if params.OrderID != 0 {
+		s.OrderID(params.OrderID)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// GetAccount get account info
+func (c *Client) GetAccount(ctx context.Context, opts ...common.RequestOption) (*Account, error) {
+	return c.NewGetAccountService().Do(ctx, opts...)
+}
+
+// ListTrades list account trades of a symbol
+func (c *Client) ListTrades(ctx context.Context, params ListTradesParams,
+	opts ...common.RequestOption,
+) ([]*TradeV3, error) {
+	s := c.NewListTradesService().Symbol(params.Symbol)
+	if params.FromID != 0 {
+		s.FromID(params.FromID)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// GetMarginAccount get cross margin account info
+func (c *Client) GetMarginAccount(ctx context.Context, opts ...common.RequestOption) (*MarginAccount, error) {
+	return c.NewGetMarginAccountService().Do(ctx, opts...)
+}
+
+// GetIsolatedMarginAccount get isolated margin account info of symbols, or of all symbols when empty
+func (c *Client) GetIsolatedMarginAccount(ctx context.Context, symbols []string,
+	opts ...common.RequestOption,
+) (*IsolatedMarginAccount, error) {
+	return c.NewGetIsolatedMarginAccountService().Symbols(symbols...).Do(ctx, opts...)
+}
+
+// CancelMarginOpenOrders cancel all open orders of symbol in the cross or isolated margin account
+func (c *Client) CancelMarginOpenOrders(ctx context.Context, symbol string, isIsolated bool,
+	opts ...common.RequestOption,
+) (*CancelOpenOrdersResponse, error) {
+	return c.NewCancelMarginOpenOrdersService().Symbol(symbol).IsIsolated(isIsolated).Do(ctx, opts...)
+}
+
+// MarginRepay repay a margin loan
+func (c *Client) MarginRepay(ctx context.Context, params MarginRepayParams,
+	opts ...common.RequestOption,
+) (*TransactionResponse, error) {
+	s := c.NewMarginRepayService().Asset(params.Asset).Amount(params.Amount)
+	if params.IsolatedSymbol != "" {
+		s.IsolatedSymbol(params.IsolatedSymbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// MarginTransfer transfer between the spot and the cross margin account
+func (c *Client) MarginTransfer(ctx context.Context, params MarginTransferParams,
+	opts ...common.RequestOption,
+) (*TransactionResponse, error) {
+	return c.NewMarginTransferService().Asset(params.Asset).Amount(params.Amount).Type(params.Type).Do(ctx, opts...)
+}
+
+// IsolatedMarginTransfer transfer between the spot and an isolated margin account
+func (c *Client) IsolatedMarginTransfer(ctx context.Context, params IsolatedMarginTransferParams,
+	opts ...common.RequestOption,
+) (*TransactionResponse, error) {
+	return c.NewIsolatedMarginTransferService().Asset(params.Asset).Symbol(params.Symbol).
+		TransFrom(params.TransFrom).TransTo(params.TransTo).Amount(params.Amount).Do(ctx, opts...)
+}
+
+// ListMarginLoans list the loans of an asset
+func (c *Client) ListMarginLoans(ctx context.Context, params MarginHistoryParams,
+	opts ...common.RequestOption,
+) (*MarginLoanResponse, error) {
+	s := c.NewListMarginLoansService().Asset(params.Asset)
+	if params.IsolatedSymbol != "" {
+		s.IsolatedSymbol(params.IsolatedSymbol)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Current > 0 {
+		s.Current(params.Current)
+	}
+	if params.Size > 0 {
+		s.Size(params.Size)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListMarginRepays list the repayments of an asset
+func (c *Client) ListMarginRepays(ctx context.Context, params MarginHistoryParams,
+	opts ...common.RequestOption,
+) (*MarginRepayResponse, error) {
+	s := c.NewListMarginRepaysService().Asset(params.Asset)
+	if params.IsolatedSymbol != "" {
+		s.IsolatedSymbol(params.IsolatedSymbol)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Current > 0 {
+		s.Current(params.Current)
+	}
+	if params.Size > 0 {
+		s.Size(params.Size)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListMarginInterestHistory list the interests charged, of all assets when Asset is empty
+func (c *Client) ListMarginInterestHistory(ctx context.Context, params MarginHistoryParams,
+	opts ...common.RequestOption,
+) (*MarginInterestHistory, error) {
+	s := c.NewListMarginInterestHistoryService()
+	if params.Asset != "" {
+		s.Asset(params.Asset)
+	}
+	if params.IsolatedSymbol != "" {
+		s.IsolatedSymbol(params.IsolatedSymbol)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Current > 0 {
+		s.Current(params.Current)
+	}
+	if params.Size > 0 {
+		s.Size(params.Size)
+	}
+	return s.Do(ctx, opts...)
+}
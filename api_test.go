@@ -0,0 +1,148 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type apiTestSuite struct {
+	baseTestSuite
+}
+
+func TestAPI(t *testing.T) {
+	suite.Run(t, new(apiTestSuite))
+}
+
+func (s *apiTestSuite) TestCreateOrder() {
+	data := []byte(`{"symbol":"LTCBTC","orderId":1,"status":"NEW"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":        "LTCBTC",
+			"side":          SideTypeBuy,
+			"type":          OrderTypeMarket,
+			"quoteOrderQty": "10",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.CreateOrder(newContext(), CreateOrderParams{
+		Symbol:        "LTCBTC",
+		Side:          SideTypeBuy,
+		Type:          OrderTypeMarket,
+		QuoteOrderQty: "10",
+	})
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.OrderID)
+	s.r().Equal(OrderStatusTypeNew, res.Status)
+}
+
+func (s *apiTestSuite) TestGetOrder() {
+	data := []byte(`{"symbol":"LTCBTC","orderId":1,"clientOrderId":"myOrder1"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":            "LTCBTC",
+			"origClientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.GetOrder(newContext(), OrderQuery{Symbol: "LTCBTC", OrigClientOrderID: "myOrder1"})
+	s.r().NoError(err)
+	s.r().Equal("myOrder1", res.ClientOrderID)
+}
+
+func (s *apiTestSuite) TestKlines() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(common.Params{
+			"symbol":    "LTCBTC",
+			"interval":  "15m",
+			"startTime": 1499040000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.Klines(newContext(), KlinesParams{
+		Symbol: "LTCBTC", Interval: "15m", StartTime: 1499040000000,
+	})
+	s.r().NoError(err)
+	s.r().Empty(res)
+}
+
+func (s *apiTestSuite) TestListTrades() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol": "LTCBTC",
+			"fromId": 100,
+			"limit":  5,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.ListTrades(newContext(), ListTradesParams{Symbol: "LTCBTC", FromID: 100, Limit: 5})
+	s.r().NoError(err)
+}
+
+func (s *apiTestSuite) TestGetIsolatedMarginAccount() {
+	data := []byte(`{"assets":[{"symbol":"BTCUSDT","baseAsset":{"asset":"BTC","free":"1"}}]}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbols": "BTCUSDT,ETHUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.GetIsolatedMarginAccount(newContext(), []string{"BTCUSDT", "ETHUSDT"})
+	s.r().NoError(err)
+	s.r().Len(res.Assets, 1)
+	s.r().Equal("1", res.Assets[0].BaseAsset.Free)
+}
+
+func (s *apiTestSuite) TestMarginRepay() {
+	data := []byte(`{"tranId":100000001}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"asset":  "BTC",
+			"amount": "0.5",
+		}).SetQuery("isolatedSymbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.MarginRepay(newContext(), MarginRepayParams{
+		Asset: "BTC", Amount: "0.5", IsolatedSymbol: "BTCUSDT",
+	})
+	s.r().NoError(err)
+	s.r().Equal(int64(100000001), res.TranID)
+}
+
+func (s *apiTestSuite) TestListMarginRepays() {
+	data := []byte(`{"rows":[{"isolatedSymbol":"BNBUSDT","asset":"BNB","amount":"1.1","txId":3}],"total":1}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":          "BNB",
+			"isolatedSymbol": "BNBUSDT",
+			"startTime":      1555056425000,
+			"size":           100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.ListMarginRepays(newContext(), MarginHistoryParams{
+		Asset: "BNB", IsolatedSymbol: "BNBUSDT", StartTime: 1555056425000, Size: 100,
+	})
+	s.r().NoError(err)
+	s.r().Len(res.Rows, 1)
+	s.r().Equal("BNBUSDT", res.Rows[0].IsolatedSymbol)
+	s.r().Equal(int64(3), res.Rows[0].TxID)
+}
@@ -0,0 +1,240 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// CancelReplaceOrderService cancel an order and place a new one on the same symbol
+type CancelReplaceOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	cancelReplaceMode       CancelReplaceModeType
+	timeInForce             *TimeInForceType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	cancelNewClientOrderID  *string
+	cancelOrigClientOrderID *string
+	cancelOrderID           *int64
+	newClientOrderID        *string
+	stopPrice               *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	stpMode                 *SelfTradePreventionModeType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceOrderService) Symbol(symbol string) *CancelReplaceOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side of the new order
+func (s *CancelReplaceOrderService) Side(side SideType) *CancelReplaceOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type of the new order
+func (s *CancelReplaceOrderService) Type(orderType OrderType) *CancelReplaceOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *CancelReplaceOrderService) CancelReplaceMode(mode CancelReplaceModeType) *CancelReplaceOrderService {
+	s.cancelReplaceMode = mode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceOrderService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceOrderService) Quantity(quantity string) *CancelReplaceOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceOrderService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceOrderService) Price(price string) *CancelReplaceOrderService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId, the client order id of the cancel
+func (s *CancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId, the client order id of the canceled order
+func (s *CancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId, the id of the canceled order
+func (s *CancelReplaceOrderService) CancelOrderID(cancelOrderID int64) *CancelReplaceOrderService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId of the new order
+func (s *CancelReplaceOrderService) NewClientOrderID(newClientOrderID string) *CancelReplaceOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceOrderService) StopPrice(stopPrice string) *CancelReplaceOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CancelReplaceOrderService) IcebergQuantity(icebergQuantity string) *CancelReplaceOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode of the new order
+func (s *CancelReplaceOrderService) SelfTradePreventionMode(mode SelfTradePreventionModeType) *CancelReplaceOrderService {
+	s.stpMode = &mode
+	return s
+}
+
+// Do send Request. When a step fails the *common.APIError is returned together
+// with the response, which reports the result of each step; the response is
+// nil only when the request itself failed.
+func (s *CancelReplaceOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *CancelReplaceOrderResponse, err error) {
+	r := common.NewPostRequestSigned("/api/v3/order/cancelReplace")
+	m := common.Params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.stpMode != nil {
+		m["selfTradePreventionMode"] = *s.stpMode
+	}
+	r.SetFormParams(m)
+
+	res = new(CancelReplaceOrderResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		var apiErr *common.APIError
+		if errors.As(err, &apiErr) && len(apiErr.Data) > 0 {
+			if e := json.Unmarshal(apiErr.Data, res); e == nil {
+				return res, err
+			}
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceOrderResponse define cancel replace order response. The
+// response of a failed step is nil and its error is set instead.
+type CancelReplaceOrderResponse struct {
+	CancelResult     CancelReplaceResultType
+	NewOrderResult   CancelReplaceResultType
+	CancelResponse   *CancelOrderResponse
+	CancelError      *common.APIError
+	NewOrderResponse *CreateOrderResponse
+	NewOrderError    *common.APIError
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (r *CancelReplaceOrderResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		CancelResult     CancelReplaceResultType `json:"cancelResult"`
+		NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+		CancelResponse   json.RawMessage         `json:"cancelResponse"`
+		NewOrderResponse json.RawMessage         `json:"newOrderResponse"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.CancelResult = raw.CancelResult
+	r.NewOrderResult = raw.NewOrderResult
+
+	var err error
+	if r.CancelError, err = decodeCancelReplaceStep(raw.CancelResponse, &r.CancelResponse); err != nil {
+		return err
+	}
+	r.NewOrderError, err = decodeCancelReplaceStep(raw.NewOrderResponse, &r.NewOrderResponse)
+	return err
+}
+
+// decodeCancelReplaceStep decodes the response of a cancel-replace step into v,
+// or returns the error the step failed with.
+func decodeCancelReplaceStep(data json.RawMessage, v interface{}) (*common.APIError, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var e struct {
+		Code    int64  `json:"code"`
+		Message string `json:"msg"`
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	if e.Code != 0 {
+		return &common.APIError{Code: e.Code, Message: e.Message}, nil
+	}
+	return nil, json.Unmarshal(data, v)
+}
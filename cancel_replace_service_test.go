@@ -0,0 +1,170 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type cancelReplaceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCancelReplaceService(t *testing.T) {
+	suite.Run(t, new(cancelReplaceServiceTestSuite))
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplace() {
+	data := []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"orderId": 9,
+			"orderListId": -1,
+			"clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+			"transactTime": 1684804350068,
+			"price": "0.01000000",
+			"origQty": "0.000100",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT",
+			"orderId": 10,
+			"orderListId": -1,
+			"clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803,
+			"price": "0.02000000",
+			"origQty": "0.040000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"fills": []
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":            "BTCUSDT",
+			"side":              SideTypeBuy,
+			"type":              OrderTypeLimit,
+			"cancelReplaceMode": CancelReplaceModeTypeStopOnFailure,
+			"timeInForce":       TimeInForceTypeGTC,
+			"quantity":          "0.04",
+			"price":             "0.02",
+			"cancelOrderId":     9,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.04").Price("0.02").CancelOrderID(9).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeSuccess, res.NewOrderResult)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderError)
+	r.Equal(int64(9), res.CancelResponse.OrderID)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Equal(int64(10), res.NewOrderResponse.OrderID)
+	r.Equal(OrderStatusTypeNew, res.NewOrderResponse.Status)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplacePartialFailure() {
+	data := []byte(`{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "FAILURE",
+			"newOrderResult": "SUCCESS",
+			"cancelResponse": {
+				"code": -2011,
+				"msg": "Unknown order sent."
+			},
+			"newOrderResponse": {
+				"symbol": "BTCUSDT",
+				"orderId": 11,
+				"orderListId": -1,
+				"clientOrderId": "pfojJMg6IMNDKuJqDxvoxN",
+				"transactTime": 1648540168818,
+				"price": "0.02000000",
+				"origQty": "0.040000",
+				"status": "NEW",
+				"type": "LIMIT",
+				"side": "BUY"
+			}
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeTypeAllowFailure).
+		CancelOrigClientOrderID("gone").Quantity("0.04").Price("0.02").Do(newContext())
+	r := s.r()
+	var apiErr *common.APIError
+	r.True(errors.As(err, &apiErr))
+	r.Equal(int64(-2021), apiErr.Code)
+	r.Equal(http.StatusConflict, apiErr.Status)
+	r.NotNil(res)
+	r.Equal(CancelReplaceResultTypeFailure, res.CancelResult)
+	r.Nil(res.CancelResponse)
+	r.Equal(&common.APIError{Code: -2011, Message: "Unknown order sent."}, res.CancelError)
+	r.Equal(CancelReplaceResultTypeSuccess, res.NewOrderResult)
+	r.Nil(res.NewOrderError)
+	r.Equal(int64(11), res.NewOrderResponse.OrderID)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplaceFailure() {
+	data := []byte(`{
+		"code": -2022,
+		"msg": "Order cancel-replace failed.",
+		"data": {
+			"cancelResult": "FAILURE",
+			"newOrderResult": "NOT_ATTEMPTED",
+			"cancelResponse": {
+				"code": -2011,
+				"msg": "Unknown order sent."
+			},
+			"newOrderResponse": null
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).CancelOrderID(1).
+		Quantity("1").Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.Equal(CancelReplaceResultTypeNotAttempted, res.NewOrderResult)
+	r.Nil(res.NewOrderResponse)
+	r.Nil(res.NewOrderError)
+	r.Equal(int64(-2011), res.CancelError.Code)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplaceRequestError() {
+	s.mockDo([]byte(`{"code": -1102, "msg": "Mandatory parameter 'cancelReplaceMode' was not sent."}`),
+		nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Do(newContext())
+	s.r().Error(err)
+	s.r().Nil(res)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/crypto-zero/go-binance/v2/common"
 	"github.com/crypto-zero/go-binance/v2/delivery"
 	"github.com/crypto-zero/go-binance/v2/futures"
+	"github.com/crypto-zero/go-binance/v2/options"
+	"github.com/crypto-zero/go-binance/v2/portfoliomargin"
 )
 
 // SideType define side type of order
@@ -27,6 +29,12 @@ type NewOrderRespType string
 // OrderStatusType define order status type
 type OrderStatusType string
 
+// OrderExecutionType define order execution type
+type OrderExecutionType string
+
+// UserDataEventType define user data stream event type
+type UserDataEventType string
+
 // SymbolType define symbol type
 type SymbolType string
 
@@ -54,6 +62,48 @@ type SideEffectType string
 // FuturesTransferType define futures transfer type
 type FuturesTransferType int
 
+// IsolatedMarginAccountType define the accounts of an isolated margin transfer
+type IsolatedMarginAccountType string
+
+// UniversalTransferType define the direction of a universal transfer, from
+// the account before the underscore to the one after it
+type UniversalTransferType string
+
+// UniversalTransferStatusType define universal transfer status type
+type UniversalTransferStatusType string
+
+// SubAccountAccountType define the account types of a sub-account transfer
+type SubAccountAccountType string
+
+// SubAccountFuturesType define the futures type of a sub-account summary
+type SubAccountFuturesType int
+
+// SimpleEarnAccountType define the account a simple earn product is
+// subscribed from or redeemed to
+type SimpleEarnAccountType string
+
+// SimpleEarnRewardType define simple earn flexible reward type
+type SimpleEarnRewardType string
+
+// ConvertWalletType define the wallet a conversion is paid from
+type ConvertWalletType string
+
+// ConvertValidTimeType define how long a convert quote can be accepted
+type ConvertValidTimeType string
+
+// ConvertOrderStatusType define convert order status type
+type ConvertOrderStatusType string
+
+// SelfTradePreventionModeType define what happens when an order would match an
+// order of the same trade group
+type SelfTradePreventionModeType string
+
+// CancelReplaceModeType define what cancel-replace does when the cancel fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the result of each step of a cancel-replace
+type CancelReplaceResultType string
+
 // Endpoints
 const (
 	baseAPIMainURL    = "https://api.binance.com"
@@ -91,6 +141,19 @@ const (
 	OrderStatusTypePendingCancel   OrderStatusType = "PENDING_CANCEL"
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	OrderExecutionTypeNew             OrderExecutionType = "NEW"
+	OrderExecutionTypeCanceled        OrderExecutionType = "CANCELED"
+	OrderExecutionTypeReplaced        OrderExecutionType = "REPLACED"
+	OrderExecutionTypeRejected        OrderExecutionType = "REJECTED"
+	OrderExecutionTypeTrade           OrderExecutionType = "TRADE"
+	OrderExecutionTypeExpired         OrderExecutionType = "EXPIRED"
+	OrderExecutionTypeTradePrevention OrderExecutionType = "TRADE_PREVENTION"
+
+	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
+	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
+	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
 
 	SymbolTypeSpot SymbolType = "SPOT"
 
@@ -134,6 +197,89 @@ const (
 	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
 	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
 
+	IsolatedMarginAccountTypeSpot           IsolatedMarginAccountType = "SPOT"
+	IsolatedMarginAccountTypeIsolatedMargin IsolatedMarginAccountType = "ISOLATED_MARGIN"
+
+	UniversalTransferTypeMainUMFuture                 UniversalTransferType = "MAIN_UMFUTURE"
+	UniversalTransferTypeMainCMFuture                 UniversalTransferType = "MAIN_CMFUTURE"
+	UniversalTransferTypeMainMargin                   UniversalTransferType = "MAIN_MARGIN"
+	UniversalTransferTypeUMFutureMain                 UniversalTransferType = "UMFUTURE_MAIN"
+	UniversalTransferTypeUMFutureMargin               UniversalTransferType = "UMFUTURE_MARGIN"
+	UniversalTransferTypeCMFutureMain                 UniversalTransferType = "CMFUTURE_MAIN"
+	UniversalTransferTypeCMFutureMargin               UniversalTransferType = "CMFUTURE_MARGIN"
+	UniversalTransferTypeMarginMain                   UniversalTransferType = "MARGIN_MAIN"
+	UniversalTransferTypeMarginUMFuture               UniversalTransferType = "MARGIN_UMFUTURE"
+	UniversalTransferTypeMarginCMFuture               UniversalTransferType = "MARGIN_CMFUTURE"
+	UniversalTransferTypeIsolatedMarginMargin         UniversalTransferType = "ISOLATEDMARGIN_MARGIN"
+	UniversalTransferTypeMarginIsolatedMargin         UniversalTransferType = "MARGIN_ISOLATEDMARGIN"
+	UniversalTransferTypeIsolatedMarginIsolatedMargin UniversalTransferType = "ISOLATEDMARGIN_ISOLATEDMARGIN"
+	UniversalTransferTypeMainFunding                  UniversalTransferType = "MAIN_FUNDING"
+	UniversalTransferTypeFundingMain                  UniversalTransferType = "FUNDING_MAIN"
+	UniversalTransferTypeFundingUMFuture              UniversalTransferType = "FUNDING_UMFUTURE"
+	UniversalTransferTypeUMFutureFunding              UniversalTransferType = "UMFUTURE_FUNDING"
+	UniversalTransferTypeMarginFunding                UniversalTransferType = "MARGIN_FUNDING"
+	UniversalTransferTypeFundingMargin                UniversalTransferType = "FUNDING_MARGIN"
+	UniversalTransferTypeFundingCMFuture              UniversalTransferType = "FUNDING_CMFUTURE"
+	UniversalTransferTypeCMFutureFunding              UniversalTransferType = "CMFUTURE_FUNDING"
+	UniversalTransferTypeMainOption                   UniversalTransferType = "MAIN_OPTION"
+	UniversalTransferTypeOptionMain                   UniversalTransferType = "OPTION_MAIN"
+	UniversalTransferTypeUMFutureOption               UniversalTransferType = "UMFUTURE_OPTION"
+	UniversalTransferTypeOptionUMFuture               UniversalTransferType = "OPTION_UMFUTURE"
+	UniversalTransferTypeMarginOption                 UniversalTransferType = "MARGIN_OPTION"
+	UniversalTransferTypeOptionMargin                 UniversalTransferType = "OPTION_MARGIN"
+	UniversalTransferTypeFundingOption                UniversalTransferType = "FUNDING_OPTION"
+	UniversalTransferTypeOptionFunding                UniversalTransferType = "OPTION_FUNDING"
+	UniversalTransferTypeMainPortfolioMargin          UniversalTransferType = "MAIN_PORTFOLIO_MARGIN"
+	UniversalTransferTypePortfolioMarginMain          UniversalTransferType = "PORTFOLIO_MARGIN_MAIN"
+
+	SubAccountAccountTypeSpot           SubAccountAccountType = "SPOT"
+	SubAccountAccountTypeUSDTFuture     SubAccountAccountType = "USDT_FUTURE"
+	SubAccountAccountTypeCoinFuture     SubAccountAccountType = "COIN_FUTURE"
+	SubAccountAccountTypeMargin         SubAccountAccountType = "MARGIN"
+	SubAccountAccountTypeIsolatedMargin SubAccountAccountType = "ISOLATED_MARGIN"
+
+	SubAccountFuturesTypeUSD  SubAccountFuturesType = 1
+	SubAccountFuturesTypeCoin SubAccountFuturesType = 2
+
+	SimpleEarnAccountTypeSpot SimpleEarnAccountType = "SPOT"
+	SimpleEarnAccountTypeFund SimpleEarnAccountType = "FUND"
+	SimpleEarnAccountTypeAll  SimpleEarnAccountType = "ALL"
+
+	SimpleEarnRewardTypeBonus    SimpleEarnRewardType = "BONUS"
+	SimpleEarnRewardTypeRealTime SimpleEarnRewardType = "REALTIME"
+	SimpleEarnRewardTypeRewards  SimpleEarnRewardType = "REWARDS"
+
+	ConvertWalletTypeSpot        ConvertWalletType = "SPOT"
+	ConvertWalletTypeFunding     ConvertWalletType = "FUNDING"
+	ConvertWalletTypeSpotFunding ConvertWalletType = "SPOT_FUNDING"
+
+	ConvertValidTimeType10s ConvertValidTimeType = "10s"
+	ConvertValidTimeType30s ConvertValidTimeType = "30s"
+	ConvertValidTimeType1m  ConvertValidTimeType = "1m"
+	ConvertValidTimeType2m  ConvertValidTimeType = "2m"
+
+	ConvertOrderStatusTypeProcess       ConvertOrderStatusType = "PROCESS"
+	ConvertOrderStatusTypeAcceptSuccess ConvertOrderStatusType = "ACCEPT_SUCCESS"
+	ConvertOrderStatusTypeSuccess       ConvertOrderStatusType = "SUCCESS"
+	ConvertOrderStatusTypeFail          ConvertOrderStatusType = "FAIL"
+
+	UniversalTransferStatusTypePending   UniversalTransferStatusType = "PENDING"
+	UniversalTransferStatusTypeConfirmed UniversalTransferStatusType = "CONFIRMED"
+	UniversalTransferStatusTypeFailed    UniversalTransferStatusType = "FAILED"
+
+	SelfTradePreventionModeTypeNone        SelfTradePreventionModeType = "NONE"
+	SelfTradePreventionModeTypeExpireTaker SelfTradePreventionModeType = "EXPIRE_TAKER"
+	SelfTradePreventionModeTypeExpireMaker SelfTradePreventionModeType = "EXPIRE_MAKER"
+	SelfTradePreventionModeTypeExpireBoth  SelfTradePreventionModeType = "EXPIRE_BOTH"
+	SelfTradePreventionModeTypeDecrement   SelfTradePreventionModeType = "DECREMENT"
+
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
 	timestampKey  = "timestamp"
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
@@ -186,6 +332,16 @@ func NewDeliveryClient(apiKey, secretKey string, testnet bool) *delivery.Client
 	return delivery.NewClient(apiKey, secretKey, testnet)
 }
 
+// NewOptionsClient initialize client for European options API
+func NewOptionsClient(apiKey, secretKey string) *options.Client {
+	return options.NewClient(apiKey, secretKey)
+}
+
+// NewPortfolioMarginClient initialize client for portfolio margin API
+func NewPortfolioMarginClient(apiKey, secretKey string) *portfoliomargin.Client {
+	return portfoliomargin.NewClient(apiKey, secretKey)
+}
+
 type doFunc func(req *http.Request) (*http.Response, error)
 
 // Client define API client
@@ -258,6 +414,36 @@ func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
 }
 
+// NewGetOrderRateLimitService init get order rate limit service
+func (c *Client) NewGetOrderRateLimitService() *GetOrderRateLimitService {
+	return &GetOrderRateLimitService{c: c}
+}
+
+// NewGetAccountCommissionService init get account commission service
+func (c *Client) NewGetAccountCommissionService() *GetAccountCommissionService {
+	return &GetAccountCommissionService{c: c}
+}
+
+// NewListPreventedMatchesService init list prevented matches service
+func (c *Client) NewListPreventedMatchesService() *ListPreventedMatchesService {
+	return &ListPreventedMatchesService{c: c}
+}
+
+// NewCancelReplaceOrderService init cancel replace order service
+func (c *Client) NewCancelReplaceOrderService() *CancelReplaceOrderService {
+	return &CancelReplaceOrderService{c: c}
+}
+
+// NewCreateOTOService init creating OTO order list service
+func (c *Client) NewCreateOTOService() *CreateOTOService {
+	return &CreateOTOService{c: c}
+}
+
+// NewCreateOTOCOService init creating OTOCO order list service
+func (c *Client) NewCreateOTOCOService() *CreateOTOCOService {
+	return &CreateOTOCOService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -353,6 +539,126 @@ func (c *Client) NewGetFundingAssetService() *GetFundingAssetService {
 	return &GetFundingAssetService{c: c}
 }
 
+// NewGetAllCoinsInfoService init get all coins info service
+func (c *Client) NewGetAllCoinsInfoService() *GetAllCoinsInfoService {
+	return &GetAllCoinsInfoService{c: c}
+}
+
+// NewGetTradeFeeService init get trade fee service
+func (c *Client) NewGetTradeFeeService() *GetTradeFeeService {
+	return &GetTradeFeeService{c: c}
+}
+
+// NewGetSystemStatusService init get system status service
+func (c *Client) NewGetSystemStatusService() *GetSystemStatusService {
+	return &GetSystemStatusService{c: c}
+}
+
+// NewGetAccountStatusService init get account status service
+func (c *Client) NewGetAccountStatusService() *GetAccountStatusService {
+	return &GetAccountStatusService{c: c}
+}
+
+// NewGetUserAssetService init get user asset service
+func (c *Client) NewGetUserAssetService() *GetUserAssetService {
+	return &GetUserAssetService{c: c}
+}
+
+// NewListAssetDividendService init list asset dividend service
+func (c *Client) NewListAssetDividendService() *ListAssetDividendService {
+	return &ListAssetDividendService{c: c}
+}
+
+// NewListSimpleEarnFlexibleProductsService init list simple earn flexible products service
+func (c *Client) NewListSimpleEarnFlexibleProductsService() *ListSimpleEarnFlexibleProductsService {
+	return &ListSimpleEarnFlexibleProductsService{c: c}
+}
+
+// NewSubscribeSimpleEarnFlexibleService init subscribe simple earn flexible service
+func (c *Client) NewSubscribeSimpleEarnFlexibleService() *SubscribeSimpleEarnFlexibleService {
+	return &SubscribeSimpleEarnFlexibleService{c: c}
+}
+
+// NewRedeemSimpleEarnFlexibleService init redeem simple earn flexible service
+func (c *Client) NewRedeemSimpleEarnFlexibleService() *RedeemSimpleEarnFlexibleService {
+	return &RedeemSimpleEarnFlexibleService{c: c}
+}
+
+// NewListSimpleEarnFlexiblePositionsService init list simple earn flexible positions service
+func (c *Client) NewListSimpleEarnFlexiblePositionsService() *ListSimpleEarnFlexiblePositionsService {
+	return &ListSimpleEarnFlexiblePositionsService{c: c}
+}
+
+// NewListSimpleEarnFlexibleRewardsService init list simple earn flexible rewards service
+func (c *Client) NewListSimpleEarnFlexibleRewardsService() *ListSimpleEarnFlexibleRewardsService {
+	return &ListSimpleEarnFlexibleRewardsService{c: c}
+}
+
+// NewListSimpleEarnFlexibleSubscriptionsService init list simple earn flexible subscriptions service
+func (c *Client) NewListSimpleEarnFlexibleSubscriptionsService() *ListSimpleEarnFlexibleSubscriptionsService {
+	return &ListSimpleEarnFlexibleSubscriptionsService{c: c}
+}
+
+// NewListSimpleEarnLockedProductsService init list simple earn locked products service
+func (c *Client) NewListSimpleEarnLockedProductsService() *ListSimpleEarnLockedProductsService {
+	return &ListSimpleEarnLockedProductsService{c: c}
+}
+
+// NewSubscribeSimpleEarnLockedService init subscribe simple earn locked service
+func (c *Client) NewSubscribeSimpleEarnLockedService() *SubscribeSimpleEarnLockedService {
+	return &SubscribeSimpleEarnLockedService{c: c}
+}
+
+// NewRedeemSimpleEarnLockedService init redeem simple earn locked service
+func (c *Client) NewRedeemSimpleEarnLockedService() *RedeemSimpleEarnLockedService {
+	return &RedeemSimpleEarnLockedService{c: c}
+}
+
+// NewListSimpleEarnLockedPositionsService init list simple earn locked positions service
+func (c *Client) NewListSimpleEarnLockedPositionsService() *ListSimpleEarnLockedPositionsService {
+	return &ListSimpleEarnLockedPositionsService{c: c}
+}
+
+// NewListSimpleEarnLockedRewardsService init list simple earn locked rewards service
+func (c *Client) NewListSimpleEarnLockedRewardsService() *ListSimpleEarnLockedRewardsService {
+	return &ListSimpleEarnLockedRewardsService{c: c}
+}
+
+// NewListSimpleEarnLockedSubscriptionsService init list simple earn locked subscriptions service
+func (c *Client) NewListSimpleEarnLockedSubscriptionsService() *ListSimpleEarnLockedSubscriptionsService {
+	return &ListSimpleEarnLockedSubscriptionsService{c: c}
+}
+
+// NewListConvertPairsService init list convert pairs service
+func (c *Client) NewListConvertPairsService() *ListConvertPairsService {
+	return &ListConvertPairsService{c: c}
+}
+
+// NewListConvertAssetsService init list convert assets service
+func (c *Client) NewListConvertAssetsService() *ListConvertAssetsService {
+	return &ListConvertAssetsService{c: c}
+}
+
+// NewGetConvertQuoteService init get convert quote service
+func (c *Client) NewGetConvertQuoteService() *GetConvertQuoteService {
+	return &GetConvertQuoteService{c: c}
+}
+
+// NewAcceptConvertQuoteService init accept convert quote service
+func (c *Client) NewAcceptConvertQuoteService() *AcceptConvertQuoteService {
+	return &AcceptConvertQuoteService{c: c}
+}
+
+// NewGetConvertOrderService init get convert order service
+func (c *Client) NewGetConvertOrderService() *GetConvertOrderService {
+	return &GetConvertOrderService{c: c}
+}
+
+// NewListConvertTradesService init list convert trades service
+func (c *Client) NewListConvertTradesService() *ListConvertTradesService {
+	return &ListConvertTradesService{c: c}
+}
+
 // NewAveragePriceService init average price service
 func (c *Client) NewAveragePriceService() *AveragePriceService {
 	return &AveragePriceService{c: c}
@@ -398,6 +704,76 @@ func (c *Client) NewListMarginRepaysService() *ListMarginRepaysService {
 	return &ListMarginRepaysService{c: c}
 }
 
+// NewCreateMarginOCOService init create margin OCO service
+func (c *Client) NewCreateMarginOCOService() *CreateMarginOCOService {
+	return &CreateMarginOCOService{c: c}
+}
+
+// NewCancelMarginOCOService init cancel margin OCO service
+func (c *Client) NewCancelMarginOCOService() *CancelMarginOCOService {
+	return &CancelMarginOCOService{c: c}
+}
+
+// NewGetMarginOrderListService init get margin order list service
+func (c *Client) NewGetMarginOrderListService() *GetMarginOrderListService {
+	return &GetMarginOrderListService{c: c}
+}
+
+// NewListMarginOrderListsService init list margin order lists service
+func (c *Client) NewListMarginOrderListsService() *ListMarginOrderListsService {
+	return &ListMarginOrderListsService{c: c}
+}
+
+// NewListMarginOpenOrderListsService init list margin open order lists service
+func (c *Client) NewListMarginOpenOrderListsService() *ListMarginOpenOrderListsService {
+	return &ListMarginOpenOrderListsService{c: c}
+}
+
+// NewCancelMarginOpenOrdersService init cancel margin open orders service
+func (c *Client) NewCancelMarginOpenOrdersService() *CancelMarginOpenOrdersService {
+	return &CancelMarginOpenOrdersService{c: c}
+}
+
+// NewIsolatedMarginTransferService init isolated margin transfer service
+func (c *Client) NewIsolatedMarginTransferService() *IsolatedMarginTransferService {
+	return &IsolatedMarginTransferService{c: c}
+}
+
+// NewListIsolatedMarginTransfersService init list isolated margin transfers service
+func (c *Client) NewListIsolatedMarginTransfersService() *ListIsolatedMarginTransfersService {
+	return &ListIsolatedMarginTransfersService{c: c}
+}
+
+// NewEnableIsolatedMarginAccountService init enable isolated margin account service
+func (c *Client) NewEnableIsolatedMarginAccountService() *EnableIsolatedMarginAccountService {
+	return &EnableIsolatedMarginAccountService{c: c}
+}
+
+// NewDisableIsolatedMarginAccountService init disable isolated margin account service
+func (c *Client) NewDisableIsolatedMarginAccountService() *DisableIsolatedMarginAccountService {
+	return &DisableIsolatedMarginAccountService{c: c}
+}
+
+// NewGetIsolatedMarginAccountLimitService init get isolated margin account limit service
+func (c *Client) NewGetIsolatedMarginAccountLimitService() *GetIsolatedMarginAccountLimitService {
+	return &GetIsolatedMarginAccountLimitService{c: c}
+}
+
+// NewListMarginInterestHistoryService init list margin interest history service
+func (c *Client) NewListMarginInterestHistoryService() *ListMarginInterestHistoryService {
+	return &ListMarginInterestHistoryService{c: c}
+}
+
+// NewListMarginForceLiquidationService init list margin force liquidation service
+func (c *Client) NewListMarginForceLiquidationService() *ListMarginForceLiquidationService {
+	return &ListMarginForceLiquidationService{c: c}
+}
+
+// NewListMarginInterestRateHistoryService init list margin interest rate history service
+func (c *Client) NewListMarginInterestRateHistoryService() *ListMarginInterestRateHistoryService {
+	return &ListMarginInterestRateHistoryService{c: c}
+}
+
 // NewGetMarginAccountService init get margin account service
 func (c *Client) NewGetMarginAccountService() *GetMarginAccountService {
 	return &GetMarginAccountService{c: c}
@@ -488,6 +864,71 @@ func (c *Client) NewFuturesTransferService() *FuturesTransferService {
 	return &FuturesTransferService{c: c}
 }
 
+// NewUniversalTransferService init universal transfer service
+func (c *Client) NewUniversalTransferService() *UniversalTransferService {
+	return &UniversalTransferService{c: c}
+}
+
+// NewListUniversalTransferService init list universal transfer service
+func (c *Client) NewListUniversalTransferService() *ListUniversalTransferService {
+	return &ListUniversalTransferService{c: c}
+}
+
+// NewListSubAccountsService init list sub-accounts service
+func (c *Client) NewListSubAccountsService() *ListSubAccountsService {
+	return &ListSubAccountsService{c: c}
+}
+
+// NewCreateVirtualSubAccountService init create virtual sub-account service
+func (c *Client) NewCreateVirtualSubAccountService() *CreateVirtualSubAccountService {
+	return &CreateVirtualSubAccountService{c: c}
+}
+
+// NewGetSubAccountAssetsService init get sub-account assets service
+func (c *Client) NewGetSubAccountAssetsService() *GetSubAccountAssetsService {
+	return &GetSubAccountAssetsService{c: c}
+}
+
+// NewGetSubAccountFuturesSummaryService init get sub-account futures summary service
+func (c *Client) NewGetSubAccountFuturesSummaryService() *GetSubAccountFuturesSummaryService {
+	return &GetSubAccountFuturesSummaryService{c: c}
+}
+
+// NewGetSubAccountMarginSummaryService init get sub-account margin summary service
+func (c *Client) NewGetSubAccountMarginSummaryService() *GetSubAccountMarginSummaryService {
+	return &GetSubAccountMarginSummaryService{c: c}
+}
+
+// NewEnableSubAccountFuturesService init enable sub-account futures service
+func (c *Client) NewEnableSubAccountFuturesService() *EnableSubAccountFuturesService {
+	return &EnableSubAccountFuturesService{c: c}
+}
+
+// NewEnableSubAccountMarginService init enable sub-account margin service
+func (c *Client) NewEnableSubAccountMarginService() *EnableSubAccountMarginService {
+	return &EnableSubAccountMarginService{c: c}
+}
+
+// NewSubAccountTransferService init sub-account transfer service
+func (c *Client) NewSubAccountTransferService() *SubAccountTransferService {
+	return &SubAccountTransferService{c: c}
+}
+
+// NewListSubAccountTransfersService init list sub-account transfers service
+func (c *Client) NewListSubAccountTransfersService() *ListSubAccountTransfersService {
+	return &ListSubAccountTransfersService{c: c}
+}
+
+// NewGetSubAccountDepositAddressService init get sub-account deposit address service
+func (c *Client) NewGetSubAccountDepositAddressService() *GetSubAccountDepositAddressService {
+	return &GetSubAccountDepositAddressService{c: c}
+}
+
+// NewListSubAccountDepositsService init list sub-account deposits service
+func (c *Client) NewListSubAccountDepositsService() *ListSubAccountDepositsService {
+	return &ListSubAccountDepositsService{c: c}
+}
+
 // NewListFuturesTransferService init list futures transfer service
 func (c *Client) NewListFuturesTransferService() *ListFuturesTransferService {
 	return &ListFuturesTransferService{c: c}
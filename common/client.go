@@ -56,6 +56,17 @@ func (c *client) UpdateDoFunc(f DoFunc) {
 	c.do = f
 }
 
+// GetDoFunc returns the function used to send requests, the one set by
+// UpdateDoFunc or else the Do method of the HTTP client.
+func (c *client) GetDoFunc() DoFunc {
+	if c.do != nil {
+		return c.do
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		return c.httpClient.Do(req)
+	}
+}
+
 func (c *client) UpdateHTTPClient(hc *http.Client) {
 	c.httpClient = hc
 }
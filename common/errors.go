@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -9,6 +10,9 @@ type APIError struct {
 	Status  int    `json:"status"`
 	Code    int64  `json:"code"`
 	Message string `json:"msg"`
+	// Data is the detail some endpoints attach to the error, like the partial
+	// result of a spot cancel-replace.
+	Data json.RawMessage `json:"data,omitempty"`
 }
 
 // Error return error code and message
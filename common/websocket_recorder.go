@@ -0,0 +1,280 @@
+package common
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// WebsocketFrame is an inbound websocket message with the time it was received.
+type WebsocketFrame struct {
+	Time time.Time
+	Data []byte
+}
+
+// WebsocketFrameWriter writes frames to a gzip compressed log. Each frame is
+// stored as the receive time in unix nanoseconds (8 bytes), the data length
+// (4 bytes), both big endian, followed by the data. It is safe for concurrent use
+// so several recorded connections can share one log.
+type WebsocketFrameWriter struct {
+	lock sync.Mutex
+	gz   *gzip.Writer
+}
+
+func NewWebsocketFrameWriter(w io.Writer) *WebsocketFrameWriter {
+	return &WebsocketFrameWriter{gz: gzip.NewWriter(w)}
+}
+
+func (fw *WebsocketFrameWriter) WriteFrame(frame WebsocketFrame) (err error) {
+	var header [12]byte
+	binary.BigEndian.PutUint64(header[:8], uint64(frame.Time.UnixNano()))
+	binary.BigEndian.PutUint32(header[8:], uint32(len(frame.Data)))
+
+	fw.lock.Lock()
+	defer fw.lock.Unlock()
+	if _, err = fw.gz.Write(header[:]); err != nil {
+		return err
+	}
+	_, err = fw.gz.Write(frame.Data)
+	return err
+}
+
+// Flush writes buffered frames to the underlying writer.
+func (fw *WebsocketFrameWriter) Flush() error {
+	fw.lock.Lock()
+	defer fw.lock.Unlock()
+	return fw.gz.Flush()
+}
+
+// Close flushes the log and writes the gzip footer, it does not close the
+// underlying writer.
+func (fw *WebsocketFrameWriter) Close() error {
+	fw.lock.Lock()
+	defer fw.lock.Unlock()
+	return fw.gz.Close()
+}
+
+// WebsocketFrameReader reads frames written by WebsocketFrameWriter.
+type WebsocketFrameReader struct {
+	r *bufio.Reader
+}
+
+func NewWebsocketFrameReader(r io.Reader) (*WebsocketFrameReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &WebsocketFrameReader{r: bufio.NewReader(gz)}, nil
+}
+
+// ReadFrame returns the next frame, or io.EOF at the end of the log.
+func (fr *WebsocketFrameReader) ReadFrame() (frame WebsocketFrame, err error) {
+	var header [12]byte
+	if _, err = io.ReadFull(fr.r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return frame, fmt.Errorf("websocket log truncated: %w", err)
+		}
+		return frame, err
+	}
+	frame.Time = time.Unix(0, int64(binary.BigEndian.Uint64(header[:8])))
+	frame.Data = make([]byte, binary.BigEndian.Uint32(header[8:]))
+	if _, err = io.ReadFull(fr.r, frame.Data); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return frame, fmt.Errorf("websocket log truncated: %w", err)
+	}
+	return frame, nil
+}
+
+var _ WebsocketClient = (*recordingWebsocketClient)(nil)
+
+type recordingWebsocketClient struct {
+	WebsocketClient
+	writer *WebsocketFrameWriter
+}
+
+func (rc *recordingWebsocketClient) Loop(f WebsocketMessageCallback) error {
+	return rc.WebsocketClient.Loop(func(data []byte) error {
+		frame := WebsocketFrame{Time: time.Now(), Data: data}
+		if err := rc.writer.WriteFrame(frame); err != nil {
+			return fmt.Errorf("websocket record frame failed: %w", err)
+		}
+		return f(data)
+	})
+}
+
+// NewRecordingWebsocketClient wraps client so every inbound frame is written to
+// writer before it is passed to the message callback.
+func NewRecordingWebsocketClient(client WebsocketClient, writer *WebsocketFrameWriter) WebsocketClient {
+	return &recordingWebsocketClient{WebsocketClient: client, writer: writer}
+}
+
+// RecordingWebsocketProvider returns a provider, suitable for DefaultWebsocketProvider,
+// which dials with provider and records every connection to writer.
+func RecordingWebsocketProvider(provider func(ctx context.Context, url string, proxyURL *url.URL) (
+	WebsocketClient, error), writer *WebsocketFrameWriter,
+) func(ctx context.Context, url string, proxyURL *url.URL) (WebsocketClient, error) {
+	return func(ctx context.Context, url string, proxyURL *url.URL) (WebsocketClient, error) {
+		client, err := provider(ctx, url, proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		return NewRecordingWebsocketClient(client, writer), nil
+	}
+}
+
+var _ WebsocketClient = (*replayWebsocketClient)(nil)
+
+type replayWebsocketClient struct {
+	ctx     context.Context
+	reader  *WebsocketFrameReader
+	speed   float64
+	replies chan []byte
+	done    chan struct{}
+	closer  io.Closer
+}
+
+// NewReplayWebsocketClient creates a client which feeds the frames of a recorded
+// log to the message callback. speed scales the recorded pace: 1 replays in real
+// time, 10 ten times faster, and 0 or less replays without waiting.
+//
+// Requests written to the client are not sent anywhere, subscriptions are
+// acknowledged with a successful reply so that WebsocketSession.Subscribe returns.
+// Loop returns nil at the end of the log or when ctx is done.
+func NewReplayWebsocketClient(ctx context.Context, r io.Reader, speed float64) (WebsocketClient, error) {
+	reader, err := NewWebsocketFrameReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &replayWebsocketClient{
+		ctx:     ctx,
+		reader:  reader,
+		speed:   speed,
+		replies: make(chan []byte, 100),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// ReplayWebsocketProvider returns a provider, suitable for DefaultWebsocketProvider,
+// which replays the log returned by open whatever the dialed url is. open is
+// called on every dial, so reconnects replay the log from the start again. The
+// log is closed when Loop returns.
+func ReplayWebsocketProvider(open func() (io.ReadCloser, error), speed float64) func(ctx context.Context,
+	url string, proxyURL *url.URL) (WebsocketClient, error) {
+	return func(ctx context.Context, url string, proxyURL *url.URL) (WebsocketClient, error) {
+		r, err := open()
+		if err != nil {
+			return nil, fmt.Errorf("websocket replay open log failed: %w", err)
+		}
+		client, err := NewReplayWebsocketClient(ctx, r, speed)
+		if err != nil {
+			_ = r.Close()
+			return nil, err
+		}
+		client.(*replayWebsocketClient).closer = r
+		return client, nil
+	}
+}
+
+func (rc *replayWebsocketClient) Loop(f WebsocketMessageCallback) (err error) {
+	var (
+		previous time.Time
+		timer    *time.Timer
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		close(rc.done)
+		if rc.closer != nil {
+			if cerr := rc.closer.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}()
+
+	for {
+		frame, err := rc.reader.ReadFrame()
+		if errors.Is(err, io.EOF) {
+			return rc.flushReplies(f)
+		}
+		if err != nil {
+			return err
+		}
+
+		var wait time.Duration
+		if rc.speed > 0 && !previous.IsZero() {
+			wait = time.Duration(float64(frame.Time.Sub(previous)) / rc.speed)
+		}
+		previous = frame.Time
+
+		if timer == nil {
+			timer = time.NewTimer(wait)
+		} else {
+			timer.Reset(wait)
+		}
+
+	WAIT_LOOP:
+		for {
+			select {
+			case <-rc.ctx.Done():
+				return nil
+			case reply := <-rc.replies:
+				if err = f(reply); err != nil {
+					return err
+				}
+			case <-timer.C:
+				break WAIT_LOOP
+			}
+		}
+
+		if err = f(frame.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func (rc *replayWebsocketClient) flushReplies(f WebsocketMessageCallback) error {
+	for {
+		select {
+		case reply := <-rc.replies:
+			if err := f(reply); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (rc *replayWebsocketClient) Delay() time.Duration {
+	return 0
+}
+
+func (rc *replayWebsocketClient) Ping() {}
+
+func (rc *replayWebsocketClient) Write(data []byte) {
+	var request WebsocketRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return
+	}
+	reply, err := json.Marshal(map[string]interface{}{"result": nil, "id": request.ID})
+	if err != nil {
+		return
+	}
+	// block until the reply is queued, dropping it would leave Subscribe waiting.
+	select {
+	case rc.replies <- reply:
+	case <-rc.ctx.Done():
+	case <-rc.done:
+	}
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testWebsocketClient struct {
+	frames [][]byte
+}
+
+func (c *testWebsocketClient) Loop(f WebsocketMessageCallback) error {
+	for _, data := range c.frames {
+		if err := f(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *testWebsocketClient) Delay() time.Duration { return 0 }
+
+func (c *testWebsocketClient) Ping() {}
+
+func (c *testWebsocketClient) Write(data []byte) {}
+
+type testReplayHandler struct {
+	unknown [][]byte
+}
+
+func (h *testReplayHandler) OnUnknownMessage(data []byte, m interface{}) error {
+	h.unknown = append(h.unknown, data)
+	return nil
+}
+
+func (h *testReplayHandler) OnClose(err error) {}
+
+type testTradeEvent struct {
+	Event string `json:"e"`
+	ID    int64  `json:"t"`
+}
+
+func TestWebsocketFrameLog(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWebsocketFrameWriter(&buffer)
+	now := time.Now()
+	require.NoError(t, writer.WriteFrame(WebsocketFrame{Time: now, Data: []byte(`{"a":1}`)}))
+	require.NoError(t, writer.WriteFrame(WebsocketFrame{Time: now.Add(time.Second), Data: nil}))
+	require.NoError(t, writer.Close())
+
+	reader, err := NewWebsocketFrameReader(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	frame, err := reader.ReadFrame()
+	require.NoError(t, err)
+	assert.True(t, now.Equal(frame.Time))
+	assert.Equal(t, []byte(`{"a":1}`), frame.Data)
+	frame, err = reader.ReadFrame()
+	require.NoError(t, err)
+	assert.Empty(t, frame.Data)
+	_, err = reader.ReadFrame()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestWebsocketRecordAndReplay(t *testing.T) {
+	frames := [][]byte{
+		[]byte(`{"e":"trade","t":1}`),
+		[]byte(`{"e":"trade","t":2}`),
+		[]byte(`{"e":"other"}`),
+	}
+
+	var buffer bytes.Buffer
+	writer := NewWebsocketFrameWriter(&buffer)
+	var received [][]byte
+	recorder := NewRecordingWebsocketClient(&testWebsocketClient{frames: frames}, writer)
+	require.NoError(t, recorder.Loop(func(data []byte) error {
+		received = append(received, data)
+		return nil
+	}))
+	require.NoError(t, writer.Close())
+	assert.Equal(t, frames, received)
+
+	ctx := context.Background()
+	client, err := NewReplayWebsocketClient(ctx, bytes.NewReader(buffer.Bytes()), 0)
+	require.NoError(t, err)
+
+	handler := new(testReplayHandler)
+	session := NewWebsocketSession(client, handler)
+	var trades []int64
+	session.RegisterMessageHandler(
+		WebsocketSessionMessageFactoryBuild[testTradeEvent](),
+		WebsocketSessionMessageHandlerBuild(func(e *testTradeEvent) {
+			trades = append(trades, e.ID)
+		}),
+		session.RequireMapKeyValue("e", "trade"),
+	)
+
+	subscribed := make(chan error, 1)
+	go func() { subscribed <- session.SubscribeNoReply(ctx, "btcusdt@trade") }()
+	// start replaying once the subscription is queued so its reply is delivered.
+	for len(client.(*replayWebsocketClient).replies) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	require.NoError(t, session.Loop())
+	require.NoError(t, <-subscribed)
+	assert.Equal(t, []int64{1, 2}, trades)
+	assert.Equal(t, [][]byte{frames[2]}, handler.unknown)
+}
+
+func TestWebsocketReplaySpeed(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWebsocketFrameWriter(&buffer)
+	now := time.Now()
+	require.NoError(t, writer.WriteFrame(WebsocketFrame{Time: now, Data: []byte(`{}`)}))
+	require.NoError(t, writer.WriteFrame(WebsocketFrame{Time: now.Add(200 * time.Millisecond), Data: []byte(`{}`)}))
+	require.NoError(t, writer.Close())
+
+	client, err := NewReplayWebsocketClient(context.Background(), bytes.NewReader(buffer.Bytes()), 4)
+	require.NoError(t, err)
+	from := time.Now()
+	count := 0
+	require.NoError(t, client.Loop(func(data []byte) error {
+		count++
+		return nil
+	}))
+	assert.Equal(t, 2, count)
+	assert.GreaterOrEqual(t, time.Since(from), 50*time.Millisecond)
+}
+
+// closeRecorder records whether the reader is closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestReplayWebsocketProviderReopens(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWebsocketFrameWriter(&buffer)
+	require.NoError(t, writer.WriteFrame(WebsocketFrame{Time: time.Now(), Data: []byte(`{}`)}))
+	require.NoError(t, writer.Close())
+
+	var opened []*closeRecorder
+	provider := ReplayWebsocketProvider(func() (io.ReadCloser, error) {
+		r := &closeRecorder{Reader: bytes.NewReader(buffer.Bytes())}
+		opened = append(opened, r)
+		return r, nil
+	}, 0)
+	for i := 0; i < 2; i++ {
+		client, err := provider(context.Background(), "", nil)
+		require.NoError(t, err)
+		count := 0
+		require.NoError(t, client.Loop(func(data []byte) error {
+			count++
+			return nil
+		}))
+		assert.Equal(t, 1, count)
+		assert.True(t, opened[i].closed)
+	}
+	assert.Len(t, opened, 2)
+}
@@ -152,7 +152,8 @@ func (ws *websocketSession) onMessage(data []byte) (err error) {
 func (ws *websocketSession) processMessage(m interface{}, data []byte) (err error) {
 	switch result := m.(type) {
 	case map[string]interface{}:
-		if MapHasKeys(result, "id", "method", "code") {
+		// events always carry "e", some of them also have an "id" field
+		if _, ok := result["e"]; !ok && MapHasKeys(result, "id", "method", "code") {
 			return ws.onRequestReply(data)
 		}
 
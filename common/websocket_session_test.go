@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testWebsocketSessionHandler struct {
@@ -70,3 +73,40 @@ func TestWebsocketSession(t *testing.T) {
 	<-handler.done
 	cancel()
 }
+
+type testIDEvent struct {
+	Event string `json:"e"`
+	ID    int64  `json:"id"`
+}
+
+func TestWebsocketSessionReplyDetection(t *testing.T) {
+	handler := &testWebsocketSessionHandler{t: t}
+	session := NewMockWebsocketSession(handler)
+	var events []*testIDEvent
+	session.RegisterMessageHandler(WebsocketSessionMessageFactoryBuild[testIDEvent](),
+		WebsocketSessionMessageHandlerBuild(func(e *testIDEvent) {
+			events = append(events, e)
+		}), session.RequireMapKeyValue("e", "trade"))
+
+	request := newWebsocketSessionRequest()
+	ws := session.(*websocketSession)
+	ws.pendingRequests[1] = request
+
+	// an event with an id field is not a reply.
+	require.NoError(t, session.MockProcessMessage([]byte(`{"e":"trade","id":1}`)))
+	require.Len(t, events, 1)
+	assert.Equal(t, int64(1), events[0].ID)
+	assert.Len(t, ws.pendingRequests, 1)
+
+	require.NoError(t, session.MockProcessMessage([]byte(`{"result":null,"id":1}`)))
+	select {
+	case <-request.done:
+	default:
+		t.Fatal("reply is not matched")
+	}
+	assert.Equal(t, uint64(1), request.reply.ID)
+	assert.NoError(t, request.reply.OK())
+	assert.Empty(t, ws.pendingRequests)
+	assert.Len(t, events, 1)
+	assert.Zero(t, handler.messageCount)
+}
@@ -0,0 +1,326 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ErrConvertQuoteExpired is returned when accepting a quote past its valid time
+var ErrConvertQuoteExpired = errors.New("binance: convert quote expired")
+
+// ListConvertPairsService list the pairs which can be converted and their
+// amount limits, at least one of fromAsset and toAsset is required
+type ListConvertPairsService struct {
+	c         *Client
+	fromAsset *string
+	toAsset   *string
+}
+
+// FromAsset set the asset sold
+func (s *ListConvertPairsService) FromAsset(fromAsset string) *ListConvertPairsService {
+	s.fromAsset = &fromAsset
+	return s
+}
+
+// ToAsset set the asset bought
+func (s *ListConvertPairsService) ToAsset(toAsset string) *ListConvertPairsService {
+	s.toAsset = &toAsset
+	return s
+}
+
+// Do send Request
+func (s *ListConvertPairsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*ConvertPair, err error) {
+	r := common.NewGetRequestPublic("/sapi/v1/convert/exchangeInfo")
+	if s.fromAsset != nil {
+		r.SetQuery("fromAsset", *s.fromAsset)
+	}
+	if s.toAsset != nil {
+		r.SetQuery("toAsset", *s.toAsset)
+	}
+
+	res = make([]*ConvertPair, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertPair define a convertible pair and its amount limits
+type ConvertPair struct {
+	FromAsset          string `json:"fromAsset"`
+	ToAsset            string `json:"toAsset"`
+	FromAssetMinAmount string `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount string `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   string `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   string `json:"toAssetMaxAmount"`
+}
+
+// ListConvertAssetsService list the precision of the convertible assets
+type ListConvertAssetsService struct {
+	c *Client
+}
+
+// Do send Request
+func (s *ListConvertAssetsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*ConvertAsset, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/convert/assetInfo")
+
+	res = make([]*ConvertAsset, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertAsset define the precision of a convertible asset, Fraction is the
+// number of decimals accepted in an amount
+type ConvertAsset struct {
+	Asset    string `json:"asset"`
+	Fraction int    `json:"fraction"`
+}
+
+// GetConvertQuoteService request a quote for a conversion, either fromAmount
+// or toAmount is required
+type GetConvertQuoteService struct {
+	c          *Client
+	fromAsset  string
+	toAsset    string
+	fromAmount *string
+	toAmount   *string
+	walletType *ConvertWalletType
+	validTime  *ConvertValidTimeType
+}
+
+// FromAsset set the asset sold
+func (s *GetConvertQuoteService) FromAsset(fromAsset string) *GetConvertQuoteService {
+	s.fromAsset = fromAsset
+	return s
+}
+
+// ToAsset set the asset bought
+func (s *GetConvertQuoteService) ToAsset(toAsset string) *GetConvertQuoteService {
+	s.toAsset = toAsset
+	return s
+}
+
+// FromAmount set the amount sold
+func (s *GetConvertQuoteService) FromAmount(fromAmount string) *GetConvertQuoteService {
+	s.fromAmount = &fromAmount
+	return s
+}
+
+// ToAmount set the amount bought
+func (s *GetConvertQuoteService) ToAmount(toAmount string) *GetConvertQuoteService {
+	s.toAmount = &toAmount
+	return s
+}
+
+// WalletType set the wallet the conversion is paid from, default SPOT
+func (s *GetConvertQuoteService) WalletType(walletType ConvertWalletType) *GetConvertQuoteService {
+	s.walletType = &walletType
+	return s
+}
+
+// ValidTime set how long the quote can be accepted, default 10s
+func (s *GetConvertQuoteService) ValidTime(validTime ConvertValidTimeType) *GetConvertQuoteService {
+	s.validTime = &validTime
+	return s
+}
+
+// Do send Request
+func (s *GetConvertQuoteService) Do(ctx context.Context, opts ...common.RequestOption) (res *ConvertQuote, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/convert/getQuote")
+	r.SetFormParams(common.Params{
+		"fromAsset": s.fromAsset,
+		"toAsset":   s.toAsset,
+	})
+	if s.fromAmount != nil {
+		r.SetForm("fromAmount", *s.fromAmount)
+	}
+	if s.toAmount != nil {
+		r.SetForm("toAmount", *s.toAmount)
+	}
+	if s.walletType != nil {
+		r.SetForm("walletType", *s.walletType)
+	}
+	if s.validTime != nil {
+		r.SetForm("validTime", *s.validTime)
+	}
+
+	res = new(ConvertQuote)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertQuote define a conversion quote, ValidTimestamp is the server time in
+// milliseconds until which it can be accepted
+type ConvertQuote struct {
+	QuoteID        string `json:"quoteId"`
+	Ratio          string `json:"ratio"`
+	InverseRatio   string `json:"inverseRatio"`
+	ValidTimestamp int64  `json:"validTimestamp"`
+	ToAmount       string `json:"toAmount"`
+	FromAmount     string `json:"fromAmount"`
+}
+
+// ExpireTime returns the time the quote expires at
+func (q *ConvertQuote) ExpireTime() time.Time {
+	return time.UnixMilli(q.ValidTimestamp)
+}
+
+// Expired returns whether the quote can no longer be accepted at t
+func (q *ConvertQuote) Expired(t time.Time) bool {
+	return FormatTimestamp(t) >= q.ValidTimestamp
+}
+
+// AcceptConvertQuoteService accept a conversion quote
+type AcceptConvertQuoteService struct {
+	c              *Client
+	quoteID        string
+	validTimestamp *int64
+}
+
+// QuoteID set quoteID
+func (s *AcceptConvertQuoteService) QuoteID(quoteID string) *AcceptConvertQuoteService {
+	s.quoteID = quoteID
+	return s
+}
+
+// Quote set the quote to accept, Do returns ErrConvertQuoteExpired without
+// sending the request once the quote expired by the server time
+func (s *AcceptConvertQuoteService) Quote(quote *ConvertQuote) *AcceptConvertQuoteService {
+	s.quoteID = quote.QuoteID
+	s.validTimestamp = &quote.ValidTimestamp
+	return s
+}
+
+// Do send Request
+func (s *AcceptConvertQuoteService) Do(ctx context.Context, opts ...common.RequestOption) (res *AcceptConvertQuoteResponse, err error) {
+	if s.validTimestamp != nil && currentTimestamp()-s.c.GetTimeOffset() >= *s.validTimestamp {
+		return nil, ErrConvertQuoteExpired
+	}
+	r := common.NewPostRequestSigned("/sapi/v1/convert/acceptQuote")
+	r.SetForm("quoteId", s.quoteID)
+
+	res = new(AcceptConvertQuoteResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AcceptConvertQuoteResponse define accept convert quote response
+type AcceptConvertQuoteResponse struct {
+	OrderID     string                 `json:"orderId"`
+	CreateTime  int64                  `json:"createTime"`
+	OrderStatus ConvertOrderStatusType `json:"orderStatus"`
+}
+
+// GetConvertOrderService get the status of a conversion by orderID or quoteID
+type GetConvertOrderService struct {
+	c       *Client
+	orderID *string
+	quoteID *string
+}
+
+// OrderID set orderID
+func (s *GetConvertOrderService) OrderID(orderID string) *GetConvertOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// QuoteID set quoteID
+func (s *GetConvertOrderService) QuoteID(quoteID string) *GetConvertOrderService {
+	s.quoteID = &quoteID
+	return s
+}
+
+// Do send Request
+func (s *GetConvertOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *ConvertOrder, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/convert/orderStatus")
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.quoteID != nil {
+		r.SetQuery("quoteId", *s.quoteID)
+	}
+
+	res = new(ConvertOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertOrder define a conversion order
+type ConvertOrder struct {
+	QuoteID      string                 `json:"quoteId"`
+	OrderID      int64                  `json:"orderId"`
+	OrderStatus  ConvertOrderStatusType `json:"orderStatus"`
+	FromAsset    string                 `json:"fromAsset"`
+	FromAmount   string                 `json:"fromAmount"`
+	ToAsset      string                 `json:"toAsset"`
+	ToAmount     string                 `json:"toAmount"`
+	Ratio        string                 `json:"ratio"`
+	InverseRatio string                 `json:"inverseRatio"`
+	CreateTime   int64                  `json:"createTime"`
+}
+
+// ListConvertTradesService list the conversions between startTime and endTime,
+// at most 30 days apart
+type ListConvertTradesService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+	limit     *int
+}
+
+// StartTime set start time
+func (s *ListConvertTradesService) StartTime(startTime int64) *ListConvertTradesService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListConvertTradesService) EndTime(endTime int64) *ListConvertTradesService {
+	s.endTime = endTime
+	return s
+}
+
+// Limit set limit, default:100 max:1000
+func (s *ListConvertTradesService) Limit(limit int) *ListConvertTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *ListConvertTradesService) Do(ctx context.Context, opts ...common.RequestOption) (res *ConvertTradeHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/convert/tradeFlow")
+	r.SetQueryParams(common.Params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = new(ConvertTradeHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ConvertTradeHistory define convert trade history, MoreData is set when the
+// range holds more trades than the limit
+type ConvertTradeHistory struct {
+	List      []*ConvertOrder `json:"list"`
+	StartTime int64           `json:"startTime"`
+	EndTime   int64           `json:"endTime"`
+	Limit     int             `json:"limit"`
+	MoreData  bool            `json:"moreData"`
+}
@@ -0,0 +1,226 @@
+package binance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type convertServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestConvertService(t *testing.T) {
+	suite.Run(t, new(convertServiceTestSuite))
+}
+
+func (s *convertServiceTestSuite) TestListPairs() {
+	data := []byte(`[
+		{
+			"fromAsset": "BTC",
+			"toAsset": "USDT",
+			"fromAssetMinAmount": "0.0004",
+			"fromAssetMaxAmount": "50",
+			"toAssetMinAmount": "20",
+			"toAssetMaxAmount": "2500000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(common.Params{
+			"fromAsset": "BTC",
+			"toAsset":   "USDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListConvertPairsService().FromAsset("BTC").ToAsset("USDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*ConvertPair{{
+		FromAsset:          "BTC",
+		ToAsset:            "USDT",
+		FromAssetMinAmount: "0.0004",
+		FromAssetMaxAmount: "50",
+		ToAssetMinAmount:   "20",
+		ToAssetMaxAmount:   "2500000",
+	}}, res)
+}
+
+func (s *convertServiceTestSuite) TestListAssets() {
+	data := []byte(`[
+		{"asset": "BTC", "fraction": 8},
+		{"asset": "SHIB", "fraction": 2}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListConvertAssetsService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*ConvertAsset{{Asset: "BTC", Fraction: 8}, {Asset: "SHIB", Fraction: 2}}, res)
+}
+
+func (s *convertServiceTestSuite) TestGetQuote() {
+	data := []byte(`{
+		"quoteId": "12415572564",
+		"ratio": "38163.7",
+		"inverseRatio": "0.0000262",
+		"validTimestamp": 1623319461670,
+		"toAmount": "3816.37",
+		"fromAmount": "0.1"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"fromAsset":  "BTC",
+			"toAsset":    "USDT",
+			"fromAmount": "0.1",
+			"walletType": ConvertWalletTypeFunding,
+			"validTime":  ConvertValidTimeType30s,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetConvertQuoteService().FromAsset("BTC").ToAsset("USDT").FromAmount("0.1").
+		WalletType(ConvertWalletTypeFunding).ValidTime(ConvertValidTimeType30s).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&ConvertQuote{
+		QuoteID:        "12415572564",
+		Ratio:          "38163.7",
+		InverseRatio:   "0.0000262",
+		ValidTimestamp: 1623319461670,
+		ToAmount:       "3816.37",
+		FromAmount:     "0.1",
+	}, res)
+	s.r().Equal(time.UnixMilli(1623319461670), res.ExpireTime())
+	s.r().False(res.Expired(time.UnixMilli(1623319461669)))
+	s.r().True(res.Expired(time.UnixMilli(1623319461670)))
+}
+
+func (s *convertServiceTestSuite) TestAcceptQuote() {
+	data := []byte(`{
+		"orderId": "933256278426274426",
+		"createTime": 1623381330472,
+		"orderStatus": "PROCESS"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("quoteId", "12415572564")
+		s.assertRequestEqual(e, r)
+	})
+
+	quote := &ConvertQuote{QuoteID: "12415572564", ValidTimestamp: FormatTimestamp(time.Now().Add(time.Minute))}
+	res, err := s.client.NewAcceptConvertQuoteService().Quote(quote).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AcceptConvertQuoteResponse{
+		OrderID:     "933256278426274426",
+		CreateTime:  1623381330472,
+		OrderStatus: ConvertOrderStatusTypeProcess,
+	}, res)
+}
+
+func (s *convertServiceTestSuite) TestAcceptExpiredQuote() {
+	s.mockDo([]byte(`{}`), nil)
+
+	quote := &ConvertQuote{QuoteID: "12415572564", ValidTimestamp: FormatTimestamp(time.Now())}
+	_, err := s.client.NewAcceptConvertQuoteService().Quote(quote).Do(newContext())
+	s.r().ErrorIs(err, ErrConvertQuoteExpired)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *convertServiceTestSuite) TestGetOrder() {
+	data := []byte(`{
+		"orderId": 933256278426274426,
+		"orderStatus": "SUCCESS",
+		"fromAsset": "BTC",
+		"fromAmount": "0.00054414",
+		"toAsset": "USDT",
+		"toAmount": "20",
+		"ratio": "36755",
+		"inverseRatio": "0.00002721",
+		"createTime": 1623381330472
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("orderId", "933256278426274426")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetConvertOrderService().OrderID("933256278426274426").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&ConvertOrder{
+		OrderID:      933256278426274426,
+		OrderStatus:  ConvertOrderStatusTypeSuccess,
+		FromAsset:    "BTC",
+		FromAmount:   "0.00054414",
+		ToAsset:      "USDT",
+		ToAmount:     "20",
+		Ratio:        "36755",
+		InverseRatio: "0.00002721",
+		CreateTime:   1623381330472,
+	}, res)
+}
+
+func (s *convertServiceTestSuite) TestListTrades() {
+	data := []byte(`{
+		"list": [
+			{
+				"quoteId": "f3b91c525b2644c7bc1e1cd31b6e1aa6",
+				"orderId": 940708407462087195,
+				"orderStatus": "SUCCESS",
+				"fromAsset": "USDT",
+				"fromAmount": "20",
+				"toAsset": "BNB",
+				"toAmount": "0.06154036",
+				"ratio": "0.00307702",
+				"inverseRatio": "324.99",
+				"createTime": 1624248872184
+			}
+		],
+		"startTime": 1623824139000,
+		"endTime": 1626416139000,
+		"limit": 100,
+		"moreData": false
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"startTime": 1623824139000,
+			"endTime":   1626416139000,
+			"limit":     100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListConvertTradesService().StartTime(1623824139000).EndTime(1626416139000).
+		Limit(100).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&ConvertTradeHistory{
+		List: []*ConvertOrder{{
+			QuoteID:      "f3b91c525b2644c7bc1e1cd31b6e1aa6",
+			OrderID:      940708407462087195,
+			OrderStatus:  ConvertOrderStatusTypeSuccess,
+			FromAsset:    "USDT",
+			FromAmount:   "20",
+			ToAsset:      "BNB",
+			ToAmount:     "0.06154036",
+			Ratio:        "0.00307702",
+			InverseRatio: "324.99",
+			CreateTime:   1624248872184,
+		}},
+		StartTime: 1623824139000,
+		EndTime:   1626416139000,
+		Limit:     100,
+	}, res)
+}
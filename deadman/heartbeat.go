@@ -0,0 +1,112 @@
+// Package deadman keeps the countdown cancel-all of the futures exchanges
+// armed while the process is healthy, so the exchange cancels the open orders
+// when the process hangs or dies.
+package deadman
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+const defaultCountdown = time.Minute
+
+// ErrNoSymbols is returned by Run when no symbol is configured.
+var ErrNoSymbols = errors.New("deadman: no symbols")
+
+// Countdown is implemented by *futures.Client and *delivery.Client.
+type Countdown interface {
+	CountdownCancelAll(ctx context.Context, symbol string, countdownTime int64, opts ...common.RequestOption) error
+}
+
+// Config define the heartbeat options.
+type Config struct {
+	// Symbols are the symbols whose orders are cancelled.
+	Symbols []string
+	// Countdown is the time after the last refresh at which the exchange
+	// cancels the orders, one minute by default.
+	Countdown time.Duration
+	// Interval is the refresh period, a third of Countdown by default.
+	Interval time.Duration
+	// Healthy is called before every refresh, refreshing stops for good when
+	// it returns an error.
+	Healthy func(ctx context.Context) error
+	// OnError receives the refresh errors, refreshing goes on after them.
+	OnError func(symbol string, err error)
+}
+
+// Heartbeat refreshes the countdown of the configured symbols.
+type Heartbeat struct {
+	api    Countdown
+	config Config
+}
+
+// NewHeartbeat creates a heartbeat, api is usually a *futures.Client or a
+// *delivery.Client.
+func NewHeartbeat(api Countdown, config Config) *Heartbeat {
+	if config.Countdown <= 0 {
+		config.Countdown = defaultCountdown
+	}
+	if config.Interval <= 0 {
+		config.Interval = config.Countdown / 3
+	}
+	return &Heartbeat{api: api, config: config}
+}
+
+// Run refreshes the countdowns every Interval until ctx is done or Healthy
+// fails, and returns the reason it stopped. The countdowns are left running,
+// the exchange cancels the orders once they elapse; call Disarm first to keep
+// the orders on a clean shutdown.
+func (h *Heartbeat) Run(ctx context.Context) error {
+	if len(h.config.Symbols) == 0 {
+		return ErrNoSymbols
+	}
+	ticker := time.NewTicker(h.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := h.Beat(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Beat checks the health of the process and refreshes the countdowns once.
+// It only returns the error of Healthy or of ctx, refresh errors are passed
+// to OnError.
+func (h *Heartbeat) Beat(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if h.config.Healthy != nil {
+		if err := h.config.Healthy(ctx); err != nil {
+			return err
+		}
+	}
+	h.refresh(ctx, h.config.Countdown.Milliseconds())
+	return nil
+}
+
+// Disarm stops the countdowns, the orders are kept. Refresh errors are passed
+// to OnError, the last one is returned.
+func (h *Heartbeat) Disarm(ctx context.Context) error {
+	return h.refresh(ctx, 0)
+}
+
+func (h *Heartbeat) refresh(ctx context.Context, countdownTime int64) (last error) {
+	for _, symbol := range h.config.Symbols {
+		if err := h.api.CountdownCancelAll(ctx, symbol, countdownTime); err != nil {
+			last = err
+			if h.config.OnError != nil {
+				h.config.OnError(symbol, err)
+			}
+		}
+	}
+	return last
+}
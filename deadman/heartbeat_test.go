@@ -0,0 +1,79 @@
+package deadman
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	_ Countdown = (*futures.Client)(nil)
+	_ Countdown = (*delivery.Client)(nil)
+)
+
+type heartbeatTestSuite struct {
+	suite.Suite
+}
+
+func TestHeartbeat(t *testing.T) {
+	suite.Run(t, new(heartbeatTestSuite))
+}
+
+func (s *heartbeatTestSuite) TestStopsWhenUnhealthy() {
+	api := new(mocks.FuturesClient)
+	api.On("CountdownCancelAll", mock.Anything, "BTCUSDT", int64(30000)).Return(nil).Times(3)
+	api.On("CountdownCancelAll", mock.Anything, "ETHUSDT", int64(30000)).
+		Return(errors.New("boom")).Times(3)
+	defer api.AssertExpectations(s.T())
+
+	unhealthy := errors.New("stuck")
+	beats := 0
+	var failed []string
+	h := NewHeartbeat(api, Config{
+		Symbols:   []string{"BTCUSDT", "ETHUSDT"},
+		Countdown: 30 * time.Second,
+		Interval:  time.Millisecond,
+		Healthy: func(ctx context.Context) error {
+			beats++
+			if beats > 3 {
+				return unhealthy
+			}
+			return nil
+		},
+		OnError: func(symbol string, err error) {
+			failed = append(failed, symbol)
+		},
+	})
+	s.Equal(unhealthy, h.Run(context.Background()))
+	s.Equal([]string{"ETHUSDT", "ETHUSDT", "ETHUSDT"}, failed)
+}
+
+func (s *heartbeatTestSuite) TestStopsWithContext() {
+	api := new(mocks.DeliveryClient)
+	ctx, cancel := context.WithCancel(context.Background())
+	api.On("CountdownCancelAll", mock.Anything, "BTCUSD_PERP", int64(60000)).Return(nil).Once().
+		Run(func(mock.Arguments) { cancel() })
+	defer api.AssertExpectations(s.T())
+
+	h := NewHeartbeat(api, Config{Symbols: []string{"BTCUSD_PERP"}})
+	s.Equal(context.Canceled, h.Run(ctx))
+	s.Equal(context.Canceled, h.Beat(ctx))
+}
+
+func (s *heartbeatTestSuite) TestDisarm() {
+	api := new(mocks.FuturesClient)
+	api.On("CountdownCancelAll", mock.Anything, "BTCUSDT", int64(0)).Return(nil).Once()
+	defer api.AssertExpectations(s.T())
+
+	h := NewHeartbeat(api, Config{Symbols: []string{"BTCUSDT"}})
+	s.NoError(h.Disarm(context.Background()))
+	s.Equal(ErrNoSymbols, NewHeartbeat(api, Config{}).Run(context.Background()))
+}
@@ -0,0 +1,98 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type apiTestSuite struct {
+	baseTestSuite
+}
+
+func TestAPI(t *testing.T) {
+	suite.Run(t, new(apiTestSuite))
+}
+
+func (s *apiTestSuite) TestCreateOrder() {
+	data := []byte(`{"symbol":"BTCUSD_PERP","orderId":1,"status":"NEW","positionSide":"SHORT"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":           "BTCUSD_PERP",
+			"side":             SideTypeSell,
+			"positionSide":     PositionSideTypeShort,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTX,
+			"quantity":         "1",
+			"price":            "30000",
+			"reduceOnly":       true,
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.CreateOrder(newContext(), CreateOrderParams{
+		Symbol:           "BTCUSD_PERP",
+		Side:             SideTypeSell,
+		PositionSide:     PositionSideTypeShort,
+		Type:             OrderTypeLimit,
+		TimeInForce:      TimeInForceTypeGTX,
+		Quantity:         "1",
+		Price:            "30000",
+		ReduceOnly:       true,
+		NewOrderRespType: NewOrderRespTypeRESULT,
+	})
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.OrderID)
+	s.r().Equal(PositionSideTypeShort, res.PositionSide)
+}
+
+func (s *apiTestSuite) TestCancelOrder() {
+	data := []byte(`{"symbol":"BTCUSD_PERP","orderId":1,"status":"CANCELED"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":  "BTCUSD_PERP",
+			"orderId": 1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.CancelOrder(newContext(), OrderQuery{Symbol: "BTCUSD_PERP", OrderID: 1})
+	s.r().NoError(err)
+	s.r().Equal(OrderStatusTypeCanceled, res.Status)
+}
+
+func (s *apiTestSuite) TestGetPositionRisk() {
+	data := []byte(`[{"symbol":"BTCUSD_PERP","positionAmt":"10"}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"pair": "BTCUSD",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.GetPositionRisk(newContext(), "BTCUSD")
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("10", res[0].PositionAmt)
+}
+
+func (s *apiTestSuite) TestListOrders() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"pair":  "BTCUSD",
+			"limit": 10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.ListOrders(newContext(), ListOrdersParams{Pair: "BTCUSD", Limit: 10})
+	s.r().NoError(err)
+}
@@ -65,17 +65,21 @@ func (s *KlinesService) Do(ctx context.Context, opts ...common.RequestOption) (r
 	if err != nil {
 		return []*Kline{}, err
 	}
+	return parseKlines(data)
+}
+
+// parseKlines decodes the array of arrays returned by the klines endpoints.
+func parseKlines(data []byte) ([]*Kline, error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return []*Kline{}, err
 	}
 	num := len(j.MustArray())
-	res = make([]*Kline, num)
+	res := make([]*Kline, num)
 	for i := 0; i < num; i++ {
 		item := j.GetIndex(i)
 		if len(item.MustArray()) < 11 {
-			err = fmt.Errorf("invalid kline response")
-			return []*Kline{}, err
+			return []*Kline{}, fmt.Errorf("invalid kline response")
 		}
 		res[i] = &Kline{
 			OpenTime:                 item.GetIndex(0).MustInt64(),
@@ -0,0 +1,522 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// OpenInterestService get present open interest of a symbol
+type OpenInterestService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *OpenInterestService) Symbol(symbol string) *OpenInterestService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *OpenInterestService) Do(ctx context.Context, opts ...common.RequestOption) (res *OpenInterest, err error) {
+	r := common.NewGetRequestPublic("/dapi/v1/openInterest")
+	r.SetQuery("symbol", s.symbol)
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OpenInterest)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OpenInterest define open interest info
+type OpenInterest struct {
+	Symbol       string       `json:"symbol"`
+	Pair         string       `json:"pair"`
+	OpenInterest string       `json:"openInterest"`
+	ContractType ContractType `json:"contractType"`
+	Time         int64        `json:"time"`
+}
+
+// OpenInterestStatisticsService list open interest history of a pair, only the latest 30 days are available
+type OpenInterestStatisticsService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *OpenInterestStatisticsService) Pair(pair string) *OpenInterestStatisticsService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, CURRENT_QUARTER, NEXT_QUARTER or PERPETUAL
+func (s *OpenInterestStatisticsService) ContractType(contractType ContractType) *OpenInterestStatisticsService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *OpenInterestStatisticsService) Period(period string) *OpenInterestStatisticsService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *OpenInterestStatisticsService) Limit(limit int) *OpenInterestStatisticsService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *OpenInterestStatisticsService) StartTime(startTime int64) *OpenInterestStatisticsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *OpenInterestStatisticsService) EndTime(endTime int64) *OpenInterestStatisticsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*OpenInterestStatistic, err error) {
+	r := common.NewGetRequestPublic("/futures/data/openInterestHist")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("contractType", s.contractType)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*OpenInterestStatistic, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OpenInterestStatistic define open interest statistic
+type OpenInterestStatistic struct {
+	Pair                 string       `json:"pair"`
+	ContractType         ContractType `json:"contractType"`
+	SumOpenInterest      string       `json:"sumOpenInterest"`
+	SumOpenInterestValue string       `json:"sumOpenInterestValue"`
+	Timestamp            int64        `json:"timestamp"`
+}
+
+// TopLongShortAccountRatioService list long/short ratio of the accounts of the top traders
+type TopLongShortAccountRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortAccountRatioService) Pair(pair string) *TopLongShortAccountRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *TopLongShortAccountRatioService) Period(period string) *TopLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *TopLongShortAccountRatioService) Limit(limit int) *TopLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortAccountRatioService) StartTime(startTime int64) *TopLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortAccountRatioService) EndTime(endTime int64) *TopLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...common.RequestOption) (res []*LongShortRatio, err error) {
+	r := common.NewGetRequestPublic("/futures/data/topLongShortAccountRatio")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TopLongShortPositionRatioService list long/short ratio of the positions of the top traders
+type TopLongShortPositionRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortPositionRatioService) Pair(pair string) *TopLongShortPositionRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *TopLongShortPositionRatioService) Period(period string) *TopLongShortPositionRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *TopLongShortPositionRatioService) Limit(limit int) *TopLongShortPositionRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortPositionRatioService) StartTime(startTime int64) *TopLongShortPositionRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortPositionRatioService) EndTime(endTime int64) *TopLongShortPositionRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...common.RequestOption) (res []*LongShortPositionRatio, err error) {
+	r := common.NewGetRequestPublic("/futures/data/topLongShortPositionRatio")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*LongShortPositionRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// LongShortPositionRatio define long/short ratio of positions
+type LongShortPositionRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongPosition   string `json:"longPosition"`
+	ShortPosition  string `json:"shortPosition"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// LongShortRatioService list long/short ratio of all the accounts
+type LongShortRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *LongShortRatioService) Pair(pair string) *LongShortRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *LongShortRatioService) Limit(limit int) *LongShortRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *LongShortRatioService) StartTime(startTime int64) *LongShortRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *LongShortRatioService) Do(ctx context.Context, opts ...common.RequestOption) (res []*LongShortRatio, err error) {
+	r := common.NewGetRequestPublic("/futures/data/globalLongShortAccountRatio")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// LongShortRatio define long/short ratio of accounts
+type LongShortRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// TakerBuySellVolumeService list taker buy/sell volume of a pair
+type TakerBuySellVolumeService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *TakerBuySellVolumeService) Pair(pair string) *TakerBuySellVolumeService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, CURRENT_QUARTER, NEXT_QUARTER or PERPETUAL
+func (s *TakerBuySellVolumeService) ContractType(contractType ContractType) *TakerBuySellVolumeService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *TakerBuySellVolumeService) Period(period string) *TakerBuySellVolumeService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *TakerBuySellVolumeService) Limit(limit int) *TakerBuySellVolumeService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerBuySellVolumeService) StartTime(startTime int64) *TakerBuySellVolumeService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerBuySellVolumeService) EndTime(endTime int64) *TakerBuySellVolumeService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerBuySellVolumeService) Do(ctx context.Context, opts ...common.RequestOption) (res []*TakerBuySellVolume, err error) {
+	r := common.NewGetRequestPublic("/futures/data/takerBuySellVol")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("contractType", s.contractType)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*TakerBuySellVolume, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TakerBuySellVolume define taker buy/sell volume, in contracts and in base asset value
+type TakerBuySellVolume struct {
+	Pair              string       `json:"pair"`
+	ContractType      ContractType `json:"contractType"`
+	TakerBuyVol       string       `json:"takerBuyVol"`
+	TakerSellVol      string       `json:"takerSellVol"`
+	TakerBuyVolValue  string       `json:"takerBuyVolValue"`
+	TakerSellVolValue string       `json:"takerSellVolValue"`
+	Timestamp         int64        `json:"timestamp"`
+}
+
+// BasisService list basis of a pair
+type BasisService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *BasisService) Pair(pair string) *BasisService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, CURRENT_QUARTER, NEXT_QUARTER or PERPETUAL
+func (s *BasisService) ContractType(contractType ContractType) *BasisService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period, one of 5m, 15m, 30m, 1h, 2h, 4h, 6h, 12h and 1d
+func (s *BasisService) Period(period string) *BasisService {
+	s.period = period
+	return s
+}
+
+// Limit set limit, 30 by default and 500 at most
+func (s *BasisService) Limit(limit int) *BasisService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *BasisService) StartTime(startTime int64) *BasisService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *BasisService) EndTime(endTime int64) *BasisService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *BasisService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Basis, err error) {
+	r := common.NewGetRequestPublic("/futures/data/basis")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("contractType", s.contractType)
+	r.SetQuery("period", s.period)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*Basis, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Basis define basis
+type Basis struct {
+	Pair                string       `json:"pair"`
+	ContractType        ContractType `json:"contractType"`
+	IndexPrice          string       `json:"indexPrice"`
+	FuturesPrice        string       `json:"futuresPrice"`
+	Basis               string       `json:"basis"`
+	BasisRate           string       `json:"basisRate"`
+	AnnualizedBasisRate string       `json:"annualizedBasisRate"`
+	Timestamp           int64        `json:"timestamp"`
+}
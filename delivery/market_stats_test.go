@@ -0,0 +1,203 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marketStatsServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarketStatsService(t *testing.T) {
+	suite.Run(t, new(marketStatsServiceTestSuite))
+}
+
+func (s *marketStatsServiceTestSuite) assertRangeReq(params common.Params) {
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(params)
+		s.assertRequestEqual(e, r)
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestOpenInterest() {
+	data := []byte(`{
+		"symbol": "BTCUSD_200626",
+		"pair": "BTCUSD",
+		"openInterest": "15004",
+		"contractType": "CURRENT_QUARTER",
+		"time": 1591261042378
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"symbol": "BTCUSD_200626"})
+
+	res, err := s.client.NewOpenInterestService().Symbol("BTCUSD_200626").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&OpenInterest{
+		Symbol:       "BTCUSD_200626",
+		Pair:         "BTCUSD",
+		OpenInterest: "15004",
+		ContractType: ContractTypeCurrentQuarter,
+		Time:         1591261042378,
+	}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestOpenInterestStatistics() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "CURRENT_QUARTER",
+			"sumOpenInterest": "20403",
+			"sumOpenInterestValue": "176196512.23400000",
+			"timestamp": 1584490200000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{
+		"pair":         "BTCUSD",
+		"contractType": ContractTypeCurrentQuarter,
+		"period":       "5m",
+		"startTime":    1584490000000,
+	})
+
+	res, err := s.client.NewOpenInterestStatisticsService().Pair("BTCUSD").
+		ContractType(ContractTypeCurrentQuarter).Period("5m").StartTime(1584490000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*OpenInterestStatistic{{
+		Pair:                 "BTCUSD",
+		ContractType:         ContractTypeCurrentQuarter,
+		SumOpenInterest:      "20403",
+		SumOpenInterestValue: "176196512.23400000",
+		Timestamp:            1584490200000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) assertLongShortRatio(do func() ([]*LongShortRatio, error)) {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"pair": "BTCUSD", "period": "1h", "limit": 1})
+
+	res, err := do()
+	s.r().NoError(err)
+	s.r().Equal([]*LongShortRatio{{
+		Pair:           "BTCUSD",
+		LongShortRatio: "1.8105",
+		LongAccount:    "0.6442",
+		ShortAccount:   "0.3558",
+		Timestamp:      1583139600000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestTopLongShortAccountRatio() {
+	s.assertLongShortRatio(func() ([]*LongShortRatio, error) {
+		return s.client.NewTopLongShortAccountRatioService().Pair("BTCUSD").Period("1h").Limit(1).Do(newContext())
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestLongShortRatio() {
+	s.assertLongShortRatio(func() ([]*LongShortRatio, error) {
+		return s.client.NewLongShortRatioService().Pair("BTCUSD").Period("1h").Limit(1).Do(newContext())
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestTopLongShortPositionRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.7869",
+			"longPosition": "0.4404",
+			"shortPosition": "0.5596",
+			"timestamp": 1592956500000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"pair": "BTCUSD", "period": "1h", "limit": 1})
+
+	res, err := s.client.NewTopLongShortPositionRatioService().Pair("BTCUSD").Period("1h").Limit(1).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*LongShortPositionRatio{{
+		Pair:           "BTCUSD",
+		LongShortRatio: "0.7869",
+		LongPosition:   "0.4404",
+		ShortPosition:  "0.5596",
+		Timestamp:      1592956500000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "PERPETUAL",
+			"takerBuyVol": "387",
+			"takerSellVol": "248",
+			"takerBuyVolValue": "2342.1220",
+			"takerSellVolValue": "4213.9800",
+			"timestamp": 1592956500000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"pair": "BTCUSD", "contractType": ContractTypePerpetual, "period": "5m"})
+
+	res, err := s.client.NewTakerBuySellVolumeService().Pair("BTCUSD").ContractType(ContractTypePerpetual).
+		Period("5m").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*TakerBuySellVolume{{
+		Pair:              "BTCUSD",
+		ContractType:      ContractTypePerpetual,
+		TakerBuyVol:       "387",
+		TakerSellVol:      "248",
+		TakerBuyVolValue:  "2342.1220",
+		TakerSellVolValue: "4213.9800",
+		Timestamp:         1592956500000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestBasis() {
+	data := []byte(`[
+		{
+			"indexPrice": "29269.93972727",
+			"contractType": "CURRENT_QUARTER",
+			"basisRate": "0.0024",
+			"futuresPrice": "29341.3",
+			"annualizedBasisRate": "0.0283",
+			"basis": "71.36027273",
+			"pair": "BTCUSD",
+			"timestamp": 1653381600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"pair": "BTCUSD", "contractType": ContractTypeCurrentQuarter, "period": "1h"})
+
+	res, err := s.client.NewBasisService().Pair("BTCUSD").ContractType(ContractTypeCurrentQuarter).Period("1h").
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Basis{{
+		Pair:                "BTCUSD",
+		ContractType:        ContractTypeCurrentQuarter,
+		IndexPrice:          "29269.93972727",
+		FuturesPrice:        "29341.3",
+		Basis:               "71.36027273",
+		BasisRate:           "0.0024",
+		AnnualizedBasisRate: "0.0283",
+		Timestamp:           1653381600000,
+	}}, res)
+}
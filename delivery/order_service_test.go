@@ -600,3 +600,106 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"origQty": "1",
+		"side": "BUY",
+		"positionSide": "SHORT",
+		"priceMatch": "QUEUE",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":     "BTCUSD_PERP",
+			"orderId":    20072994037,
+			"side":       SideTypeBuy,
+			"quantity":   "1",
+			"priceMatch": PriceMatchTypeQueue,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrderID(20072994037).
+		Side(SideTypeBuy).Quantity("1").PriceMatch(PriceMatchTypeQueue).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(20072994037), res.OrderID)
+	s.r().Equal("30005", res.Price)
+	s.r().Equal(PriceMatchTypeQueue, res.PriceMatch)
+}
+
+func (s *orderServiceTestSuite) TestListOrderAmendments() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {
+					"before": "30004",
+					"after": "30003.2"
+				},
+				"origQty": {
+					"before": "1",
+					"after": "1"
+				},
+				"count": 3
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":            "BTCUSD_PERP",
+			"origClientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"startTime":         1629184560000,
+			"limit":             10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListOrderAmendmentsService().Symbol("BTCUSD_PERP").
+		OrigClientOrderID("LJ9R4QZDihCaS8UAOOLpgW").StartTime(1629184560000).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*OrderAmendment{{
+		AmendmentID:   5363,
+		Symbol:        "BTCUSD_PERP",
+		Pair:          "BTCUSD",
+		OrderID:       20072994037,
+		ClientOrderID: "LJ9R4QZDihCaS8UAOOLpgW",
+		Time:          1629184560899,
+		Amendment: OrderAmendDetail{
+			Price:        OrderAmendChange{Before: "30004", After: "30003.2"},
+			OrigQuantity: OrderAmendChange{Before: "1", After: "1"},
+			Count:        3,
+		},
+	}}, res)
+}
+
+func (s *orderServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{
+		"symbol": "BTCUSD_PERP",
+		"countdownTime": "100000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":        "BTCUSD_PERP",
+			"countdownTime": 100000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Symbol("BTCUSD_PERP").CountdownTime(100000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CountdownCancelAllResponse{Symbol: "BTCUSD_PERP", CountdownTime: "100000"}, res)
+}
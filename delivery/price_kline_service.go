@@ -0,0 +1,259 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MarkPriceKlinesService list mark price klines, the volume fields of the klines are unused
+type MarkPriceKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *MarkPriceKlinesService) Symbol(symbol string) *MarkPriceKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *MarkPriceKlinesService) Interval(interval string) *MarkPriceKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *MarkPriceKlinesService) Limit(limit int) *MarkPriceKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *MarkPriceKlinesService) StartTime(startTime int64) *MarkPriceKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MarkPriceKlinesService) EndTime(endTime int64) *MarkPriceKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *MarkPriceKlinesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Kline, err error) {
+	r := common.NewGetRequestPublic("/dapi/v1/markPriceKlines")
+	r.SetQuery("symbol", s.symbol)
+	r.SetQuery("interval", s.interval)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
+
+// IndexPriceKlinesService list index price klines of a pair, the volume fields of the klines are unused
+type IndexPriceKlinesService struct {
+	c         *Client
+	pair      string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *IndexPriceKlinesService) Pair(pair string) *IndexPriceKlinesService {
+	s.pair = pair
+	return s
+}
+
+// Interval set interval
+func (s *IndexPriceKlinesService) Interval(interval string) *IndexPriceKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *IndexPriceKlinesService) Limit(limit int) *IndexPriceKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *IndexPriceKlinesService) StartTime(startTime int64) *IndexPriceKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *IndexPriceKlinesService) EndTime(endTime int64) *IndexPriceKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *IndexPriceKlinesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Kline, err error) {
+	r := common.NewGetRequestPublic("/dapi/v1/indexPriceKlines")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("interval", s.interval)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
+
+// PremiumIndexKlinesService list premium index klines, the volume fields of the klines are unused
+type PremiumIndexKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *PremiumIndexKlinesService) Symbol(symbol string) *PremiumIndexKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *PremiumIndexKlinesService) Interval(interval string) *PremiumIndexKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *PremiumIndexKlinesService) Limit(limit int) *PremiumIndexKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *PremiumIndexKlinesService) StartTime(startTime int64) *PremiumIndexKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *PremiumIndexKlinesService) EndTime(endTime int64) *PremiumIndexKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *PremiumIndexKlinesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Kline, err error) {
+	r := common.NewGetRequestPublic("/dapi/v1/premiumIndexKlines")
+	r.SetQuery("symbol", s.symbol)
+	r.SetQuery("interval", s.interval)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
+
+// ContinuousKlinesService list klines of a continuous contract
+type ContinuousKlinesService struct {
+	c            *Client
+	pair         string
+	contractType ContractType
+	interval     string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *ContinuousKlinesService) Pair(pair string) *ContinuousKlinesService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType
+func (s *ContinuousKlinesService) ContractType(contractType ContractType) *ContinuousKlinesService {
+	s.contractType = contractType
+	return s
+}
+
+// Interval set interval
+func (s *ContinuousKlinesService) Interval(interval string) *ContinuousKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *ContinuousKlinesService) Limit(limit int) *ContinuousKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *ContinuousKlinesService) StartTime(startTime int64) *ContinuousKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ContinuousKlinesService) EndTime(endTime int64) *ContinuousKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ContinuousKlinesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Kline, err error) {
+	r := common.NewGetRequestPublic("/dapi/v1/continuousKlines")
+	r.SetQuery("pair", s.pair)
+	r.SetQuery("contractType", s.contractType)
+	r.SetQuery("interval", s.interval)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	data, err := s.c.CallAPIBytes(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
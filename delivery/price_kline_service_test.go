@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type priceKlineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPriceKlineService(t *testing.T) {
+	suite.Run(t, new(priceKlineServiceTestSuite))
+}
+
+// mockPriceKlines mocks a page holding a single mark price like kline.
+func (s *priceKlineServiceTestSuite) mockPriceKlines(params common.Params) {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9653.69440000",
+			"9653.69640000",
+			"9651.38600000",
+			"9651.55200000",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(params)
+		s.assertRequestEqual(e, r)
+	})
+}
+
+func (s *priceKlineServiceTestSuite) assertPriceKlines(res []*Kline, err error) {
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Kline{{
+		OpenTime:                 1591256400000,
+		Open:                     "9653.69440000",
+		High:                     "9653.69640000",
+		Low:                      "9651.38600000",
+		Close:                    "9651.55200000",
+		Volume:                   "0",
+		CloseTime:                1591256459999,
+		QuoteAssetVolume:         "0",
+		TradeNum:                 60,
+		TakerBuyBaseAssetVolume:  "0",
+		TakerBuyQuoteAssetVolume: "0",
+	}}, res)
+}
+
+func (s *priceKlineServiceTestSuite) TestMarkPriceKlines() {
+	s.mockPriceKlines(common.Params{
+		"symbol":    "BTCUSD_PERP",
+		"interval":  "1m",
+		"limit":     1,
+		"startTime": 1591256400000,
+		"endTime":   1591256459999,
+	})
+	defer s.assertDo()
+
+	s.assertPriceKlines(s.client.NewMarkPriceKlinesService().Symbol("BTCUSD_PERP").Interval("1m").Limit(1).
+		StartTime(1591256400000).EndTime(1591256459999).Do(newContext()))
+}
+
+func (s *priceKlineServiceTestSuite) TestIndexPriceKlines() {
+	s.mockPriceKlines(common.Params{"pair": "BTCUSD", "interval": "1m"})
+	defer s.assertDo()
+
+	s.assertPriceKlines(s.client.NewIndexPriceKlinesService().Pair("BTCUSD").Interval("1m").Do(newContext()))
+}
+
+func (s *priceKlineServiceTestSuite) TestPremiumIndexKlines() {
+	s.mockPriceKlines(common.Params{"symbol": "BTCUSD_PERP", "interval": "1m"})
+	defer s.assertDo()
+
+	s.assertPriceKlines(s.client.NewPremiumIndexKlinesService().Symbol("BTCUSD_PERP").Interval("1m").Do(newContext()))
+}
+
+func (s *priceKlineServiceTestSuite) TestContinuousKlines() {
+	s.mockPriceKlines(common.Params{"pair": "BTCUSD", "contractType": ContractTypePerpetual, "interval": "1m"})
+	defer s.assertDo()
+
+	s.assertPriceKlines(s.client.NewContinuousKlinesService().Pair("BTCUSD").ContractType(ContractTypePerpetual).
+		Interval("1m").Do(newContext()))
+}
+
+func (s *priceKlineServiceTestSuite) TestInvalidKlines() {
+	s.mockDo([]byte(`[[1591256400000, "9653.69440000"]]`), nil)
+	defer s.assertDo()
+
+	_, err := s.client.NewMarkPriceKlinesService().Symbol("BTCUSD_PERP").Interval("1m").Do(newContext())
+	s.r().Error(err)
+}
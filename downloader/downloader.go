@@ -0,0 +1,206 @@
+// Package downloader downloads historical market data from the spot, futures
+// and delivery APIs into daily CSV or JSON Lines files.
+//
+// Progress is checkpointed next to the output after every page, so running the
+// same job again resumes where an interrupted run stopped.
+package downloader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultWeightPerMinute is the request weight budget used when Config.WeightPerMinute
+// is not set. It stays below the lowest IP limit of the supported markets.
+const DefaultWeightPerMinute = 1000
+
+// ErrGap is returned by Run when Config.FailOnGap is set and a gap is found.
+var ErrGap = errors.New("downloader: data is not continuous")
+
+// Config define a download job
+type Config struct {
+	SourceConfig
+	// Start and End delimit the downloaded range [Start, End).
+	Start time.Time
+	End   time.Time
+	// Dir is the root directory, files are written to Job.Dir.
+	Dir    string
+	Format Format
+	// WeightPerMinute bounds the request weight spent per minute.
+	WeightPerMinute int
+	// Validate enables continuity checks on klines and aggregate trades.
+	Validate bool
+	// FailOnGap stops the download at the first gap instead of reporting it.
+	FailOnGap bool
+	// OnGap is called for every gap found when Validate is enabled.
+	OnGap func(gap Gap)
+	// OnProgress is called after every committed page.
+	OnProgress func(cursor Cursor)
+}
+
+// Downloader download a range of records from a source
+type Downloader struct {
+	config    Config
+	source    Source
+	limiter   *rate.Limiter
+	validator Validator
+	now       func() time.Time
+}
+
+// New create a downloader reading from source
+func New(source Source, config Config) (*Downloader, error) {
+	if config.Format == "" {
+		config.Format = FormatCSV
+	}
+	if config.Format != FormatCSV && config.Format != FormatJSONL {
+		return nil, fmt.Errorf("downloader: unknown format %q", config.Format)
+	}
+	if !config.Start.Before(config.End) {
+		return nil, errors.New("downloader: start must be before end")
+	}
+	if newRecord(config.DataType) == nil {
+		return nil, fmt.Errorf("downloader: unknown data type %q", config.DataType)
+	}
+	if config.WeightPerMinute <= 0 {
+		config.WeightPerMinute = DefaultWeightPerMinute
+	}
+	if source.Weight() > config.WeightPerMinute {
+		return nil, fmt.Errorf("downloader: request weight %d exceeds the budget of %d per minute",
+			source.Weight(), config.WeightPerMinute)
+	}
+	d := &Downloader{
+		config: config,
+		source: source,
+		limiter: rate.NewLimiter(rate.Limit(float64(config.WeightPerMinute)/60),
+			config.WeightPerMinute),
+		now: time.Now,
+	}
+	if config.Validate {
+		v, err := NewValidator(config.DataType, config.Interval)
+		if err != nil {
+			return nil, err
+		}
+		d.validator = v
+	}
+	return d, nil
+}
+
+// Dir returns the directory holding the files and the checkpoint of the job,
+// like <root>/futures/BTCUSDT/klines/1m or
+// <root>/futures/BTCUSDT/continuousKlines/PERPETUAL/1m.
+func (d *Downloader) Dir() string {
+	dir := filepath.Join(d.config.Dir, string(d.config.Market), d.config.Symbol,
+		string(d.config.DataType))
+	if d.config.DataType == DataTypeContinuousKlines {
+		dir = filepath.Join(dir, d.config.ContractType)
+	}
+	if isKlines(d.config.DataType) {
+		dir = filepath.Join(dir, d.config.Interval)
+	}
+	return dir
+}
+
+// Run download the configured range, resuming from the checkpoint if one exists.
+// It returns the gaps found when validation is enabled. Klines which are not
+// closed yet are not written, the download stops before them so that a later
+// run fetches them once closed.
+func (d *Downloader) Run(ctx context.Context) (gaps []Gap, err error) {
+	dir := d.Dir()
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	cp, err := LoadCheckpoint(dir)
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil {
+		cp = &Checkpoint{
+			Cursor: Cursor{Time: d.config.Start.UnixMilli()},
+			Files:  make(map[string]int64),
+		}
+	}
+
+	var prev Record
+	if len(cp.Last) > 0 {
+		prev = newRecord(d.config.DataType)
+		if err = json.Unmarshal(cp.Last, prev); err != nil {
+			return nil, fmt.Errorf("downloader: invalid checkpoint: %w", err)
+		}
+	}
+
+	w := newPartitionWriter(dir, d.config.Format, cp.Files)
+	defer func() {
+		if cerr := w.Close(); err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+
+	commit := func(next Cursor) error {
+		if err := w.Commit(); err != nil {
+			return err
+		}
+		if prev != nil {
+			last, err := json.Marshal(prev)
+			if err != nil {
+				return err
+			}
+			cp.Last = last
+		}
+		cp.Cursor = next
+		if err := saveCheckpoint(dir, cp); err != nil {
+			return err
+		}
+		if d.config.OnProgress != nil {
+			d.config.OnProgress(next)
+		}
+		return nil
+	}
+
+	end := d.config.End.UnixMilli() - 1
+	for cp.Cursor.Time <= end {
+		if err = d.limiter.WaitN(ctx, d.source.Weight()); err != nil {
+			return gaps, err
+		}
+		records, next, err := d.source.Fetch(ctx, cp.Cursor, end)
+		if err != nil {
+			return gaps, err
+		}
+		for _, r := range records {
+			if k, ok := r.(*Kline); ok && k.CloseTime >= d.now().UnixMilli() {
+				return gaps, commit(Cursor{Time: k.OpenTime})
+			}
+			if prev != nil && d.validator != nil {
+				if gap := d.validator.Check(prev, r); gap != nil {
+					gaps = append(gaps, *gap)
+					if d.config.OnGap != nil {
+						d.config.OnGap(*gap)
+					}
+					if d.config.FailOnGap {
+						if err = commit(prev.Next()); err != nil {
+							return gaps, err
+						}
+						return gaps, fmt.Errorf("%w: %s", ErrGap, gap)
+					}
+				}
+			}
+			if err = w.Write(r); err != nil {
+				return gaps, err
+			}
+			prev = r
+		}
+		if next == cp.Cursor {
+			return gaps, fmt.Errorf("downloader: no progress at cursor %+v", next)
+		}
+		if err = commit(next); err != nil {
+			return gaps, err
+		}
+	}
+	return gaps, nil
+}
@@ -0,0 +1,217 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const minute = int64(time.Minute / time.Millisecond)
+
+// testKlineSource serves one minute klines from a fixed set of open times.
+type testKlineSource struct {
+	openTimes []int64
+	pageSize  int
+	calls     int
+	failAt    int
+}
+
+func (s *testKlineSource) Weight() int {
+	return 1
+}
+
+func (s *testKlineSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	s.calls++
+	if s.failAt > 0 && s.calls == s.failAt {
+		return nil, cursor, errors.New("connection reset")
+	}
+	for _, t := range s.openTimes {
+		if t < cursor.Time || t > end {
+			continue
+		}
+		records = append(records, &Kline{OpenTime: t, CloseTime: t + minute - 1, Open: "1"})
+		if len(records) == s.pageSize {
+			return records, records[len(records)-1].Next(), nil
+		}
+	}
+	return records, Cursor{Time: end + 1}, nil
+}
+
+type downloaderTestSuite struct {
+	suite.Suite
+	dir   string
+	start time.Time
+}
+
+func TestDownloader(t *testing.T) {
+	suite.Run(t, new(downloaderTestSuite))
+}
+
+func (s *downloaderTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.start = time.Date(2023, 1, 1, 23, 58, 0, 0, time.UTC)
+}
+
+func (s *downloaderTestSuite) config(format Format) Config {
+	return Config{
+		SourceConfig: SourceConfig{
+			Market: MarketFutures, DataType: DataTypeKlines, Symbol: "BTCUSDT", Interval: "1m",
+		},
+		Start:           s.start,
+		End:             s.start.Add(4 * time.Minute),
+		Dir:             s.dir,
+		Format:          format,
+		WeightPerMinute: 6000,
+		Validate:        true,
+	}
+}
+
+func (s *downloaderTestSuite) openTimes(n int) []int64 {
+	var res []int64
+	for i := 0; i < n; i++ {
+		res = append(res, s.start.UnixMilli()+int64(i)*minute)
+	}
+	return res
+}
+
+func (s *downloaderTestSuite) readLines(d *Downloader, name string) []string {
+	data, err := os.ReadFile(filepath.Join(d.Dir(), name))
+	s.Require().NoError(err)
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func (s *downloaderTestSuite) TestPartitionByDay() {
+	source := &testKlineSource{openTimes: s.openTimes(4), pageSize: 3}
+	d, err := New(source, s.config(FormatCSV))
+	s.Require().NoError(err)
+	s.Equal(filepath.Join(s.dir, "futures", "BTCUSDT", "klines", "1m"), d.Dir())
+
+	gaps, err := d.Run(context.Background())
+	s.Require().NoError(err)
+	s.Empty(gaps)
+	s.Equal(2, source.calls)
+
+	first := s.readLines(d, "2023-01-01.csv")
+	s.Len(first, 3)
+	s.True(strings.HasPrefix(first[0], "open_time,"))
+	second := s.readLines(d, "2023-01-02.csv")
+	s.Len(second, 3)
+	s.True(strings.HasPrefix(second[1], "1672617600000,"))
+
+	cp, err := LoadCheckpoint(d.Dir())
+	s.Require().NoError(err)
+	s.Equal(s.config(FormatCSV).End.UnixMilli(), cp.Cursor.Time)
+}
+
+func (s *downloaderTestSuite) TestResume() {
+	source := &testKlineSource{openTimes: s.openTimes(4), pageSize: 1, failAt: 3}
+	d, err := New(source, s.config(FormatJSONL))
+	s.Require().NoError(err)
+	_, err = d.Run(context.Background())
+	s.Require().Error(err)
+
+	// simulate rows written after the last checkpoint by the interrupted run.
+	f, err := os.OpenFile(filepath.Join(d.Dir(), "2023-01-02.jsonl"), os.O_CREATE|os.O_WRONLY, 0o644)
+	s.Require().NoError(err)
+	_, err = f.WriteString(`{"openTime":1}` + "\n")
+	s.Require().NoError(err)
+	s.Require().NoError(f.Close())
+
+	source.failAt = 0
+	gaps, err := d.Run(context.Background())
+	s.Require().NoError(err)
+	s.Empty(gaps)
+	s.Len(s.readLines(d, "2023-01-01.jsonl"), 2)
+	lines := s.readLines(d, "2023-01-02.jsonl")
+	s.Len(lines, 2)
+	s.Contains(lines[0], `"openTime":1672617600000`)
+}
+
+func (s *downloaderTestSuite) TestOpenKline() {
+	source := &testKlineSource{openTimes: s.openTimes(4), pageSize: 10}
+	d, err := New(source, s.config(FormatJSONL))
+	s.Require().NoError(err)
+	now := s.start.Add(2*time.Minute + 30*time.Second)
+	d.now = func() time.Time { return now }
+
+	// the third kline is still open, the download stops before it.
+	gaps, err := d.Run(context.Background())
+	s.Require().NoError(err)
+	s.Empty(gaps)
+	s.Len(s.readLines(d, "2023-01-01.jsonl"), 2)
+	_, err = os.Stat(filepath.Join(d.Dir(), "2023-01-02.jsonl"))
+	s.True(os.IsNotExist(err))
+	cp, err := LoadCheckpoint(d.Dir())
+	s.Require().NoError(err)
+	s.Equal(s.start.Add(2*time.Minute).UnixMilli(), cp.Cursor.Time)
+
+	now = s.start.Add(time.Hour)
+	gaps, err = d.Run(context.Background())
+	s.Require().NoError(err)
+	s.Empty(gaps)
+	lines := s.readLines(d, "2023-01-02.jsonl")
+	s.Len(lines, 2)
+	s.Contains(lines[0], `"openTime":1672617600000`)
+}
+
+func (s *downloaderTestSuite) TestGap() {
+	openTimes := s.openTimes(4)
+	openTimes = append(openTimes[:1], openTimes[3:]...)
+	source := &testKlineSource{openTimes: openTimes, pageSize: 10}
+
+	var reported []Gap
+	config := s.config(FormatCSV)
+	config.OnGap = func(gap Gap) { reported = append(reported, gap) }
+	d, err := New(source, config)
+	s.Require().NoError(err)
+	gaps, err := d.Run(context.Background())
+	s.Require().NoError(err)
+	s.Equal([]Gap{{From: openTimes[0], To: openTimes[1], Missing: 2}}, gaps)
+	s.Equal(gaps, reported)
+
+	s.Require().NoError(os.RemoveAll(s.dir))
+	config.FailOnGap = true
+	d, err = New(source, config)
+	s.Require().NoError(err)
+	_, err = d.Run(context.Background())
+	s.ErrorIs(err, ErrGap)
+	cp, err := LoadCheckpoint(d.Dir())
+	s.Require().NoError(err)
+	s.Equal(openTimes[0]+1, cp.Cursor.Time)
+}
+
+func (s *downloaderTestSuite) TestInvalidConfig() {
+	source := &testKlineSource{}
+	config := s.config(Format("xml"))
+	_, err := New(source, config)
+	s.Error(err)
+
+	config = s.config(FormatCSV)
+	config.End = config.Start
+	_, err = New(source, config)
+	s.Error(err)
+
+	config = s.config(FormatCSV)
+	config.Interval = "1x"
+	_, err = New(source, config)
+	s.Error(err)
+}
+
+func (s *downloaderTestSuite) TestIntervalDuration() {
+	for interval, expected := range map[string]time.Duration{
+		"1s": time.Second, "15m": 15 * time.Minute, "4h": 4 * time.Hour,
+		"3d": 72 * time.Hour, "1w": 7 * 24 * time.Hour, "1M": 0,
+	} {
+		d, err := IntervalDuration(interval)
+		s.Require().NoError(err, interval)
+		s.Equal(expected, d, interval)
+	}
+}
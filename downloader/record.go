@@ -0,0 +1,138 @@
+package downloader
+
+import (
+	"strconv"
+)
+
+// Cursor define the position a download resumes from
+type Cursor struct {
+	// Time is the next start time in milliseconds.
+	Time int64 `json:"time"`
+	// ID is the next id for sources paginated by id, zero if unused.
+	ID int64 `json:"id,omitempty"`
+}
+
+// Record define a single row of downloaded data
+type Record interface {
+	// Timestamp returns the time in milliseconds used to partition the record by day.
+	Timestamp() int64
+	// Next returns the cursor pointing right after this record.
+	Next() Cursor
+	// CSVHeader returns the column names of the CSV representation.
+	CSVHeader() []string
+	// CSVRecord returns the CSV representation of the record.
+	CSVRecord() []string
+}
+
+// Kline define kline record, it has the same layout as the kline of each market
+type Kline struct {
+	OpenTime                 int64  `json:"openTime"`
+	Open                     string `json:"open"`
+	High                     string `json:"high"`
+	Low                      string `json:"low"`
+	Close                    string `json:"close"`
+	Volume                   string `json:"volume"`
+	CloseTime                int64  `json:"closeTime"`
+	QuoteAssetVolume         string `json:"quoteAssetVolume"`
+	TradeNum                 int64  `json:"tradeNum"`
+	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
+	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
+}
+
+func (k *Kline) Timestamp() int64 {
+	return k.OpenTime
+}
+
+func (k *Kline) Next() Cursor {
+	return Cursor{Time: k.OpenTime + 1}
+}
+
+func (k *Kline) CSVHeader() []string {
+	return []string{
+		"open_time", "open", "high", "low", "close", "volume", "close_time",
+		"quote_asset_volume", "trade_num", "taker_buy_base_asset_volume",
+		"taker_buy_quote_asset_volume",
+	}
+}
+
+func (k *Kline) CSVRecord() []string {
+	return []string{
+		formatInt(k.OpenTime), k.Open, k.High, k.Low, k.Close, k.Volume,
+		formatInt(k.CloseTime), k.QuoteAssetVolume, formatInt(k.TradeNum),
+		k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume,
+	}
+}
+
+// AggTrade define aggregate trade record
+type AggTrade struct {
+	AggTradeID   int64  `json:"a"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	FirstTradeID int64  `json:"f"`
+	LastTradeID  int64  `json:"l"`
+	Time         int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+func (t *AggTrade) Timestamp() int64 {
+	return t.Time
+}
+
+func (t *AggTrade) Next() Cursor {
+	return Cursor{Time: t.Time, ID: t.AggTradeID + 1}
+}
+
+func (t *AggTrade) CSVHeader() []string {
+	return []string{
+		"agg_trade_id", "price", "quantity", "first_trade_id", "last_trade_id",
+		"timestamp", "is_buyer_maker",
+	}
+}
+
+func (t *AggTrade) CSVRecord() []string {
+	return []string{
+		formatInt(t.AggTradeID), t.Price, t.Quantity, formatInt(t.FirstTradeID),
+		formatInt(t.LastTradeID), formatInt(t.Time), strconv.FormatBool(t.IsBuyerMaker),
+	}
+}
+
+// FundingRate define funding rate record
+type FundingRate struct {
+	Symbol      string `json:"symbol"`
+	FundingRate string `json:"fundingRate"`
+	FundingTime int64  `json:"fundingTime"`
+}
+
+func (f *FundingRate) Timestamp() int64 {
+	return f.FundingTime
+}
+
+func (f *FundingRate) Next() Cursor {
+	return Cursor{Time: f.FundingTime + 1}
+}
+
+func (f *FundingRate) CSVHeader() []string {
+	return []string{"symbol", "funding_rate", "funding_time"}
+}
+
+func (f *FundingRate) CSVRecord() []string {
+	return []string{f.Symbol, f.FundingRate, formatInt(f.FundingTime)}
+}
+
+// newRecord returns an empty record of a data type.
+func newRecord(dataType DataType) Record {
+	if isKlines(dataType) {
+		return new(Kline)
+	}
+	switch dataType {
+	case DataTypeAggTrades:
+		return new(AggTrade)
+	case DataTypeFundingRate:
+		return new(FundingRate)
+	}
+	return nil
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
@@ -0,0 +1,488 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+)
+
+// Market define the market a source downloads from
+type Market string
+
+// DataType define the kind of data a source downloads
+type DataType string
+
+// Global enums
+const (
+	MarketSpot     Market = "spot"
+	MarketFutures  Market = "futures"
+	MarketDelivery Market = "delivery"
+
+	DataTypeKlines             DataType = "klines"
+	DataTypeMarkPriceKlines    DataType = "markPriceKlines"
+	DataTypeIndexPriceKlines   DataType = "indexPriceKlines"
+	DataTypePremiumIndexKlines DataType = "premiumIndexKlines"
+	DataTypeContinuousKlines   DataType = "continuousKlines"
+	DataTypeAggTrades          DataType = "aggTrades"
+	DataTypeFundingRate        DataType = "fundingRate"
+)
+
+// isKlines reports whether records of dataType are klines.
+func isKlines(dataType DataType) bool {
+	switch dataType {
+	case DataTypeKlines, DataTypeMarkPriceKlines, DataTypeIndexPriceKlines,
+		DataTypePremiumIndexKlines, DataTypeContinuousKlines:
+		return true
+	}
+	return false
+}
+
+const (
+	klineLimit       = 1000
+	aggTradeLimit    = 1000
+	fundingRateLimit = 1000
+
+	// aggTradeWindow is the maximum distance between startTime and endTime
+	// accepted by the aggTrades endpoints.
+	aggTradeWindow = time.Hour
+	// deliveryKlineWindow is the maximum distance between startTime and
+	// endTime accepted by the delivery klines endpoints.
+	deliveryKlineWindow = 200 * 24 * time.Hour
+)
+
+// ErrUnsupported is returned when a market does not provide the requested data type.
+var ErrUnsupported = errors.New("downloader: data type not supported by market")
+
+// Source fetches pages of records for a single symbol and data type
+type Source interface {
+	// Weight returns the request weight consumed by a single Fetch call.
+	Weight() int
+	// Fetch returns the page of records starting at cursor and not later than end,
+	// together with the cursor of the next page.
+	Fetch(ctx context.Context, cursor Cursor, end int64) (records []Record, next Cursor, err error)
+}
+
+// SourceConfig define the parameters of a source
+type SourceConfig struct {
+	Market   Market
+	DataType DataType
+	// Symbol is the pair for DataTypeIndexPriceKlines and DataTypeContinuousKlines.
+	Symbol string
+	// Interval is the kline interval, only used by the kline data types.
+	Interval string
+	// ContractType is the contract type of DataTypeContinuousKlines, like PERPETUAL.
+	ContractType string
+}
+
+// NewSource create a source for config. clients for markets other than
+// config.Market may be nil.
+func NewSource(config SourceConfig, spot *binance.Client, fc *futures.Client,
+	dc *delivery.Client,
+) (Source, error) {
+	if config.DataType == DataTypeContinuousKlines && config.ContractType == "" {
+		return nil, errors.New("downloader: contract type is required for continuous klines")
+	}
+	switch config.Market {
+	case MarketSpot:
+		if spot == nil {
+			return nil, errors.New("downloader: spot client is required")
+		}
+		switch config.DataType {
+		case DataTypeKlines:
+			return &spotKlineSource{c: spot, symbol: config.Symbol, interval: config.Interval}, nil
+		case DataTypeAggTrades:
+			return &spotAggTradeSource{c: spot, symbol: config.Symbol}, nil
+		}
+	case MarketFutures:
+		if fc == nil {
+			return nil, errors.New("downloader: futures client is required")
+		}
+		switch config.DataType {
+		case DataTypeKlines:
+			return &futuresKlineSource{c: fc, symbol: config.Symbol, interval: config.Interval}, nil
+		case DataTypeAggTrades:
+			return &futuresAggTradeSource{c: fc, symbol: config.Symbol}, nil
+		case DataTypeFundingRate:
+			return &futuresFundingRateSource{c: fc, symbol: config.Symbol}, nil
+		}
+		if fetch := futuresPriceKlines(fc, config); fetch != nil {
+			return &priceKlineSource{fetch: fetch}, nil
+		}
+	case MarketDelivery:
+		if dc == nil {
+			return nil, errors.New("downloader: delivery client is required")
+		}
+		switch config.DataType {
+		case DataTypeKlines:
+			return &deliveryKlineSource{c: dc, symbol: config.Symbol, interval: config.Interval}, nil
+		}
+		if fetch := deliveryPriceKlines(dc, config); fetch != nil {
+			return &priceKlineSource{fetch: fetch, windowEnd: func(start, end int64) int64 {
+				return deliveryKlineWindowEnd(config.Interval, start, end)
+			}}, nil
+		}
+	default:
+		return nil, fmt.Errorf("downloader: unknown market %q", config.Market)
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrUnsupported, config.Market, config.DataType)
+}
+
+// futuresKlineWeight returns the weight of the futures and delivery klines
+// endpoints, which depends on the limit parameter.
+func futuresKlineWeight(limit int) int {
+	switch {
+	case limit < 100:
+		return 1
+	case limit < 500:
+		return 2
+	case limit <= 1000:
+		return 5
+	}
+	return 10
+}
+
+// deliveryKlineWindowEnd returns the end of the delivery klines window
+// starting at start. The delivery endpoints reject longer windows and return
+// the latest klines of windows holding more than one page, so a window holds
+// at most one page.
+func deliveryKlineWindowEnd(interval string, start, end int64) int64 {
+	window := deliveryKlineWindow
+	if d, _ := IntervalDuration(interval); d > 0 && d*klineLimit < window {
+		window = d * klineLimit
+	}
+	if windowEnd := start + int64(window/time.Millisecond) - 1; windowEnd < end {
+		return windowEnd
+	}
+	return end
+}
+
+// klinePage returns the cursor following a page of klines.
+func klinePage(records []Record, end int64) Cursor {
+	if len(records) < klineLimit {
+		return Cursor{Time: end + 1}
+	}
+	return records[len(records)-1].Next()
+}
+
+type spotKlineSource struct {
+	c        *binance.Client
+	symbol   string
+	interval string
+}
+
+func (s *spotKlineSource) Weight() int {
+	return 2
+}
+
+func (s *spotKlineSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	res, err := s.c.NewKlinesService().Symbol(s.symbol).Interval(s.interval).
+		StartTime(cursor.Time).EndTime(end).Limit(klineLimit).Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, k := range res {
+		x := Kline(*k)
+		records = append(records, &x)
+	}
+	return records, klinePage(records, end), nil
+}
+
+type futuresKlineSource struct {
+	c        *futures.Client
+	symbol   string
+	interval string
+}
+
+func (s *futuresKlineSource) Weight() int {
+	return futuresKlineWeight(klineLimit)
+}
+
+func (s *futuresKlineSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	res, err := s.c.NewKlinesService().Symbol(s.symbol).Interval(s.interval).
+		StartTime(cursor.Time).EndTime(end).Limit(klineLimit).Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, k := range res {
+		x := Kline(*k)
+		records = append(records, &x)
+	}
+	return records, klinePage(records, end), nil
+}
+
+type deliveryKlineSource struct {
+	c        *delivery.Client
+	symbol   string
+	interval string
+}
+
+func (s *deliveryKlineSource) Weight() int {
+	return futuresKlineWeight(klineLimit)
+}
+
+func (s *deliveryKlineSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	windowEnd := deliveryKlineWindowEnd(s.interval, cursor.Time, end)
+	res, err := s.c.NewKlinesService().Symbol(s.symbol).Interval(s.interval).
+		StartTime(cursor.Time).EndTime(windowEnd).Limit(klineLimit).Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, k := range res {
+		x := Kline(*k)
+		records = append(records, &x)
+	}
+	return records, klinePage(records, windowEnd), nil
+}
+
+// klineFetcher returns the klines opened between start and end.
+type klineFetcher func(ctx context.Context, start, end int64) ([]*Kline, error)
+
+// priceKlineSource downloads the mark price, index price, premium index and
+// continuous contract klines, which share the layout and the pagination of
+// the trade klines.
+type priceKlineSource struct {
+	fetch klineFetcher
+	// windowEnd bounds the window of a request starting at start, nil when
+	// the endpoints accept any window.
+	windowEnd func(start, end int64) int64
+}
+
+func (s *priceKlineSource) Weight() int {
+	return futuresKlineWeight(klineLimit)
+}
+
+func (s *priceKlineSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	if s.windowEnd != nil {
+		end = s.windowEnd(cursor.Time, end)
+	}
+	res, err := s.fetch(ctx, cursor.Time, end)
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, k := range res {
+		records = append(records, k)
+	}
+	return records, klinePage(records, end), nil
+}
+
+// futuresPriceKlines returns the fetcher of a futures kline data type other
+// than DataTypeKlines, nil if there is none.
+func futuresPriceKlines(c *futures.Client, config SourceConfig) klineFetcher {
+	convert := func(res []*futures.Kline, err error) ([]*Kline, error) {
+		klines := make([]*Kline, len(res))
+		for i, k := range res {
+			x := Kline(*k)
+			klines[i] = &x
+		}
+		return klines, err
+	}
+	switch config.DataType {
+	case DataTypeMarkPriceKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewMarkPriceKlinesService().Symbol(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypeIndexPriceKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewIndexPriceKlinesService().Pair(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypePremiumIndexKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewPremiumIndexKlinesService().Symbol(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypeContinuousKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewContinuousKlinesService().Pair(config.Symbol).
+				ContractType(futures.ContractType(config.ContractType)).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	}
+	return nil
+}
+
+// deliveryPriceKlines returns the fetcher of a delivery kline data type other
+// than DataTypeKlines, nil if there is none.
+func deliveryPriceKlines(c *delivery.Client, config SourceConfig) klineFetcher {
+	convert := func(res []*delivery.Kline, err error) ([]*Kline, error) {
+		klines := make([]*Kline, len(res))
+		for i, k := range res {
+			x := Kline(*k)
+			klines[i] = &x
+		}
+		return klines, err
+	}
+	switch config.DataType {
+	case DataTypeMarkPriceKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewMarkPriceKlinesService().Symbol(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypeIndexPriceKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewIndexPriceKlinesService().Pair(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypePremiumIndexKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewPremiumIndexKlinesService().Symbol(config.Symbol).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	case DataTypeContinuousKlines:
+		return func(ctx context.Context, start, end int64) ([]*Kline, error) {
+			return convert(c.NewContinuousKlinesService().Pair(config.Symbol).
+				ContractType(delivery.ContractType(config.ContractType)).Interval(config.Interval).
+				StartTime(start).EndTime(end).Limit(klineLimit).Do(ctx))
+		}
+	}
+	return nil
+}
+
+// aggTradePage turns a page of aggregate trades into records and the next cursor.
+// Pages are requested by time window until the first trade is known, then by id
+// so that no trade is skipped between pages.
+func aggTradePage(trades []*AggTrade, cursor Cursor, end int64) (records []Record, next Cursor) {
+	for _, t := range trades {
+		if t.Time > end {
+			return records, Cursor{Time: end + 1}
+		}
+		records = append(records, t)
+	}
+	if len(records) > 0 {
+		return records, records[len(records)-1].Next()
+	}
+	if cursor.ID > 0 {
+		// no trade after the last id yet, the range is exhausted.
+		return records, Cursor{Time: end + 1}
+	}
+	return records, Cursor{Time: cursor.Time + int64(aggTradeWindow/time.Millisecond)}
+}
+
+// aggTradeWindowEnd returns the end of the time window starting at cursor.
+func aggTradeWindowEnd(cursor Cursor, end int64) int64 {
+	windowEnd := cursor.Time + int64(aggTradeWindow/time.Millisecond) - 1
+	if windowEnd > end {
+		return end
+	}
+	return windowEnd
+}
+
+type spotAggTradeSource struct {
+	c      *binance.Client
+	symbol string
+}
+
+func (s *spotAggTradeSource) Weight() int {
+	return 2
+}
+
+func (s *spotAggTradeSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	service := s.c.NewAggTradesService().Symbol(s.symbol).Limit(aggTradeLimit)
+	if cursor.ID > 0 {
+		service.FromID(cursor.ID)
+	} else {
+		service.StartTime(cursor.Time).EndTime(aggTradeWindowEnd(cursor, end))
+	}
+	res, err := service.Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	trades := make([]*AggTrade, 0, len(res))
+	for _, t := range res {
+		trades = append(trades, &AggTrade{
+			AggTradeID:   t.AggTradeID,
+			Price:        t.Price,
+			Quantity:     t.Quantity,
+			FirstTradeID: t.FirstTradeID,
+			LastTradeID:  t.LastTradeID,
+			Time:         t.Timestamp,
+			IsBuyerMaker: t.IsBuyerMaker,
+		})
+	}
+	records, next = aggTradePage(trades, cursor, end)
+	return records, next, nil
+}
+
+type futuresAggTradeSource struct {
+	c      *futures.Client
+	symbol string
+}
+
+func (s *futuresAggTradeSource) Weight() int {
+	return 20
+}
+
+func (s *futuresAggTradeSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	service := s.c.NewAggTradesService().Symbol(s.symbol).Limit(aggTradeLimit)
+	if cursor.ID > 0 {
+		service.FromID(cursor.ID)
+	} else {
+		service.StartTime(cursor.Time).EndTime(aggTradeWindowEnd(cursor, end))
+	}
+	res, err := service.Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	trades := make([]*AggTrade, 0, len(res))
+	for _, t := range res {
+		trades = append(trades, &AggTrade{
+			AggTradeID:   t.AggTradeID,
+			Price:        t.Price,
+			Quantity:     t.Quantity,
+			FirstTradeID: t.FirstTradeID,
+			LastTradeID:  t.LastTradeID,
+			Time:         t.Timestamp,
+			IsBuyerMaker: t.IsBuyerMaker,
+		})
+	}
+	records, next = aggTradePage(trades, cursor, end)
+	return records, next, nil
+}
+
+type futuresFundingRateSource struct {
+	c      *futures.Client
+	symbol string
+}
+
+func (s *futuresFundingRateSource) Weight() int {
+	return 1
+}
+
+func (s *futuresFundingRateSource) Fetch(ctx context.Context, cursor Cursor, end int64) (
+	records []Record, next Cursor, err error,
+) {
+	res, err := s.c.NewFundingRateService().Symbol(s.symbol).StartTime(cursor.Time).
+		EndTime(end).Limit(fundingRateLimit).Do(ctx)
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, f := range res {
+		records = append(records, &FundingRate{
+			Symbol:      f.Symbol,
+			FundingRate: f.FundingRate,
+			FundingTime: f.FundingTime,
+		})
+	}
+	if len(records) < fundingRateLimit {
+		return records, Cursor{Time: end + 1}, nil
+	}
+	return records, records[len(records)-1].Next(), nil
+}
@@ -0,0 +1,186 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuturesAggTradeSource(t *testing.T) {
+	var queries []string
+	replies := []string{
+		`[{"a":10,"p":"1","q":"1","f":100,"l":100,"T":1000,"m":true}]`,
+		`[{"a":11,"p":"1","q":"1","f":101,"l":101,"T":2000,"m":false},
+		  {"a":12,"p":"1","q":"1","f":102,"l":102,"T":9000,"m":false}]`,
+	}
+	c := futures.NewClient("", "", false)
+	c.UpdateDoFunc(func(req *http.Request) (*http.Response, error) {
+		queries = append(queries, req.URL.RawQuery)
+		body := replies[len(queries)-1]
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	})
+
+	source, err := NewSource(SourceConfig{
+		Market: MarketFutures, DataType: DataTypeAggTrades, Symbol: "BTCUSDT",
+	}, nil, c, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 20, source.Weight())
+
+	records, next, err := source.Fetch(context.Background(), Cursor{Time: 500}, 5000)
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, Cursor{Time: 1000, ID: 11}, next)
+	assert.Contains(t, queries[0], "startTime=500")
+	assert.Contains(t, queries[0], "endTime=5000")
+
+	records, next, err = source.Fetch(context.Background(), next, 5000)
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, Cursor{Time: 5001}, next)
+	assert.Contains(t, queries[1], "fromId=11")
+	assert.NotContains(t, queries[1], "startTime")
+}
+
+func TestNewSourceUnsupported(t *testing.T) {
+	_, err := NewSource(SourceConfig{Market: MarketDelivery, DataType: DataTypeAggTrades},
+		nil, nil, delivery.NewClient("", "", false))
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = NewSource(SourceConfig{Market: MarketDelivery, DataType: DataTypeFundingRate},
+		nil, nil, delivery.NewClient("", "", false))
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = NewSource(SourceConfig{Market: MarketDelivery, DataType: DataTypeContinuousKlines,
+		Symbol: "BTCUSD", Interval: "1m"}, nil, nil, delivery.NewClient("", "", false))
+	assert.EqualError(t, err, "downloader: contract type is required for continuous klines")
+}
+
+func TestDeliveryContinuousKlineSource(t *testing.T) {
+	var paths []string
+	c := delivery.NewClient("", "", false)
+	c.UpdateDoFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path+"?"+req.URL.RawQuery)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(bytes.NewBufferString(
+				`[[60000,"1","2","0.5","1.5","10",119999,"100",5,"4","40"]]`)),
+		}, nil
+	})
+
+	source, err := NewSource(SourceConfig{
+		Market: MarketDelivery, DataType: DataTypeContinuousKlines, Symbol: "BTCUSD",
+		Interval: "1m", ContractType: "PERPETUAL",
+	}, nil, nil, c)
+	require.NoError(t, err)
+	assert.Equal(t, 5, source.Weight())
+
+	records, next, err := source.Fetch(context.Background(), Cursor{Time: 0}, 200000)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, &Kline{
+		OpenTime: 60000, Open: "1", High: "2", Low: "0.5", Close: "1.5", Volume: "10",
+		CloseTime: 119999, QuoteAssetVolume: "100", TradeNum: 5,
+		TakerBuyBaseAssetVolume: "4", TakerBuyQuoteAssetVolume: "40",
+	}, records[0])
+	assert.Equal(t, Cursor{Time: 200001}, next)
+	require.Len(t, paths, 1)
+	assert.Contains(t, paths[0], "/dapi/v1/continuousKlines?")
+	assert.Contains(t, paths[0], "contractType=PERPETUAL")
+	assert.Contains(t, paths[0], "pair=BTCUSD")
+}
+
+// klineServer answers kline requests like the delivery endpoints: it rejects
+// windows longer than 200 days and returns the latest klines of the window.
+func klineServer(t *testing.T, interval int64, windows *[][2]int64) func(req *http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		start, err := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		require.NoError(t, err)
+		end, err := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		require.NoError(t, err)
+		limit, err := strconv.Atoi(query.Get("limit"))
+		require.NoError(t, err)
+		*windows = append(*windows, [2]int64{start, end})
+		if end-start >= 200*24*3600*1000 {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"code":-1130,"msg":"invalid window"}`)),
+			}, nil
+		}
+		var rows []string
+		for open := (start + interval - 1) / interval * interval; open <= end; open += interval {
+			rows = append(rows, fmt.Sprintf(`[%d,"1","1","1","1","1",%d,"1",1,"1","1"]`, open, open+interval-1))
+		}
+		if len(rows) > limit {
+			rows = rows[len(rows)-limit:]
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString("[" + strings.Join(rows, ",") + "]")),
+		}, nil
+	}
+}
+
+func TestDeliveryKlineSourcePages(t *testing.T) {
+	for _, tc := range []struct {
+		dataType DataType
+		interval string
+		duration int64
+		count    int64
+	}{
+		{dataType: DataTypeKlines, interval: "1d", duration: 24 * 3600 * 1000, count: 300},
+		{dataType: DataTypeKlines, interval: "1m", duration: 60 * 1000, count: 2500},
+		{dataType: DataTypeMarkPriceKlines, interval: "1d", duration: 24 * 3600 * 1000, count: 300},
+		{dataType: DataTypeContinuousKlines, interval: "1m", duration: 60 * 1000, count: 2500},
+	} {
+		var windows [][2]int64
+		c := delivery.NewClient("", "", false)
+		c.UpdateDoFunc(klineServer(t, tc.duration, &windows))
+		source, err := NewSource(SourceConfig{
+			Market: MarketDelivery, DataType: tc.dataType, Symbol: "BTCUSD", Interval: tc.interval,
+			ContractType: "PERPETUAL",
+		}, nil, nil, c)
+		require.NoError(t, err)
+
+		var opens []int64
+		end := tc.count*tc.duration - 1
+		for cursor := (Cursor{}); cursor.Time <= end; {
+			records, next, err := source.Fetch(context.Background(), cursor, end)
+			require.NoError(t, err, tc.dataType, tc.interval)
+			for _, r := range records {
+				opens = append(opens, r.Timestamp())
+			}
+			require.NotEqual(t, cursor, next, tc.dataType, tc.interval)
+			cursor = next
+		}
+		require.Len(t, opens, int(tc.count), tc.dataType, tc.interval)
+		for i, open := range opens {
+			assert.Equal(t, int64(i)*tc.duration, open, tc.dataType, tc.interval)
+		}
+		assert.Greater(t, len(windows), 1, tc.dataType, tc.interval)
+	}
+}
+
+func TestFuturesMarkPriceKlineSource(t *testing.T) {
+	source, err := NewSource(SourceConfig{
+		Market: MarketFutures, DataType: DataTypeMarkPriceKlines, Symbol: "BTCUSDT", Interval: "1h",
+	}, nil, futures.NewClient("", "", false), nil)
+	require.NoError(t, err)
+	assert.IsType(t, &priceKlineSource{}, source)
+
+	v, err := NewValidator(DataTypeMarkPriceKlines, "1h")
+	require.NoError(t, err)
+	assert.Equal(t, klineValidator{interval: 3600000}, v)
+}
@@ -0,0 +1,104 @@
+package downloader
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Gap define a discontinuity found between two consecutive records
+type Gap struct {
+	// From and To are the timestamps of the records around the gap.
+	From int64
+	To   int64
+	// Missing is the number of klines or trade ids missing between the records.
+	Missing int64
+}
+
+func (g Gap) String() string {
+	return fmt.Sprintf("gap of %d between %s and %s", g.Missing,
+		time.UnixMilli(g.From).UTC().Format(time.RFC3339),
+		time.UnixMilli(g.To).UTC().Format(time.RFC3339))
+}
+
+// Validator checks that consecutive records are continuous
+type Validator interface {
+	// Check returns a non nil gap if cur does not immediately follow prev.
+	Check(prev, cur Record) *Gap
+}
+
+// NewValidator returns the validator of a data type, nil if the data type has
+// no continuity rule.
+func NewValidator(dataType DataType, interval string) (Validator, error) {
+	switch {
+	case isKlines(dataType):
+		d, err := IntervalDuration(interval)
+		if err != nil {
+			return nil, err
+		}
+		if d == 0 {
+			return nil, nil
+		}
+		return klineValidator{interval: int64(d / time.Millisecond)}, nil
+	case dataType == DataTypeAggTrades:
+		return aggTradeValidator{}, nil
+	}
+	return nil, nil
+}
+
+// IntervalDuration returns the duration of a kline interval like 1m, 4h or 1w.
+// Month intervals have no fixed duration and return zero.
+func IntervalDuration(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("downloader: invalid interval %q", interval)
+	}
+	n, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("downloader: invalid interval %q", interval)
+	}
+	switch interval[len(interval)-1] {
+	case 's':
+		return time.Duration(n) * time.Second, nil
+	case 'm':
+		return time.Duration(n) * time.Minute, nil
+	case 'h':
+		return time.Duration(n) * time.Hour, nil
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	case 'M':
+		return 0, nil
+	}
+	return 0, fmt.Errorf("downloader: invalid interval %q", interval)
+}
+
+type klineValidator struct {
+	interval int64
+}
+
+func (v klineValidator) Check(prev, cur Record) *Gap {
+	p, c := prev.(*Kline), cur.(*Kline)
+	if c.OpenTime-p.OpenTime == v.interval {
+		return nil
+	}
+	return &Gap{
+		From:    p.OpenTime,
+		To:      c.OpenTime,
+		Missing: (c.OpenTime-p.OpenTime)/v.interval - 1,
+	}
+}
+
+type aggTradeValidator struct{}
+
+func (aggTradeValidator) Check(prev, cur Record) *Gap {
+	p, c := prev.(*AggTrade), cur.(*AggTrade)
+	if c.AggTradeID-p.AggTradeID == 1 {
+		return nil
+	}
+	return &Gap{
+		From:    p.Time,
+		To:      c.Time,
+		Missing: c.AggTradeID - p.AggTradeID - 1,
+	}
+}
@@ -0,0 +1,199 @@
+package downloader
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Format define the output file format
+type Format string
+
+// Global enums
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+const checkpointFileName = ".checkpoint.json"
+
+// Checkpoint define the progress of a download persisted next to its output
+type Checkpoint struct {
+	// Cursor is where the next page starts.
+	Cursor Cursor `json:"cursor"`
+	// Last is the last record written, used to validate continuity across
+	// restarts. It is empty before the first record.
+	Last json.RawMessage `json:"last,omitempty"`
+	// Files maps the partition file name to its committed size in bytes.
+	Files map[string]int64 `json:"files"`
+}
+
+// LoadCheckpoint read the checkpoint stored in dir, it returns nil if none exists
+func LoadCheckpoint(dir string) (*Checkpoint, error) {
+	data, err := os.ReadFile(filepath.Join(dir, checkpointFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cp := new(Checkpoint)
+	if err = json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("downloader: invalid checkpoint: %w", err)
+	}
+	if cp.Files == nil {
+		cp.Files = make(map[string]int64)
+	}
+	return cp, nil
+}
+
+// saveCheckpoint write cp to dir atomically
+func saveCheckpoint(dir string, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, checkpointFileName+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, checkpointFileName))
+}
+
+// partitionWriter write records into one file per UTC day
+type partitionWriter struct {
+	dir    string
+	format Format
+	// committed holds the size of each file at the last checkpoint, files are
+	// truncated to it the first time they are opened so that rows written after
+	// the checkpoint by an interrupted run are discarded.
+	committed map[string]int64
+	opened    map[string]bool
+
+	name   string
+	file   *os.File
+	empty  bool
+	buffer *bufio.Writer
+	csv    *csv.Writer
+}
+
+func newPartitionWriter(dir string, format Format, committed map[string]int64) *partitionWriter {
+	return &partitionWriter{
+		dir:       dir,
+		format:    format,
+		committed: committed,
+		opened:    make(map[string]bool),
+	}
+}
+
+// fileName returns the partition file name of a timestamp in milliseconds.
+func (w *partitionWriter) fileName(ts int64) string {
+	day := time.UnixMilli(ts).UTC().Format("2006-01-02")
+	return fmt.Sprintf("%s.%s", day, w.format)
+}
+
+func (w *partitionWriter) open(name string) (err error) {
+	if err = w.close(); err != nil {
+		return err
+	}
+	path := filepath.Join(w.dir, name)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if !w.opened[name] {
+		if err = f.Truncate(w.committed[name]); err != nil {
+			f.Close()
+			return err
+		}
+		w.opened[name] = true
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.name, w.file, w.empty = name, f, size == 0
+	w.buffer = bufio.NewWriter(f)
+	if w.format == FormatCSV {
+		w.csv = csv.NewWriter(w.buffer)
+	}
+	return nil
+}
+
+func (w *partitionWriter) Write(r Record) (err error) {
+	name := w.fileName(r.Timestamp())
+	if name != w.name {
+		if err = w.open(name); err != nil {
+			return err
+		}
+	}
+	switch w.format {
+	case FormatCSV:
+		if w.empty {
+			if err = w.csv.Write(r.CSVHeader()); err != nil {
+				return err
+			}
+		}
+		w.empty = false
+		return w.csv.Write(r.CSVRecord())
+	case FormatJSONL:
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err = w.buffer.Write(data); err != nil {
+			return err
+		}
+		w.empty = false
+		return w.buffer.WriteByte('\n')
+	}
+	return fmt.Errorf("downloader: unknown format %q", w.format)
+}
+
+// Commit flush buffered rows to disk and record the committed file sizes
+func (w *partitionWriter) Commit() (err error) {
+	if w.file == nil {
+		return nil
+	}
+	if w.csv != nil {
+		w.csv.Flush()
+		if err = w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if err = w.buffer.Flush(); err != nil {
+		return err
+	}
+	if err = w.file.Sync(); err != nil {
+		return err
+	}
+	info, err := w.file.Stat()
+	if err != nil {
+		return err
+	}
+	w.committed[w.name] = info.Size()
+	return nil
+}
+
+func (w *partitionWriter) close() (err error) {
+	if w.file == nil {
+		return nil
+	}
+	if err = w.Commit(); err != nil {
+		return err
+	}
+	err = w.file.Close()
+	w.name, w.file, w.buffer, w.csv = "", nil, nil, nil
+	return err
+}
+
+// Close commit and close the current file
+func (w *partitionWriter) Close() error {
+	return w.close()
+}
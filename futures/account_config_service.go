@@ -0,0 +1,208 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetCommissionRateService get the commission rate of a symbol
+type GetCommissionRateService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetCommissionRateService) Symbol(symbol string) *GetCommissionRateService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetCommissionRateService) Do(ctx context.Context, opts ...common.RequestOption) (res *CommissionRate, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/commissionRate")
+	r.SetQuery("symbol", s.symbol)
+
+	res = new(CommissionRate)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CommissionRate define the commission rates of a symbol
+type CommissionRate struct {
+	Symbol              string `json:"symbol"`
+	MakerCommissionRate string `json:"makerCommissionRate"`
+	TakerCommissionRate string `json:"takerCommissionRate"`
+}
+
+// GetADLQuantileService get the auto-deleveraging quantiles of the positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, all symbols are listed when unset
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...common.RequestOption) (res []*ADLQuantile, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/adlQuantile")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*ADLQuantile, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ADLQuantile define the auto-deleveraging quantiles of a symbol
+type ADLQuantile struct {
+	Symbol      string            `json:"symbol"`
+	ADLQuantile ADLQuantileValues `json:"adlQuantile"`
+}
+
+// ADLQuantileValues define the quantiles from 0 to 4 by position side, the
+// higher the quantile the sooner the position is deleveraged. In one-way mode
+// only Both is set, in hedge mode Long and Short are set. Hedge only marks
+// crossed hedge mode positions, its value is meaningless.
+type ADLQuantileValues struct {
+	Long  int `json:"LONG"`
+	Short int `json:"SHORT"`
+	Both  int `json:"BOTH"`
+	Hedge int `json:"HEDGE"`
+}
+
+// GetAPITradingStatusService get the trading quantitative rules indicators of the account
+type GetAPITradingStatusService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetAPITradingStatusService) Symbol(symbol string) *GetAPITradingStatusService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetAPITradingStatusService) Do(ctx context.Context, opts ...common.RequestOption) (res *APITradingStatus, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/apiTradingStatus")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = new(APITradingStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TradingIndicatorAccount is the key of Indicators holding the account wide indicators
+const TradingIndicatorAccount = "ACCOUNT"
+
+// APITradingStatus define the trading quantitative rules indicators
+type APITradingStatus struct {
+	// Indicators are keyed by symbol, or by TradingIndicatorAccount.
+	Indicators map[string][]*TradingIndicator `json:"indicators"`
+	UpdateTime int64                          `json:"updateTime"`
+}
+
+// TradingIndicator define a trading quantitative rule indicator, like UFR,
+// IFER, GCR, DR or TMV
+type TradingIndicator struct {
+	IsLocked           bool    `json:"isLocked"`
+	PlannedRecoverTime int64   `json:"plannedRecoverTime"`
+	Indicator          string  `json:"indicator"`
+	Value              float64 `json:"value"`
+	TriggerValue       float64 `json:"triggerValue"`
+}
+
+// ChangeMultiAssetsModeService change user's multi-assets mode
+type ChangeMultiAssetsModeService struct {
+	c                 *Client
+	multiAssetsMargin string
+}
+
+// MultiAssetsMargin set the mode: true - Multi-Assets Mode, false - Single-Asset Mode
+func (s *ChangeMultiAssetsModeService) MultiAssetsMargin(multiAssetsMargin bool) *ChangeMultiAssetsModeService {
+	if multiAssetsMargin {
+		s.multiAssetsMargin = "true"
+	} else {
+		s.multiAssetsMargin = "false"
+	}
+	return s
+}
+
+// Do send request
+func (s *ChangeMultiAssetsModeService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	r := common.NewPostRequestSigned("/fapi/v1/multiAssetsMargin")
+	r.SetFormParams(common.Params{
+		"multiAssetsMargin": s.multiAssetsMargin,
+	})
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
+
+// GetMultiAssetsModeService get user's multi-assets mode
+type GetMultiAssetsModeService struct {
+	c *Client
+}
+
+// MultiAssetsMode define user's multi-assets mode
+type MultiAssetsMode struct {
+	MultiAssetsMargin bool `json:"multiAssetsMargin"`
+}
+
+// Do send request
+func (s *GetMultiAssetsModeService) Do(ctx context.Context, opts ...common.RequestOption) (res *MultiAssetsMode, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/multiAssetsMargin")
+
+	res = new(MultiAssetsMode)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSymbolConfigService get the margin type and leverage configured for the symbols
+type GetSymbolConfigService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, all symbols are listed when unset
+func (s *GetSymbolConfigService) Symbol(symbol string) *GetSymbolConfigService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetSymbolConfigService) Do(ctx context.Context, opts ...common.RequestOption) (res []*SymbolConfig, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/symbolConfig")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*SymbolConfig, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SymbolConfig define the configuration of a symbol
+type SymbolConfig struct {
+	Symbol           string     `json:"symbol"`
+	MarginType       MarginType `json:"marginType"`
+	IsAutoAddMargin  bool       `json:"isAutoAddMargin"`
+	Leverage         int        `json:"leverage"`
+	MaxNotionalValue string     `json:"maxNotionalValue"`
+}
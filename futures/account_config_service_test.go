@@ -0,0 +1,198 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountConfigServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountConfigService(t *testing.T) {
+	suite.Run(t, new(accountConfigServiceTestSuite))
+}
+
+func (s *accountConfigServiceTestSuite) TestGetCommissionRate() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"makerCommissionRate": "0.0002",
+		"takerCommissionRate": "0.0004"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetCommissionRateService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CommissionRate{
+		Symbol:              "BTCUSDT",
+		MakerCommissionRate: "0.0002",
+		TakerCommissionRate: "0.0004",
+	}, res)
+}
+
+func (s *accountConfigServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{"symbol": "ETHUSDT", "adlQuantile": {"LONG": 3, "SHORT": 3, "HEDGE": 0}},
+		{"symbol": "BTCUSDT", "adlQuantile": {"LONG": 1, "SHORT": 2, "BOTH": 0}},
+		{"symbol": "SUSHIUSDT", "adlQuantile": {"LONG": 0, "SHORT": 0, "BOTH": 4}}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*ADLQuantile{
+		{Symbol: "ETHUSDT", ADLQuantile: ADLQuantileValues{Long: 3, Short: 3}},
+		{Symbol: "BTCUSDT", ADLQuantile: ADLQuantileValues{Long: 1, Short: 2}},
+		{Symbol: "SUSHIUSDT", ADLQuantile: ADLQuantileValues{Both: 4}},
+	}, res)
+}
+
+func (s *accountConfigServiceTestSuite) TestGetAPITradingStatus() {
+	data := []byte(`{
+		"indicators": {
+			"BTCUSDT": [
+				{
+					"isLocked": true,
+					"plannedRecoverTime": 1545741270000,
+					"indicator": "UFR",
+					"value": 0.05,
+					"triggerValue": 0.995
+				}
+			],
+			"ACCOUNT": [
+				{
+					"indicator": "TMV",
+					"value": 10,
+					"triggerValue": 1,
+					"plannedRecoverTime": 1644919865000,
+					"isLocked": true
+				}
+			]
+		},
+		"updateTime": 1545741270000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAPITradingStatusService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1545741270000), res.UpdateTime)
+	s.r().Equal([]*TradingIndicator{{
+		IsLocked:           true,
+		PlannedRecoverTime: 1545741270000,
+		Indicator:          "UFR",
+		Value:              0.05,
+		TriggerValue:       0.995,
+	}}, res.Indicators["BTCUSDT"])
+	s.r().Len(res.Indicators[TradingIndicatorAccount], 1)
+	s.r().Equal("TMV", res.Indicators[TradingIndicatorAccount][0].Indicator)
+}
+
+func (s *accountConfigServiceTestSuite) TestChangeMultiAssetsMode() {
+	data := []byte(`{"code": 200, "msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"multiAssetsMargin": "true"})
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewChangeMultiAssetsModeService().MultiAssetsMargin(true).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *accountConfigServiceTestSuite) TestGetMultiAssetsMode() {
+	data := []byte(`{"multiAssetsMargin": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+
+	res, err := s.client.NewGetMultiAssetsModeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MultiAssetsMode{MultiAssetsMargin: true}, res)
+}
+
+func (s *accountConfigServiceTestSuite) TestGetSymbolConfig() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"marginType": "CROSSED",
+			"isAutoAddMargin": false,
+			"leverage": 21,
+			"maxNotionalValue": "1000000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSymbolConfigService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*SymbolConfig{{
+		Symbol:           "BTCUSDT",
+		MarginType:       MarginTypeCrossed,
+		Leverage:         21,
+		MaxNotionalValue: "1000000",
+	}}, res)
+}
+
+func (s *accountConfigServiceTestSuite) TestListUserLiquidationOrders() {
+	data := []byte(`[
+		{
+			"orderId": 6071832819,
+			"symbol": "BTCUSDT",
+			"status": "FILLED",
+			"clientOrderId": "autoclose-1596107620040000020",
+			"price": "10871.09",
+			"avgPrice": "10913.21000",
+			"origQty": "0.001",
+			"executedQty": "0.001",
+			"cumQuote": "10.91321",
+			"timeInForce": "IOC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "SELL",
+			"positionSide": "BOTH",
+			"stopPrice": "0",
+			"workingType": "CONTRACT_PRICE",
+			"origType": "LIMIT",
+			"time": 1596107620044,
+			"updateTime": 1596107620087
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"symbol": "BTCUSDT", "limit": 10})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListUserLiquidationOrdersService().Symbol("BTCUSDT").Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(6071832819), res[0].OrderId)
+	s.r().Equal("10913.21000", res[0].AveragePrice)
+	s.r().Equal(SideTypeSell, res[0].Side)
+}
@@ -0,0 +1,306 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MarketDataAPI define the public market data operations of the USDⓈ-M futures API.
+type MarketDataAPI interface {
+	ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error)
+	Depth(ctx context.Context, symbol string, limit int, opts ...common.RequestOption) (*DepthResponse, error)
+	Klines(ctx context.Context, params KlinesParams, opts ...common.RequestOption) ([]*Kline, error)
+	ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*SymbolPrice, error)
+	ListBookTickers(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*BookTicker, error)
+	ExchangeInfo(ctx context.Context, opts ...common.RequestOption) (*ExchangeInfo, error)
+}
+
+// TradingAPI define the order operations of the USDⓈ-M futures API.
+type TradingAPI interface {
+	CreateOrder(ctx context.Context, params CreateOrderParams, opts ...common.RequestOption) (*CreateOrderResponse, error)
+	GetOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*Order, error)
+	CancelOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*CancelOrderResponse, error)
+	CancelAllOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) error
+	CountdownCancelAll(ctx context.Context, symbol string, countdownTime int64, opts ...common.RequestOption) error
+	ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*Order, error)
+	ListOrders(ctx context.Context, params ListOrdersParams, opts ...common.RequestOption) ([]*Order, error)
+}
+
+// AccountAPI define the account operations of the USDⓈ-M futures API.
+type AccountAPI interface {
+	GetAccount(ctx context.Context, opts ...common.RequestOption) (*Account, error)
+	GetBalance(ctx context.Context, opts ...common.RequestOption) ([]*Balance, error)
+	GetPositionRisk(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*PositionRisk, error)
+	ListAccountTrades(ctx context.Context, params ListAccountTradesParams,
+		opts ...common.RequestOption) ([]*AccountTrade, error)
+}
+
+// API define all the operations above, it is implemented by *Client and can be
+// replaced by a mock in tests.
+type API interface {
+	MarketDataAPI
+	TradingAPI
+	AccountAPI
+}
+
+var _ API = (*Client)(nil)
+
+// KlinesParams define the parameters of Klines, zero values are not sent.
+type KlinesParams struct {
+	Symbol    string
+	Interval  string
+	Limit     int
+	StartTime int64
+	EndTime   int64
+}
+
+// CreateOrderParams define the parameters of CreateOrder, zero values are not sent.
+type CreateOrderParams struct {
+	Symbol           string
+	Side             SideType
+	PositionSide     PositionSideType
+	Type             OrderType
+	TimeInForce      TimeInForceType
+	Quantity         string
+	ReduceOnly       bool
+	Price            string
+	NewClientOrderID string
+	StopPrice        string
+	WorkingType      WorkingType
+	ActivationPrice  string
+	CallbackRate     string
+	PriceProtect     bool
+	NewOrderRespType NewOrderRespType
+	ClosePosition    bool
+}
+
+// OrderQuery identify an order by OrderID or OrigClientOrderID.
+type OrderQuery struct {
+	Symbol            string
+	OrderID           int64
+	OrigClientOrderID string
+}
+
+// ListOrdersParams define the parameters of ListOrders, zero values are not sent.
+type ListOrdersParams struct {
+	Symbol    string
+	OrderID   int64
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// ListAccountTradesParams define the parameters of ListAccountTrades, zero values are not sent.
+type ListAccountTradesParams struct {
+	Symbol    string
+	FromID    int64
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// ServerTime get server time
+func (c *Client) ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error) {
+	return c.NewServerTimeService().Do(ctx, opts...)
+}
+
+// Depth get order book
+func (c *Client) Depth(ctx context.Context, symbol string, limit int,
+	opts ...common.RequestOption,
+) (*DepthResponse, error) {
+	s := c.NewDepthService().Symbol(symbol)
+	if limit > 0 {
+		s.Limit(limit)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// Klines list klines
+func (c *Client) Klines(ctx context.Context, params KlinesParams, opts ...common.RequestOption) ([]*Kline, error) {
+	s := c.NewKlinesService().Symbol(params.Symbol).Interval(params.Interval)
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListPrices list latest prices of symbol, or of all symbols when empty
+func (c *Client) ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*SymbolPrice, error) {
+	s := c.NewListPricesService()
+	if symbol != "" {
+		s.Symbol(symbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListBookTickers list best price and quantity of symbol, or of all symbols when empty
+func (c *Client) ListBookTickers(ctx context.Context, symbol string,
+	opts ...common.RequestOption,
+) ([]*BookTicker, error) {
+	s := c.NewListBookTickersService()
+	if symbol != "" {
+		s.Symbol(symbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ExchangeInfo get exchange info
+func (c *Client) ExchangeInfo(ctx context.Context, opts ...common.RequestOption) (*ExchangeInfo, error) {
+	return c.NewExchangeInfoService().Do(ctx, opts...)
+}
+
+// CreateOrder create order
+func (c *Client) CreateOrder(ctx context.Context, params CreateOrderParams,
+	opts ...common.RequestOption,
+) (*CreateOrderResponse, error) {
+	s := c.NewCreateOrderService().Symbol(params.Symbol).Side(params.Side).Type(params.Type).
+		Quantity(params.Quantity)
+	if params.PositionSide != "" {
+		s.PositionSide(params.PositionSide)
+	}
+	if params.TimeInForce != "" {
+		s.TimeInForce(params.TimeInForce)
+	}
+	if params.ReduceOnly {
+		s.ReduceOnly(params.ReduceOnly)
+	}
+	if params.Price != "" {
+		s.Price(params.Price)
+	}
+	if params.NewClientOrderID != "" {
+		s.NewClientOrderID(params.NewClientOrderID)
+	}
+	if params.StopPrice != "" {
+		s.StopPrice(params.StopPrice)
+	}
+	if params.WorkingType != "" {
+		s.WorkingType(params.WorkingType)
+	}
+	if params.ActivationPrice != "" {
+		s.ActivationPrice(params.ActivationPrice)
+	}
+	if params.CallbackRate != "" {
+		s.CallbackRate(params.CallbackRate)
+	}
+	if params.PriceProtect {
+		s.PriceProtect(params.PriceProtect)
+	}
+	if params.NewOrderRespType != "" {
+		s.NewOrderResponseType(params.NewOrderRespType)
+	}
+	if params.ClosePosition {
+		s.ClosePosition(params.ClosePosition)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// GetOrder get an order
+func (c *Client) GetOrder(ctx context.Context, query OrderQuery, opts ...common.RequestOption) (*Order, error) {
+	s := c.NewGetOrderService().Symbol(query.Symbol)
+	if query.OrderID != 0 {
+		s.OrderID(query.OrderID)
+	}
+	if query.OrigClientOrderID != "" {
+		s.OrigClientOrderID(query.OrigClientOrderID)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// CancelOrder cancel an order
+func (c *Client) CancelOrder(ctx context.Context, query OrderQuery,
+	opts ...common.RequestOption,
+) (*CancelOrderResponse, error) {
+	s := c.NewCancelOrderService().Symbol(query.Symbol)
+	if query.OrderID != 0 {
+		s.OrderID(query.OrderID)
+	}
+	if query.OrigClientOrderID != "" {
+		s.OrigClientOrderID(query.OrigClientOrderID)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// CancelAllOpenOrders cancel all open orders of symbol
+func (c *Client) CancelAllOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) error {
+	return c.NewCancelAllOpenOrdersService().Symbol(symbol).Do(ctx, opts...)
+}
+
+// CountdownCancelAll cancel all open orders of symbol after countdownTime
+// milliseconds unless called again, zero stops the countdown
+func (c *Client) CountdownCancelAll(ctx context.Context, symbol string, countdownTime int64,
+	opts ...common.RequestOption,
+) error {
+	_, err := c.NewCountdownCancelAllService().Symbol(symbol).CountdownTime(countdownTime).Do(ctx, opts...)
+	return err
+}
+
+// ListOpenOrders list open orders of symbol, or of all symbols when empty
+func (c *Client) ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*Order, error) {
+	return c.NewListOpenOrdersService().Symbol(symbol).Do(ctx, opts...)
+}
+
+// ListOrders list all orders of a symbol
+func (c *Client) ListOrders(ctx context.Context, params ListOrdersParams, opts ...common.RequestOption) ([]*Order, error) {
+	s := c.NewListOrdersService().Symbol(params.Symbol)
+	if params.OrderID != 0 {
+		s.OrderID(params.OrderID)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// GetAccount get account info
+func (c *Client) GetAccount(ctx context.Context, opts ...common.RequestOption) (*Account, error) {
+	return c.NewGetAccountService().Do(ctx, opts...)
+}
+
+// GetBalance get account balances
+func (c *Client) GetBalance(ctx context.Context, opts ...common.RequestOption) ([]*Balance, error) {
+	return c.NewGetBalanceService().Do(ctx, opts...)
+}
+
+// GetPositionRisk get positions of symbol, or of all symbols when empty
+func (c *Client) GetPositionRisk(ctx context.Context, symbol string,
+	opts ...common.RequestOption,
+) ([]*PositionRisk, error) {
+	s := c.NewGetPositionRiskService()
+	if symbol != "" {
+		s.Symbol(symbol)
+	}
+	return s.Do(ctx, opts...)
+}
+
+// ListAccountTrades list account trades of a symbol
+func (c *Client) ListAccountTrades(ctx context.Context, params ListAccountTradesParams,
+	opts ...common.RequestOption,
+) ([]*AccountTrade, error) {
+	s := c.NewListAccountTradeService().Symbol(params.Symbol)
+	if params.FromID != 0 {
+		s.FromID(params.FromID)
+	}
+	if params.StartTime > 0 {
+		s.StartTime(params.StartTime)
+	}
+	if params.EndTime > 0 {
+		s.EndTime(params.EndTime)
+	}
+	if params.Limit > 0 {
+		s.Limit(params.Limit)
+	}
+	return s.Do(ctx, opts...)
+}
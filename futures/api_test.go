@@ -0,0 +1,101 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type apiTestSuite struct {
+	baseTestSuite
+}
+
+func TestAPI(t *testing.T) {
+	suite.Run(t, new(apiTestSuite))
+}
+
+func (s *apiTestSuite) TestCreateOrder() {
+	data := []byte(`{"symbol":"BTCUSDT","orderId":1,"status":"NEW","positionSide":"SHORT"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"positionSide":     PositionSideTypeShort,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTX,
+			"quantity":         "1",
+			"price":            "20000",
+			"reduceOnly":       true,
+			"newOrderRespType": NewOrderRespTypeRESULT,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.CreateOrder(newContext(), CreateOrderParams{
+		Symbol:           "BTCUSDT",
+		Side:             SideTypeSell,
+		PositionSide:     PositionSideTypeShort,
+		Type:             OrderTypeLimit,
+		TimeInForce:      TimeInForceTypeGTX,
+		Quantity:         "1",
+		Price:            "20000",
+		ReduceOnly:       true,
+		NewOrderRespType: NewOrderRespTypeRESULT,
+	})
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.OrderID)
+	s.r().Equal(PositionSideTypeShort, res.PositionSide)
+}
+
+func (s *apiTestSuite) TestCancelOrder() {
+	data := []byte(`{"symbol":"BTCUSDT","orderId":1,"status":"CANCELED"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":  "BTCUSDT",
+			"orderId": 1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.CancelOrder(newContext(), OrderQuery{Symbol: "BTCUSDT", OrderID: 1})
+	s.r().NoError(err)
+	s.r().Equal(OrderStatusTypeCanceled, res.Status)
+}
+
+func (s *apiTestSuite) TestGetPositionRisk() {
+	data := []byte(`[{"symbol":"BTCUSDT","positionAmt":"0.1"}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.GetPositionRisk(newContext(), "BTCUSDT")
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("0.1", res[0].PositionAmt)
+}
+
+func (s *apiTestSuite) TestListAccountTrades() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":    "BTCUSDT",
+			"startTime": 1569514978020,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.ListAccountTrades(newContext(), ListAccountTradesParams{
+		Symbol: "BTCUSDT", StartTime: 1569514978020, Limit: 10,
+	})
+	s.r().NoError(err)
+}
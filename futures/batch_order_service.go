@@ -0,0 +1,154 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MaxBatchOrders is the maximum number of orders of a batch request
+const MaxBatchOrders = 5
+
+// CreateBatchOrdersService create up to 5 orders in one request
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// OrderList set the orders to create, build them with NewCreateOrderService
+func (s *CreateBatchOrdersService) OrderList(orders ...*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request, the request fails only when the whole batch is rejected,
+// the errors of single orders are reported in the response
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	params := make([]common.Params, 0, len(s.orders))
+	for _, order := range s.orders {
+		params = append(params, order.params())
+	}
+	r, err := newBatchOrdersRequest(common.NewPostRequestSigned("/fapi/v1/batchOrders"), params)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]json.RawMessage, 0)
+	if err = s.c.CallAPI(ctx, r, &items, opts...); err != nil {
+		return nil, err
+	}
+	res = &CreateBatchOrdersResponse{Orders: make([]*CreateOrderResponse, len(items))}
+	res.Errors, err = decodeBatchOrders(items, func(i int) interface{} {
+		res.Orders[i] = new(CreateOrderResponse)
+		return res.Orders[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateBatchOrdersResponse define create batch orders response. Orders and
+// Errors have one item per requested order in the same order, Orders[i] is
+// nil when the order was rejected with Errors[i], and Errors[i] is nil when it
+// was created.
+type CreateBatchOrdersResponse struct {
+	Orders []*CreateOrderResponse
+	Errors []*common.APIError
+}
+
+// ModifyBatchOrdersService modify up to 5 orders in one request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// OrderList set the orders to modify, build them with NewModifyOrderService
+func (s *ModifyBatchOrdersService) OrderList(orders ...*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request, the request fails only when the whole batch is rejected,
+// the errors of single orders are reported in the response
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	params := make([]common.Params, 0, len(s.orders))
+	for _, order := range s.orders {
+		params = append(params, order.params())
+	}
+	r, err := newBatchOrdersRequest(common.NewPutRequestSigned("/fapi/v1/batchOrders"), params)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]json.RawMessage, 0)
+	if err = s.c.CallAPI(ctx, r, &items, opts...); err != nil {
+		return nil, err
+	}
+	res = &ModifyBatchOrdersResponse{Orders: make([]*Order, len(items))}
+	res.Errors, err = decodeBatchOrders(items, func(i int) interface{} {
+		res.Orders[i] = new(Order)
+		return res.Orders[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyBatchOrdersResponse define modify batch orders response, it is laid
+// out like CreateBatchOrdersResponse
+type ModifyBatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
+// newBatchOrdersRequest set the batchOrders parameter of r, each order is a
+// JSON object of string values
+func newBatchOrdersRequest(r *common.Request, orders []common.Params) (*common.Request, error) {
+	if len(orders) == 0 || len(orders) > MaxBatchOrders {
+		return nil, fmt.Errorf("batch of %d orders, it must have 1 to %d orders", len(orders), MaxBatchOrders)
+	}
+	list := make([]map[string]string, 0, len(orders))
+	for _, m := range orders {
+		order := make(map[string]string, len(m))
+		for k, v := range m {
+			if value := fmt.Sprintf("%v", v); value != "" {
+				order[k] = value
+			}
+		}
+		list = append(list, order)
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	return r.SetForm("batchOrders", string(data)), nil
+}
+
+// decodeBatchOrders decode each item of a batch response into newItem(i), or
+// into the returned errors when the item is an error
+func decodeBatchOrders(items []json.RawMessage, newItem func(i int) interface{}) (errs []*common.APIError, err error) {
+	errs = make([]*common.APIError, len(items))
+	for i, item := range items {
+		// items are decoded in a local type, the status of an order is a string
+		// while the one of APIError is a number.
+		var itemErr struct {
+			Code    int64  `json:"code"`
+			Message string `json:"msg"`
+		}
+		if err = json.Unmarshal(item, &itemErr); err != nil {
+			return nil, err
+		}
+		if itemErr.Code != 0 {
+			errs[i] = &common.APIError{Code: itemErr.Code, Message: itemErr.Message}
+			continue
+		}
+		if err = json.Unmarshal(item, newItem(i)); err != nil {
+			return nil, err
+		}
+	}
+	return errs, nil
+}
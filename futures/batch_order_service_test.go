@@ -0,0 +1,117 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type batchOrderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBatchOrderService(t *testing.T) {
+	suite.Run(t, new(batchOrderServiceTestSuite))
+}
+
+func (s *batchOrderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "grid1",
+			"cumQuote": "0",
+			"executedQty": "0",
+			"orderId": 22542179,
+			"origQty": "1",
+			"price": "20000",
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"symbol": "BTCUSDT",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1566818724722
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"batchOrders": `[{"newClientOrderId":"grid1","price":"20000","quantity":"1","side":"BUY",` +
+				`"symbol":"BTCUSDT","timeInForce":"GTC","type":"LIMIT"},` +
+				`{"quantity":"1","reduceOnly":"true","side":"SELL","symbol":"BTCUSDT","type":"MARKET"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+			TimeInForce(TimeInForceTypeGTC).Quantity("1").Price("20000").NewClientOrderID("grid1"),
+		s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeMarket).
+			Quantity("1").ReduceOnly(true),
+	).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Orders, 2)
+	s.r().Len(res.Errors, 2)
+	s.r().Nil(res.Errors[0])
+	s.r().Equal(int64(22542179), res.Orders[0].OrderID)
+	s.r().Equal(OrderStatusTypeNew, res.Orders[0].Status)
+	s.r().Nil(res.Orders[1])
+	s.r().Equal(&common.APIError{Code: -2022, Message: "ReduceOnly Order is rejected."}, res.Errors[1])
+}
+
+func (s *batchOrderServiceTestSuite) TestCreateBatchOrdersLimit() {
+	orders := make([]*CreateOrderService, MaxBatchOrders+1)
+	for i := range orders {
+		orders[i] = s.client.NewCreateOrderService().Symbol("BTCUSDT")
+	}
+	_, err := s.client.NewCreateBatchOrdersService().OrderList(orders...).Do(newContext())
+	s.r().Error(err)
+	_, err = s.client.NewCreateBatchOrdersService().Do(newContext())
+	s.r().Error(err)
+}
+
+func (s *batchOrderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 20072994037,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"price": "30005",
+			"origQty": "1",
+			"side": "BUY",
+			"updateTime": 1629182711600
+		},
+		{
+			"code": -2013,
+			"msg": "Order does not exist."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"batchOrders": `[{"orderId":"20072994037","price":"30005","quantity":"1","side":"BUY","symbol":"BTCUSDT"},` +
+				`{"origClientOrderId":"grid2","priceMatch":"QUEUE","quantity":"1","side":"SELL","symbol":"BTCUSDT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyBatchOrdersService().OrderList(
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(20072994037).Side(SideTypeBuy).
+			Quantity("1").Price("30005"),
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrigClientOrderID("grid2").Side(SideTypeSell).
+			Quantity("1").PriceMatch(PriceMatchTypeQueue),
+	).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("30005", res.Orders[0].Price)
+	s.r().Nil(res.Errors[0])
+	s.r().Nil(res.Orders[1])
+	s.r().Equal(int64(-2013), res.Errors[1].Code)
+}
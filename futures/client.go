@@ -47,6 +47,9 @@ type SideEffectType string
 // WorkingType define working type
 type WorkingType string
 
+// PriceMatchType define price match mode of order
+type PriceMatchType string
+
 // MarginType define margin type
 type MarginType string
 
@@ -118,6 +121,16 @@ const (
 	WorkingTypeMarkPrice     WorkingType = "MARK_PRICE"
 	WorkingTypeContractPrice WorkingType = "CONTRACT_PRICE"
 
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
 	SymbolStatusTypePreTrading   SymbolStatusType = "PRE_TRADING"
 	SymbolStatusTypeTrading      SymbolStatusType = "TRADING"
 	SymbolStatusTypePostTrading  SymbolStatusType = "POST_TRADING"
@@ -297,6 +310,31 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch orders service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewModifyOrderService init modifying order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewListOrderAmendmentsService init listing order amendments service
+func (c *Client) NewListOrderAmendmentsService() *ListOrderAmendmentsService {
+	return &ListOrderAmendmentsService{c: c}
+}
+
+// NewModifyBatchOrdersService init modifying batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -421,3 +459,88 @@ func (c *Client) NewGetPositionModeService() *GetPositionModeService {
 func (c *Client) NewGetRebateNewUserService() *GetRebateNewUserService {
 	return &GetRebateNewUserService{c: c}
 }
+
+// NewOpenInterestService init open interest service
+func (c *Client) NewOpenInterestService() *OpenInterestService {
+	return &OpenInterestService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top trader long/short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top trader long/short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewLongShortRatioService init long/short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTakerLongShortRatioService init taker long/short ratio service
+func (c *Client) NewTakerLongShortRatioService() *TakerLongShortRatioService {
+	return &TakerLongShortRatioService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewGetCommissionRateService init get commission rate service
+func (c *Client) NewGetCommissionRateService() *GetCommissionRateService {
+	return &GetCommissionRateService{c: c}
+}
+
+// NewGetADLQuantileService init get ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
+// NewGetAPITradingStatusService init get API trading status service
+func (c *Client) NewGetAPITradingStatusService() *GetAPITradingStatusService {
+	return &GetAPITradingStatusService{c: c}
+}
+
+// NewChangeMultiAssetsModeService init change multi-assets mode service
+func (c *Client) NewChangeMultiAssetsModeService() *ChangeMultiAssetsModeService {
+	return &ChangeMultiAssetsModeService{c: c}
+}
+
+// NewGetMultiAssetsModeService init get multi-assets mode service
+func (c *Client) NewGetMultiAssetsModeService() *GetMultiAssetsModeService {
+	return &GetMultiAssetsModeService{c: c}
+}
+
+// NewGetSymbolConfigService init get symbol config service
+func (c *Client) NewGetSymbolConfigService() *GetSymbolConfigService {
+	return &GetSymbolConfigService{c: c}
+}
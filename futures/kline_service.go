@@ -62,33 +62,9 @@ func (s *KlinesService) Do(ctx context.Context, opts ...common.RequestOption) (r
 		r.SetQuery("endTime", *s.endTime)
 	}
 
-	f := func(data []byte) error {
-		j, err := newJSON(data)
-		if err != nil {
-			return err
-		}
-		num := len(j.MustArray())
-		res = make([]*Kline, num)
-		for i := 0; i < num; i++ {
-			item := j.GetIndex(i)
-			if len(item.MustArray()) < 11 {
-				return fmt.Errorf("invalid kline response")
-			}
-			res[i] = &Kline{
-				OpenTime:                 item.GetIndex(0).MustInt64(),
-				Open:                     item.GetIndex(1).MustString(),
-				High:                     item.GetIndex(2).MustString(),
-				Low:                      item.GetIndex(3).MustString(),
-				Close:                    item.GetIndex(4).MustString(),
-				Volume:                   item.GetIndex(5).MustString(),
-				CloseTime:                item.GetIndex(6).MustInt64(),
-				QuoteAssetVolume:         item.GetIndex(7).MustString(),
-				TradeNum:                 item.GetIndex(8).MustInt64(),
-				TakerBuyBaseAssetVolume:  item.GetIndex(9).MustString(),
-				TakerBuyQuoteAssetVolume: item.GetIndex(10).MustString(),
-			}
-		}
-		return nil
+	f := func(data []byte) (err error) {
+		res, err = parseKlines(data)
+		return err
 	}
 	if err = s.c.CallAPI(ctx, r, f, opts...); err != nil {
 		return nil, err
@@ -96,6 +72,36 @@ func (s *KlinesService) Do(ctx context.Context, opts ...common.RequestOption) (r
 	return res, nil
 }
 
+// parseKlines decodes the array of arrays returned by the klines endpoints.
+func parseKlines(data []byte) ([]*Kline, error) {
+	j, err := newJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	num := len(j.MustArray())
+	res := make([]*Kline, num)
+	for i := 0; i < num; i++ {
+		item := j.GetIndex(i)
+		if len(item.MustArray()) < 11 {
+			return nil, fmt.Errorf("invalid kline response")
+		}
+		res[i] = &Kline{
+			OpenTime:                 item.GetIndex(0).MustInt64(),
+			Open:                     item.GetIndex(1).MustString(),
+			High:                     item.GetIndex(2).MustString(),
+			Low:                      item.GetIndex(3).MustString(),
+			Close:                    item.GetIndex(4).MustString(),
+			Volume:                   item.GetIndex(5).MustString(),
+			CloseTime:                item.GetIndex(6).MustInt64(),
+			QuoteAssetVolume:         item.GetIndex(7).MustString(),
+			TradeNum:                 item.GetIndex(8).MustInt64(),
+			TakerBuyBaseAssetVolume:  item.GetIndex(9).MustString(),
+			TakerBuyQuoteAssetVolume: item.GetIndex(10).MustString(),
+		}
+	}
+	return res, nil
+}
+
 // Kline define kline info
 type Kline struct {
 	OpenTime                 int64  `json:"openTime"`
@@ -0,0 +1,166 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marketStatsServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarketStatsService(t *testing.T) {
+	suite.Run(t, new(marketStatsServiceTestSuite))
+}
+
+func (s *marketStatsServiceTestSuite) assertRangeReq(params common.Params) {
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(params)
+		s.assertRequestEqual(e, r)
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestOpenInterest() {
+	data := []byte(`{
+		"openInterest": "10659.509",
+		"symbol": "BTCUSDT",
+		"time": 1589437530011
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"symbol": "BTCUSDT"})
+
+	res, err := s.client.NewOpenInterestService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&OpenInterest{OpenInterest: "10659.509", Symbol: "BTCUSDT", Time: 1589437530011}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestOpenInterestStatistics() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"sumOpenInterest": "20403.63700000",
+			"sumOpenInterestValue": "150570784.07809979",
+			"timestamp": 1583127900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{
+		"symbol":    "BTCUSDT",
+		"period":    "5m",
+		"limit":     10,
+		"startTime": 1583127600000,
+		"endTime":   1583128200000,
+	})
+
+	res, err := s.client.NewOpenInterestStatisticsService().Symbol("BTCUSDT").Period("5m").Limit(10).
+		StartTime(1583127600000).EndTime(1583128200000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*OpenInterestStatistic{{
+		Symbol:               "BTCUSDT",
+		SumOpenInterest:      "20403.63700000",
+		SumOpenInterestValue: "150570784.07809979",
+		Timestamp:            1583127900000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) assertLongShortRatio(do func() ([]*LongShortRatio, error)) {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"symbol": "BTCUSDT", "period": "1h"})
+
+	res, err := do()
+	s.r().NoError(err)
+	s.r().Equal([]*LongShortRatio{{
+		Symbol:         "BTCUSDT",
+		LongShortRatio: "1.8105",
+		LongAccount:    "0.6442",
+		ShortAccount:   "0.3558",
+		Timestamp:      1583139600000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestTopLongShortAccountRatio() {
+	s.assertLongShortRatio(func() ([]*LongShortRatio, error) {
+		return s.client.NewTopLongShortAccountRatioService().Symbol("BTCUSDT").Period("1h").Do(newContext())
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestTopLongShortPositionRatio() {
+	s.assertLongShortRatio(func() ([]*LongShortRatio, error) {
+		return s.client.NewTopLongShortPositionRatioService().Symbol("BTCUSDT").Period("1h").Do(newContext())
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestLongShortRatio() {
+	s.assertLongShortRatio(func() ([]*LongShortRatio, error) {
+		return s.client.NewLongShortRatioService().Symbol("BTCUSDT").Period("1h").Do(newContext())
+	})
+}
+
+func (s *marketStatsServiceTestSuite) TestTakerLongShortRatio() {
+	data := []byte(`[
+		{
+			"buySellRatio": "1.5586",
+			"buyVol": "387.3300",
+			"sellVol": "248.5030",
+			"timestamp": 1585614900000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"symbol": "BTCUSDT", "period": "5m", "limit": 1})
+
+	res, err := s.client.NewTakerLongShortRatioService().Symbol("BTCUSDT").Period("5m").Limit(1).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*TakerLongShortRatio{{
+		BuySellRatio: "1.5586",
+		BuyVol:       "387.3300",
+		SellVol:      "248.5030",
+		Timestamp:    1585614900000,
+	}}, res)
+}
+
+func (s *marketStatsServiceTestSuite) TestBasis() {
+	data := []byte(`[
+		{
+			"indexPrice": "34400.15945055",
+			"contractType": "PERPETUAL",
+			"basisRate": "0.0004",
+			"futuresPrice": "34414.10",
+			"annualizedBasisRate": "",
+			"basis": "13.94054945",
+			"pair": "BTCUSDT",
+			"timestamp": 1698742800000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertRangeReq(common.Params{"pair": "BTCUSDT", "contractType": ContractTypePerpetual, "period": "1d"})
+
+	res, err := s.client.NewBasisService().Pair("BTCUSDT").ContractType(ContractTypePerpetual).Period("1d").
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Basis{{
+		Pair:         "BTCUSDT",
+		ContractType: ContractTypePerpetual,
+		IndexPrice:   "34400.15945055",
+		FuturesPrice: "34414.10",
+		Basis:        "13.94054945",
+		BasisRate:    "0.0004",
+		Timestamp:    1698742800000,
+	}}, res)
+}
@@ -125,10 +125,7 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string,
-	result interface{}, opts ...common.RequestOption,
-) (err error) {
-	r := common.NewPostRequestSigned(endpoint)
+func (s *CreateOrderService) params() common.Params {
 	m := common.Params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -169,7 +166,14 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string,
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.SetFormParams(m)
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string,
+	result interface{}, opts ...common.RequestOption,
+) (err error) {
+	r := common.NewPostRequestSigned(endpoint)
+	r.SetFormParams(s.params())
 
 	if err = s.c.CallAPI(ctx, r, result, opts...); err != nil {
 		return err
@@ -211,6 +215,192 @@ type CreateOrderResponse struct {
 	PriceProtect     bool             `json:"priceProtect"`
 }
 
+// ModifyOrderService modify the price and quantity of a LIMIT order
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          string
+	price             string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = price
+	return s
+}
+
+// PriceMatch set priceMatch, it can't be used with price
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+func (s *ModifyOrderService) params() common.Params {
+	m := common.Params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.price != "" {
+		m["price"] = s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	return m
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *Order, err error) {
+	r := common.NewPutRequestSigned("/fapi/v1/order")
+	r.SetFormParams(s.params())
+
+	res = new(Order)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOrderAmendmentsService list the amendments of an order, newest first
+type ListOrderAmendmentsService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	startTime         *int64
+	endTime           *int64
+	limit             *int
+}
+
+// Symbol set symbol
+func (s *ListOrderAmendmentsService) Symbol(symbol string) *ListOrderAmendmentsService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ListOrderAmendmentsService) OrderID(orderID int64) *ListOrderAmendmentsService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ListOrderAmendmentsService) OrigClientOrderID(origClientOrderID string) *ListOrderAmendmentsService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrderAmendmentsService) StartTime(startTime int64) *ListOrderAmendmentsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrderAmendmentsService) EndTime(endTime int64) *ListOrderAmendmentsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, 50 by default and 100 at most
+func (s *ListOrderAmendmentsService) Limit(limit int) *ListOrderAmendmentsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrderAmendmentsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*OrderAmendment, err error) {
+	r := common.NewGetRequestSigned("/fapi/v1/orderAmendment")
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetQuery("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*OrderAmendment, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OrderAmendment define an amendment of an order
+type OrderAmendment struct {
+	AmendmentID   int64            `json:"amendmentId"`
+	Symbol        string           `json:"symbol"`
+	Pair          string           `json:"pair"`
+	OrderID       int64            `json:"orderId"`
+	ClientOrderID string           `json:"clientOrderId"`
+	Time          int64            `json:"time"`
+	Amendment     OrderAmendDetail `json:"amendment"`
+}
+
+// OrderAmendDetail define the changes of an order amendment, Count is the
+// number of amendments of the order so far
+type OrderAmendDetail struct {
+	Price        OrderAmendChange `json:"price"`
+	OrigQuantity OrderAmendChange `json:"origQty"`
+	Count        int64            `json:"count"`
+}
+
+// OrderAmendChange define a value before and after an amendment
+type OrderAmendChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
 // ListOpenOrdersService list opened orders
 type ListOpenOrdersService struct {
 	c      *Client
@@ -307,6 +497,7 @@ type Order struct {
 	PositionSide     PositionSideType `json:"positionSide"`
 	PriceProtect     bool             `json:"priceProtect"`
 	ClosePosition    bool             `json:"closePosition"`
+	PriceMatch       PriceMatchType   `json:"priceMatch"`
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -507,6 +698,47 @@ func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...common.Re
 	return res, nil
 }
 
+// CountdownCancelAllService cancel all open orders of a symbol at the end of
+// a countdown, every call resets the countdown and a zero countdown stops it
+type CountdownCancelAllService struct {
+	c             *Client
+	symbol        string
+	countdownTime int64
+}
+
+// Symbol set symbol
+func (s *CountdownCancelAllService) Symbol(symbol string) *CountdownCancelAllService {
+	s.symbol = symbol
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...common.RequestOption) (res *CountdownCancelAllResponse, err error) {
+	r := common.NewPostRequestSigned("/fapi/v1/countdownCancelAll")
+	r.SetFormParams(common.Params{
+		"symbol":        s.symbol,
+		"countdownTime": s.countdownTime,
+	})
+
+	res = new(CountdownCancelAllResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllResponse define countdown cancel all response
+type CountdownCancelAllResponse struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"`
+}
+
 // ListLiquidationOrdersService list liquidation orders
 type ListLiquidationOrdersService struct {
 	c         *Client
@@ -593,7 +825,7 @@ func (s *ListUserLiquidationOrdersService) Symbol(symbol string) *ListUserLiquid
 	return s
 }
 
-// AutoCloseType set symbol
+// AutoCloseType set autoCloseType, both types are listed when unset
 func (s *ListUserLiquidationOrdersService) AutoCloseType(autoCloseType ForceOrderCloseType) *ListUserLiquidationOrdersService {
 	s.autoCloseType = autoCloseType
 	return s
@@ -621,7 +853,9 @@ func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidation
 func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*UserLiquidationOrder, err error) {
 	r := common.NewGetRequestSigned("/fapi/v1/forceOrders")
 
-	r.SetQuery("autoCloseType", s.autoCloseType)
+	if s.autoCloseType != "" {
+		r.SetQuery("autoCloseType", s.autoCloseType)
+	}
 	if s.symbol != nil {
 		r.SetQuery("symbol", *s.symbol)
 	}
@@ -2,16 +2,26 @@ package binance
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/crypto-zero/go-binance/v2/common"
 )
 
-// FuturesTransferService transfer asset between spot account and futures account
+// futuresTransferTypes map the futures transfer types to the universal ones.
+var futuresTransferTypes = map[FuturesTransferType]UniversalTransferType{
+	FuturesTransferTypeSpotToFutures:  UniversalTransferTypeMainUMFuture,
+	FuturesTransferTypeFuturesToSpot:  UniversalTransferTypeUMFutureMain,
+	FuturesTransferTypeSpotToFuturesM: UniversalTransferTypeMainCMFuture,
+	FuturesTransferTypeFuturesMToSpot: UniversalTransferTypeCMFutureMain,
+}
+
+// FuturesTransferService transfer asset between spot account and futures
+// account, it is a universal transfer
 type FuturesTransferService struct {
 	c            *Client
 	asset        string
 	amount       string
-	transferType int
+	transferType FuturesTransferType
 }
 
 // Asset set asset being transferred, e.g., BTC
@@ -26,36 +36,32 @@ func (s *FuturesTransferService) Amount(amount string) *FuturesTransferService {
 	return s
 }
 
-// Type 1: transfer from spot account to futures account 2: transfer from futures account to spot account
+// Type set the transfer direction, it is sent as the matching universal transfer
+// type, like MAIN_UMFUTURE for FuturesTransferTypeSpotToFutures
 func (s *FuturesTransferService) Type(transferType FuturesTransferType) *FuturesTransferService {
-	s.transferType = int(transferType)
+	s.transferType = transferType
 	return s
 }
 
 // Do send Request
 func (s *FuturesTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *TransactionResponse, err error) {
-	r := common.NewPostRequestSigned("/sapi/v1/futures/transfer")
-	m := common.Params{
-		"asset":  s.asset,
-		"amount": s.amount,
-		"type":   s.transferType,
-	}
-	r.SetFormParams(m)
-	res = new(TransactionResponse)
-	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
-		return nil, err
+	transferType, ok := futuresTransferTypes[s.transferType]
+	if !ok {
+		return nil, fmt.Errorf("unknown futures transfer type %d", s.transferType)
 	}
-	return res, nil
+	return s.c.NewUniversalTransferService().Type(transferType).Asset(s.asset).Amount(s.amount).Do(ctx, opts...)
 }
 
-// ListFuturesTransferService list futures transfer
+// ListFuturesTransferService list futures transfer, it lists the universal
+// transfers of the matching directions and keeps the ones of the asset
 type ListFuturesTransferService struct {
-	c         *Client
-	asset     string
-	startTime int64
-	endTime   *int64
-	current   *int64
-	size      *int64
+	c            *Client
+	asset        string
+	transferType *FuturesTransferType
+	startTime    int64
+	endTime      *int64
+	current      *int64
+	size         *int64
 }
 
 // Asset set asset
@@ -64,6 +70,13 @@ func (s *ListFuturesTransferService) Asset(asset string) *ListFuturesTransferSer
 	return s
 }
 
+// Type set the transfer direction, the four directions are listed by default
+// with one request each
+func (s *ListFuturesTransferService) Type(transferType FuturesTransferType) *ListFuturesTransferService {
+	s.transferType = &transferType
+	return s
+}
+
 // StartTime set start time
 func (s *ListFuturesTransferService) StartTime(startTime int64) *ListFuturesTransferService {
 	s.startTime = startTime
@@ -76,38 +89,66 @@ func (s *ListFuturesTransferService) EndTime(endTime int64) *ListFuturesTransfer
 	return s
 }
 
-// Current currently querying page. Start from 1. Default:1
+// Current currently querying page of every direction. Start from 1. Default:1
 func (s *ListFuturesTransferService) Current(current int64) *ListFuturesTransferService {
 	s.current = &current
 	return s
 }
 
-// Size default:10 max:100
+// Size default:10 max:100, per direction
 func (s *ListFuturesTransferService) Size(size int64) *ListFuturesTransferService {
 	s.size = &size
 	return s
 }
 
-// Do send Request
+// Do send Request. Total is the sum of the totals of the listed directions,
+// which count the transfers of every asset.
 func (s *ListFuturesTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *FuturesTransferHistory, err error) {
-	r := common.NewGetRequestSigned("/sapi/v1/futures/transfer")
-	r.SetQueryParams(common.Params{
-		"asset":     s.asset,
-		"startTime": s.startTime,
-	})
-	if s.endTime != nil {
-		r.SetQuery("endTime", *s.endTime)
-	}
-	if s.current != nil {
-		r.SetQuery("current", *s.current)
+	transferTypes := []FuturesTransferType{
+		FuturesTransferTypeSpotToFutures, FuturesTransferTypeFuturesToSpot,
+		FuturesTransferTypeSpotToFuturesM, FuturesTransferTypeFuturesMToSpot,
 	}
-	if s.size != nil {
-		r.SetQuery("size", *s.size)
+	if s.transferType != nil {
+		transferTypes = []FuturesTransferType{*s.transferType}
 	}
 
 	res = new(FuturesTransferHistory)
-	if err := s.c.CallAPI(ctx, r, res, opts...); err != nil {
-		return nil, err
+	for _, transferType := range transferTypes {
+		universalType, ok := futuresTransferTypes[transferType]
+		if !ok {
+			return nil, fmt.Errorf("unknown futures transfer type %d", transferType)
+		}
+		service := s.c.NewListUniversalTransferService().Type(universalType)
+		if s.startTime != 0 {
+			service.StartTime(s.startTime)
+		}
+		if s.endTime != nil {
+			service.EndTime(*s.endTime)
+		}
+		if s.current != nil {
+			service.Current(*s.current)
+		}
+		if s.size != nil {
+			service.Size(*s.size)
+		}
+		history, err := service.Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		res.Total += history.Total
+		for _, t := range history.Rows {
+			if s.asset != "" && t.Asset != s.asset {
+				continue
+			}
+			res.Rows = append(res.Rows, FuturesTransfer{
+				Asset:     t.Asset,
+				TranID:    t.TranID,
+				Amount:    t.Amount,
+				Type:      int64(transferType),
+				Timestamp: t.Timestamp,
+				Status:    FuturesTransferStatusType(t.Status),
+			})
+		}
 	}
 	return res, nil
 }
@@ -29,7 +29,7 @@ func (s *futuresTransferTestSuite) TestTransfer() {
 		e := newSignedRequest().SetFormParams(common.Params{
 			"asset":  asset,
 			"amount": amount,
-			"type":   transferType,
+			"type":   UniversalTransferTypeMainUMFuture,
 		})
 		s.assertRequestEqual(e, r)
 	})
@@ -48,17 +48,25 @@ func (s *futuresTransferTestSuite) assertTransactionResponseEqual(a, e *Transact
 
 func (s *futuresTransferTestSuite) TestListFuturesTransfer() {
 	data := []byte(`{
+		"total": 2,
 		"rows": [
 		  {
 			"asset": "USDT",
-			"tranId": 100000001,
 			"amount": "40.84624400",
-			"type": 1,
-			"timestamp": 1555056425000,
-			"status": "CONFIRMED"
+			"type": "MAIN_UMFUTURE",
+			"status": "CONFIRMED",
+			"tranId": 100000001,
+			"timestamp": 1555056425000
+		  },
+		  {
+			"asset": "BNB",
+			"amount": "1.00000000",
+			"type": "MAIN_UMFUTURE",
+			"status": "CONFIRMED",
+			"tranId": 100000002,
+			"timestamp": 1555056426000
 		  }
-		],
-		"total": 1
+		]
 	}`)
 	s.mockDo(data, nil)
 	defer s.assertDo()
@@ -66,13 +74,13 @@ func (s *futuresTransferTestSuite) TestListFuturesTransfer() {
 	startTime := int64(1555056425000)
 	s.assertReq(func(r *common.Request) {
 		e := newSignedRequest().SetQueryParams(common.Params{
-			"asset":     asset,
+			"type":      UniversalTransferTypeMainUMFuture,
 			"startTime": startTime,
 		})
 		s.assertRequestEqual(e, r)
 	})
 	res, err := s.client.NewListFuturesTransferService().Asset(asset).
-		StartTime(startTime).Do(newContext())
+		Type(FuturesTransferTypeSpotToFutures).StartTime(startTime).Do(newContext())
 	s.r().NoError(err)
 	e := &FuturesTransferHistory{
 		Rows: []FuturesTransfer{
@@ -85,11 +93,16 @@ func (s *futuresTransferTestSuite) TestListFuturesTransfer() {
 				Status:    FuturesTransferStatusTypeConfirmed,
 			},
 		},
-		Total: 1,
+		Total: 2,
 	}
 	s.assertFuturesTransferHistoryEqual(e, res)
 }
 
+func (s *futuresTransferTestSuite) TestListFuturesTransferUnknownType() {
+	_, err := s.client.NewListFuturesTransferService().Type(FuturesTransferType(9)).Do(newContext())
+	s.r().EqualError(err, "unknown futures transfer type 9")
+}
+
 func (s *futuresTransferTestSuite) assertFuturesTransferHistoryEqual(e, a *FuturesTransferHistory) {
 	s.r().Equal(e.Total, a.Total, "Total")
 	s.r().Len(a.Rows, len(e.Rows))
@@ -0,0 +1,262 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ListMarginInterestHistoryService list the interests charged on margin loans
+type ListMarginInterestHistoryService struct {
+	c              *Client
+	asset          *string
+	isolatedSymbol *string
+	startTime      *int64
+	endTime        *int64
+	current        *int64
+	size           *int64
+	archived       *bool
+}
+
+// Asset set asset
+func (s *ListMarginInterestHistoryService) Asset(asset string) *ListMarginInterestHistoryService {
+	s.asset = &asset
+	return s
+}
+
+// IsolatedSymbol set isolatedSymbol, the cross margin account is listed when unset
+func (s *ListMarginInterestHistoryService) IsolatedSymbol(isolatedSymbol string) *ListMarginInterestHistoryService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// StartTime set start time
+func (s *ListMarginInterestHistoryService) StartTime(startTime int64) *ListMarginInterestHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListMarginInterestHistoryService) EndTime(endTime int64) *ListMarginInterestHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *ListMarginInterestHistoryService) Current(current int64) *ListMarginInterestHistoryService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *ListMarginInterestHistoryService) Size(size int64) *ListMarginInterestHistoryService {
+	s.size = &size
+	return s
+}
+
+// Archived set archived, true to list the interests older than 6 months
+func (s *ListMarginInterestHistoryService) Archived(archived bool) *ListMarginInterestHistoryService {
+	s.archived = &archived
+	return s
+}
+
+// Do send Request
+func (s *ListMarginInterestHistoryService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginInterestHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/interestHistory")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.isolatedSymbol != nil {
+		r.SetQuery("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+	if s.archived != nil {
+		r.SetQuery("archived", *s.archived)
+	}
+
+	res = new(MarginInterestHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginInterestHistory define margin interest history response
+type MarginInterestHistory struct {
+	Rows  []MarginInterest `json:"rows"`
+	Total int64            `json:"total"`
+}
+
+// MarginInterest define a margin interest charge
+type MarginInterest struct {
+	TxID                int64  `json:"txId"`
+	InterestAccuredTime int64  `json:"interestAccuredTime"`
+	Asset               string `json:"asset"`
+	RawAsset            string `json:"rawAsset"`
+	Principal           string `json:"principal"`
+	Interest            string `json:"interest"`
+	InterestRate        string `json:"interestRate"`
+	// Type is one of PERIODIC, ON_BORROW, PERIODIC_CONVERTED, ON_BORROW_CONVERTED
+	// and PORTFOLIO.
+	Type           string `json:"type"`
+	IsolatedSymbol string `json:"isolatedSymbol"`
+}
+
+// ListMarginForceLiquidationService list the force liquidation orders of the margin accounts
+type ListMarginForceLiquidationService struct {
+	c              *Client
+	isolatedSymbol *string
+	startTime      *int64
+	endTime        *int64
+	current        *int64
+	size           *int64
+}
+
+// IsolatedSymbol set isolatedSymbol
+func (s *ListMarginForceLiquidationService) IsolatedSymbol(isolatedSymbol string) *ListMarginForceLiquidationService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
+// StartTime set start time
+func (s *ListMarginForceLiquidationService) StartTime(startTime int64) *ListMarginForceLiquidationService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListMarginForceLiquidationService) EndTime(endTime int64) *ListMarginForceLiquidationService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *ListMarginForceLiquidationService) Current(current int64) *ListMarginForceLiquidationService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *ListMarginForceLiquidationService) Size(size int64) *ListMarginForceLiquidationService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListMarginForceLiquidationService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginForceLiquidationResponse, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/forceLiquidationRec")
+	if s.isolatedSymbol != nil {
+		r.SetQuery("isolatedSymbol", *s.isolatedSymbol)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(MarginForceLiquidationResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginForceLiquidationResponse define margin force liquidation response
+type MarginForceLiquidationResponse struct {
+	Rows  []MarginForceLiquidation `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+// MarginForceLiquidation define a force liquidation order
+type MarginForceLiquidation struct {
+	AveragePrice     string          `json:"avgPrice"`
+	ExecutedQuantity string          `json:"executedQty"`
+	OrderID          int64           `json:"orderId"`
+	Price            string          `json:"price"`
+	Quantity         string          `json:"qty"`
+	Side             SideType        `json:"side"`
+	Symbol           string          `json:"symbol"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	IsIsolated       bool            `json:"isIsolated"`
+	UpdatedTime      int64           `json:"updatedTime"`
+}
+
+// ListMarginInterestRateHistoryService list the daily interest rates of a margin asset
+type ListMarginInterestRateHistoryService struct {
+	c         *Client
+	asset     string
+	vipLevel  *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Asset set asset
+func (s *ListMarginInterestRateHistoryService) Asset(asset string) *ListMarginInterestRateHistoryService {
+	s.asset = asset
+	return s
+}
+
+// VipLevel set vipLevel, the level of the account by default
+func (s *ListMarginInterestRateHistoryService) VipLevel(vipLevel int) *ListMarginInterestRateHistoryService {
+	s.vipLevel = &vipLevel
+	return s
+}
+
+// StartTime set start time
+func (s *ListMarginInterestRateHistoryService) StartTime(startTime int64) *ListMarginInterestRateHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListMarginInterestRateHistoryService) EndTime(endTime int64) *ListMarginInterestRateHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send Request
+func (s *ListMarginInterestRateHistoryService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginInterestRate, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/interestRateHistory")
+	r.SetQuery("asset", s.asset)
+	if s.vipLevel != nil {
+		r.SetQuery("vipLevel", *s.vipLevel)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	res = make([]*MarginInterestRate, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginInterestRate define the daily interest rate of a margin asset
+type MarginInterestRate struct {
+	Asset             string `json:"asset"`
+	DailyInterestRate string `json:"dailyInterestRate"`
+	Timestamp         int64  `json:"timestamp"`
+	VipLevel          int    `json:"vipLevel"`
+}
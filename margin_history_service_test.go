@@ -0,0 +1,143 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marginHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarginHistoryService(t *testing.T) {
+	suite.Run(t, new(marginHistoryServiceTestSuite))
+}
+
+func (s *marginHistoryServiceTestSuite) TestListMarginInterestHistory() {
+	data := []byte(`{
+		"rows": [
+			{
+				"txId": 1352286576452864727,
+				"interestAccuredTime": 1672160400000,
+				"asset": "USDT",
+				"rawAsset": "USDT",
+				"principal": "45.3313",
+				"interest": "0.00024995",
+				"interestRate": "0.00013233",
+				"type": "ON_BORROW",
+				"isolatedSymbol": "BNBUSDT"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":          "USDT",
+			"isolatedSymbol": "BNBUSDT",
+			"current":        1,
+			"size":           10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginInterestHistoryService().Asset("USDT").IsolatedSymbol("BNBUSDT").
+		Current(1).Size(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginInterestHistory{
+		Rows: []MarginInterest{{
+			TxID:                1352286576452864727,
+			InterestAccuredTime: 1672160400000,
+			Asset:               "USDT",
+			RawAsset:            "USDT",
+			Principal:           "45.3313",
+			Interest:            "0.00024995",
+			InterestRate:        "0.00013233",
+			Type:                "ON_BORROW",
+			IsolatedSymbol:      "BNBUSDT",
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *marginHistoryServiceTestSuite) TestListMarginForceLiquidation() {
+	data := []byte(`{
+		"rows": [
+			{
+				"avgPrice": "0.00388359",
+				"executedQty": "31.39000000",
+				"orderId": 180015097,
+				"price": "0.00388110",
+				"qty": "31.39000000",
+				"side": "SELL",
+				"symbol": "BNBBTC",
+				"timeInForce": "GTC",
+				"isIsolated": true,
+				"updatedTime": 1558941374745
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"isolatedSymbol": "BNBBTC",
+			"startTime":      1558941000000,
+			"endTime":        1558942000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginForceLiquidationService().IsolatedSymbol("BNBBTC").
+		StartTime(1558941000000).EndTime(1558942000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginForceLiquidationResponse{
+		Rows: []MarginForceLiquidation{{
+			AveragePrice:     "0.00388359",
+			ExecutedQuantity: "31.39000000",
+			OrderID:          180015097,
+			Price:            "0.00388110",
+			Quantity:         "31.39000000",
+			Side:             SideTypeSell,
+			Symbol:           "BNBBTC",
+			TimeInForce:      TimeInForceTypeGTC,
+			IsIsolated:       true,
+			UpdatedTime:      1558941374745,
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *marginHistoryServiceTestSuite) TestListMarginInterestRateHistory() {
+	data := []byte(`[
+		{
+			"asset": "BTC",
+			"dailyInterestRate": "0.00025000",
+			"timestamp": 1611544731000,
+			"vipLevel": 1
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":    "BTC",
+			"vipLevel": 1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginInterestRateHistoryService().Asset("BTC").VipLevel(1).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*MarginInterestRate{{
+		Asset:             "BTC",
+		DailyInterestRate: "0.00025000",
+		Timestamp:         1611544731000,
+		VipLevel:          1,
+	}}, res)
+}
@@ -0,0 +1,264 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// IsolatedMarginTransferService transfer between spot account and an isolated margin account
+type IsolatedMarginTransferService struct {
+	c         *Client
+	asset     string
+	symbol    string
+	transFrom IsolatedMarginAccountType
+	transTo   IsolatedMarginAccountType
+	amount    string
+}
+
+// Asset set asset being transferred, e.g., BTC
+func (s *IsolatedMarginTransferService) Asset(asset string) *IsolatedMarginTransferService {
+	s.asset = asset
+	return s
+}
+
+// Symbol set the symbol of the isolated margin account
+func (s *IsolatedMarginTransferService) Symbol(symbol string) *IsolatedMarginTransferService {
+	s.symbol = symbol
+	return s
+}
+
+// TransFrom set the source account
+func (s *IsolatedMarginTransferService) TransFrom(transFrom IsolatedMarginAccountType) *IsolatedMarginTransferService {
+	s.transFrom = transFrom
+	return s
+}
+
+// TransTo set the destination account
+func (s *IsolatedMarginTransferService) TransTo(transTo IsolatedMarginAccountType) *IsolatedMarginTransferService {
+	s.transTo = transTo
+	return s
+}
+
+// Amount the amount to be transferred
+func (s *IsolatedMarginTransferService) Amount(amount string) *IsolatedMarginTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send Request
+func (s *IsolatedMarginTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *TransactionResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/margin/isolated/transfer")
+	m := common.Params{
+		"asset":     s.asset,
+		"symbol":    s.symbol,
+		"transFrom": s.transFrom,
+		"transTo":   s.transTo,
+		"amount":    s.amount,
+	}
+	r.SetFormParams(m)
+
+	res = new(TransactionResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListIsolatedMarginTransfersService list the transfers of an isolated margin account
+type ListIsolatedMarginTransfersService struct {
+	c         *Client
+	symbol    string
+	asset     *string
+	transFrom *IsolatedMarginAccountType
+	transTo   *IsolatedMarginAccountType
+	startTime *int64
+	endTime   *int64
+	current   *int64
+	size      *int64
+	archived  *bool
+}
+
+// Symbol set the symbol of the isolated margin account
+func (s *ListIsolatedMarginTransfersService) Symbol(symbol string) *ListIsolatedMarginTransfersService {
+	s.symbol = symbol
+	return s
+}
+
+// Asset set asset
+func (s *ListIsolatedMarginTransfersService) Asset(asset string) *ListIsolatedMarginTransfersService {
+	s.asset = &asset
+	return s
+}
+
+// TransFrom set the source account
+func (s *ListIsolatedMarginTransfersService) TransFrom(transFrom IsolatedMarginAccountType) *ListIsolatedMarginTransfersService {
+	s.transFrom = &transFrom
+	return s
+}
+
+// TransTo set the destination account
+func (s *ListIsolatedMarginTransfersService) TransTo(transTo IsolatedMarginAccountType) *ListIsolatedMarginTransfersService {
+	s.transTo = &transTo
+	return s
+}
+
+// StartTime set start time
+func (s *ListIsolatedMarginTransfersService) StartTime(startTime int64) *ListIsolatedMarginTransfersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListIsolatedMarginTransfersService) EndTime(endTime int64) *ListIsolatedMarginTransfersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *ListIsolatedMarginTransfersService) Current(current int64) *ListIsolatedMarginTransfersService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *ListIsolatedMarginTransfersService) Size(size int64) *ListIsolatedMarginTransfersService {
+	s.size = &size
+	return s
+}
+
+// Archived set archived, true to list the transfers older than 6 months
+func (s *ListIsolatedMarginTransfersService) Archived(archived bool) *ListIsolatedMarginTransfersService {
+	s.archived = &archived
+	return s
+}
+
+// Do send Request
+func (s *ListIsolatedMarginTransfersService) Do(ctx context.Context, opts ...common.RequestOption) (res *IsolatedMarginTransferResponse, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/isolated/transfer")
+	r.SetQuery("symbol", s.symbol)
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.transFrom != nil {
+		r.SetQuery("transFrom", *s.transFrom)
+	}
+	if s.transTo != nil {
+		r.SetQuery("transTo", *s.transTo)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+	if s.archived != nil {
+		r.SetQuery("archived", *s.archived)
+	}
+
+	res = new(IsolatedMarginTransferResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsolatedMarginTransferResponse define isolated margin transfer history response
+type IsolatedMarginTransferResponse struct {
+	Rows  []IsolatedMarginTransfer `json:"rows"`
+	Total int64                    `json:"total"`
+}
+
+// IsolatedMarginTransfer define an isolated margin transfer
+type IsolatedMarginTransfer struct {
+	Amount    string                    `json:"amount"`
+	Asset     string                    `json:"asset"`
+	Status    string                    `json:"status"`
+	Timestamp int64                     `json:"timestamp"`
+	TxID      int64                     `json:"txId"`
+	TransFrom IsolatedMarginAccountType `json:"transFrom"`
+	TransTo   IsolatedMarginAccountType `json:"transTo"`
+}
+
+// EnableIsolatedMarginAccountService enable an isolated margin account
+type EnableIsolatedMarginAccountService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *EnableIsolatedMarginAccountService) Symbol(symbol string) *EnableIsolatedMarginAccountService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send Request
+func (s *EnableIsolatedMarginAccountService) Do(ctx context.Context, opts ...common.RequestOption) (res *IsolatedMarginAccountStatus, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/margin/isolated/account")
+	r.SetForm("symbol", s.symbol)
+
+	res = new(IsolatedMarginAccountStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DisableIsolatedMarginAccountService disable an isolated margin account, it
+// can only be enabled again 24 hours later
+type DisableIsolatedMarginAccountService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *DisableIsolatedMarginAccountService) Symbol(symbol string) *DisableIsolatedMarginAccountService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send Request
+func (s *DisableIsolatedMarginAccountService) Do(ctx context.Context, opts ...common.RequestOption) (res *IsolatedMarginAccountStatus, err error) {
+	r := common.NewDeleteRequestSigned("/sapi/v1/margin/isolated/account")
+	r.SetForm("symbol", s.symbol)
+
+	res = new(IsolatedMarginAccountStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsolatedMarginAccountStatus define the result of enabling or disabling an isolated margin account
+type IsolatedMarginAccountStatus struct {
+	Success bool   `json:"success"`
+	Symbol  string `json:"symbol"`
+}
+
+// GetIsolatedMarginAccountLimitService get the number of enabled isolated margin accounts and its limit
+type GetIsolatedMarginAccountLimitService struct {
+	c *Client
+}
+
+// Do send Request
+func (s *GetIsolatedMarginAccountLimitService) Do(ctx context.Context, opts ...common.RequestOption) (res *IsolatedMarginAccountLimit, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/isolated/accountLimit")
+
+	res = new(IsolatedMarginAccountLimit)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsolatedMarginAccountLimit define isolated margin account limit
+type IsolatedMarginAccountLimit struct {
+	EnabledAccount int `json:"enabledAccount"`
+	MaxAccount     int `json:"maxAccount"`
+}
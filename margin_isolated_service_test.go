@@ -0,0 +1,139 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marginIsolatedServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarginIsolatedService(t *testing.T) {
+	suite.Run(t, new(marginIsolatedServiceTestSuite))
+}
+
+func (s *marginIsolatedServiceTestSuite) TestIsolatedMarginTransfer() {
+	data := []byte(`{
+		"tranId": 100000001
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"asset":     "BTC",
+			"symbol":    "BTCUSDT",
+			"transFrom": IsolatedMarginAccountTypeSpot,
+			"transTo":   IsolatedMarginAccountTypeIsolatedMargin,
+			"amount":    "1.000",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewIsolatedMarginTransferService().Asset("BTC").Symbol("BTCUSDT").
+		TransFrom(IsolatedMarginAccountTypeSpot).TransTo(IsolatedMarginAccountTypeIsolatedMargin).
+		Amount("1.000").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(100000001), res.TranID)
+}
+
+func (s *marginIsolatedServiceTestSuite) TestListIsolatedMarginTransfers() {
+	data := []byte(`{
+		"rows": [
+			{
+				"amount": "0.10000000",
+				"asset": "BNB",
+				"status": "CONFIRMED",
+				"timestamp": 1566898617000,
+				"txId": 5240372201,
+				"transFrom": "SPOT",
+				"transTo": "ISOLATED_MARGIN"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":    "BNBUSDT",
+			"asset":     "BNB",
+			"transFrom": IsolatedMarginAccountTypeSpot,
+			"startTime": 1566898600000,
+			"size":      10,
+			"archived":  true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListIsolatedMarginTransfersService().Symbol("BNBUSDT").Asset("BNB").
+		TransFrom(IsolatedMarginAccountTypeSpot).StartTime(1566898600000).Size(10).Archived(true).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&IsolatedMarginTransferResponse{
+		Rows: []IsolatedMarginTransfer{{
+			Amount:    "0.10000000",
+			Asset:     "BNB",
+			Status:    "CONFIRMED",
+			Timestamp: 1566898617000,
+			TxID:      5240372201,
+			TransFrom: IsolatedMarginAccountTypeSpot,
+			TransTo:   IsolatedMarginAccountTypeIsolatedMargin,
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *marginIsolatedServiceTestSuite) TestEnableIsolatedMarginAccount() {
+	data := []byte(`{
+		"success": true,
+		"symbol": "BTCUSDT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewEnableIsolatedMarginAccountService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&IsolatedMarginAccountStatus{Success: true, Symbol: "BTCUSDT"}, res)
+}
+
+func (s *marginIsolatedServiceTestSuite) TestDisableIsolatedMarginAccount() {
+	data := []byte(`{
+		"success": true,
+		"symbol": "BTCUSDT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"symbol": "BTCUSDT"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewDisableIsolatedMarginAccountService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&IsolatedMarginAccountStatus{Success: true, Symbol: "BTCUSDT"}, res)
+}
+
+func (s *marginIsolatedServiceTestSuite) TestGetIsolatedMarginAccountLimit() {
+	data := []byte(`{
+		"enabledAccount": 5,
+		"maxAccount": 20
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetIsolatedMarginAccountLimitService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&IsolatedMarginAccountLimit{EnabledAccount: 5, MaxAccount: 20}, res)
+}
@@ -0,0 +1,460 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// CreateMarginOCOService create a margin OCO order
+type CreateMarginOCOService struct {
+	c                    *Client
+	symbol               string
+	isIsolated           bool
+	listClientOrderID    *string
+	side                 SideType
+	quantity             string
+	limitClientOrderID   *string
+	price                string
+	limitIcebergQty      *string
+	stopClientOrderID    *string
+	stopPrice            string
+	stopLimitPrice       *string
+	stopIcebergQty       *string
+	stopLimitTimeInForce *TimeInForceType
+	newOrderRespType     *NewOrderRespType
+	sideEffectType       *SideEffectType
+}
+
+// Symbol set symbol
+func (s *CreateMarginOCOService) Symbol(symbol string) *CreateMarginOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *CreateMarginOCOService) IsIsolated(isIsolated bool) *CreateMarginOCOService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateMarginOCOService) ListClientOrderID(listClientOrderID string) *CreateMarginOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Side set side
+func (s *CreateMarginOCOService) Side(side SideType) *CreateMarginOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateMarginOCOService) Quantity(quantity string) *CreateMarginOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// LimitClientOrderID set limitClientOrderID
+func (s *CreateMarginOCOService) LimitClientOrderID(limitClientOrderID string) *CreateMarginOCOService {
+	s.limitClientOrderID = &limitClientOrderID
+	return s
+}
+
+// Price set price of the limit order
+func (s *CreateMarginOCOService) Price(price string) *CreateMarginOCOService {
+	s.price = price
+	return s
+}
+
+// LimitIcebergQuantity set limitIcebergQty
+func (s *CreateMarginOCOService) LimitIcebergQuantity(limitIcebergQty string) *CreateMarginOCOService {
+	s.limitIcebergQty = &limitIcebergQty
+	return s
+}
+
+// StopClientOrderID set stopClientOrderID
+func (s *CreateMarginOCOService) StopClientOrderID(stopClientOrderID string) *CreateMarginOCOService {
+	s.stopClientOrderID = &stopClientOrderID
+	return s
+}
+
+// StopPrice set stop price
+func (s *CreateMarginOCOService) StopPrice(stopPrice string) *CreateMarginOCOService {
+	s.stopPrice = stopPrice
+	return s
+}
+
+// StopLimitPrice set stop limit price
+func (s *CreateMarginOCOService) StopLimitPrice(stopLimitPrice string) *CreateMarginOCOService {
+	s.stopLimitPrice = &stopLimitPrice
+	return s
+}
+
+// StopIcebergQty set stopIcebergQty
+func (s *CreateMarginOCOService) StopIcebergQty(stopIcebergQty string) *CreateMarginOCOService {
+	s.stopIcebergQty = &stopIcebergQty
+	return s
+}
+
+// StopLimitTimeInForce set stopLimitTimeInForce
+func (s *CreateMarginOCOService) StopLimitTimeInForce(stopLimitTimeInForce TimeInForceType) *CreateMarginOCOService {
+	s.stopLimitTimeInForce = &stopLimitTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateMarginOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *CreateMarginOCOService) SideEffectType(sideEffectType SideEffectType) *CreateMarginOCOService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// Do send Request
+func (s *CreateMarginOCOService) Do(ctx context.Context, opts ...common.RequestOption) (res *CreateMarginOCOResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/margin/order/oco")
+	m := common.Params{
+		"symbol":    s.symbol,
+		"side":      s.side,
+		"quantity":  s.quantity,
+		"price":     s.price,
+		"stopPrice": s.stopPrice,
+	}
+	if s.isIsolated {
+		m["isIsolated"] = "TRUE"
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.limitClientOrderID != nil {
+		m["limitClientOrderId"] = *s.limitClientOrderID
+	}
+	if s.limitIcebergQty != nil {
+		m["limitIcebergQty"] = *s.limitIcebergQty
+	}
+	if s.stopClientOrderID != nil {
+		m["stopClientOrderId"] = *s.stopClientOrderID
+	}
+	if s.stopLimitPrice != nil {
+		m["stopLimitPrice"] = *s.stopLimitPrice
+	}
+	if s.stopIcebergQty != nil {
+		m["stopIcebergQty"] = *s.stopIcebergQty
+	}
+	if s.stopLimitTimeInForce != nil {
+		m["stopLimitTimeInForce"] = *s.stopLimitTimeInForce
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.sideEffectType != nil {
+		m["sideEffectType"] = *s.sideEffectType
+	}
+	r.SetFormParams(m)
+
+	res = new(CreateMarginOCOResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateMarginOCOResponse define create margin OCO response
+type CreateMarginOCOResponse struct {
+	CreateOCOResponse
+	MarginBuyBorrowAmount string `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string `json:"marginBuyBorrowAsset"`
+	IsIsolated            bool   `json:"isIsolated"`
+}
+
+// CancelMarginOCOService cancel all the orders of a margin order list
+type CancelMarginOCOService struct {
+	c                 *Client
+	symbol            string
+	isIsolated        bool
+	orderListID       *int64
+	listClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Symbol set symbol
+func (s *CancelMarginOCOService) Symbol(symbol string) *CancelMarginOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *CancelMarginOCOService) IsIsolated(isIsolated bool) *CancelMarginOCOService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// OrderListID set orderListID
+func (s *CancelMarginOCOService) OrderListID(orderListID int64) *CancelMarginOCOService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CancelMarginOCOService) ListClientOrderID(listClientOrderID string) *CancelMarginOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CancelMarginOCOService) NewClientOrderID(newClientOrderID string) *CancelMarginOCOService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// Do send Request
+func (s *CancelMarginOCOService) Do(ctx context.Context, opts ...common.RequestOption) (res *CancelMarginOCOResponse, err error) {
+	r := common.NewDeleteRequestSigned("/sapi/v1/margin/orderList")
+	r.SetForm("symbol", s.symbol)
+	if s.isIsolated {
+		r.SetForm("isIsolated", "TRUE")
+	}
+	if s.orderListID != nil {
+		r.SetForm("orderListId", *s.orderListID)
+	}
+	if s.listClientOrderID != nil {
+		r.SetForm("listClientOrderId", *s.listClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.SetForm("newClientOrderId", *s.newClientOrderID)
+	}
+
+	res = new(CancelMarginOCOResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelMarginOCOResponse define cancel margin OCO response
+type CancelMarginOCOResponse struct {
+	CancelOCOResponse
+	IsIsolated bool `json:"isIsolated"`
+}
+
+// GetMarginOrderListService get a margin order list
+type GetMarginOrderListService struct {
+	c                 *Client
+	symbol            *string
+	isIsolated        bool
+	orderListID       *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *GetMarginOrderListService) Symbol(symbol string) *GetMarginOrderListService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *GetMarginOrderListService) IsIsolated(isIsolated bool) *GetMarginOrderListService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// OrderListID set orderListID
+func (s *GetMarginOrderListService) OrderListID(orderListID int64) *GetMarginOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID, the list client order id
+func (s *GetMarginOrderListService) OrigClientOrderID(origClientOrderID string) *GetMarginOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send Request
+func (s *GetMarginOrderListService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginOrderList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/orderList")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetQuery("isIsolated", "TRUE")
+	}
+	if s.orderListID != nil {
+		r.SetQuery("orderListId", *s.orderListID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetQuery("origClientOrderId", *s.origClientOrderID)
+	}
+
+	res = new(MarginOrderList)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginOrderListsService list the margin order lists, open or not
+type ListMarginOrderListsService struct {
+	c          *Client
+	symbol     *string
+	isIsolated bool
+	fromID     *int64
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *ListMarginOrderListsService) Symbol(symbol string) *ListMarginOrderListsService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *ListMarginOrderListsService) IsIsolated(isIsolated bool) *ListMarginOrderListsService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// FromID set fromID, the first order list id returned
+func (s *ListMarginOrderListsService) FromID(fromID int64) *ListMarginOrderListsService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginOrderListsService) StartTime(startTime int64) *ListMarginOrderListsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginOrderListsService) EndTime(endTime int64) *ListMarginOrderListsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListMarginOrderListsService) Limit(limit int) *ListMarginOrderListsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *ListMarginOrderListsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginOrderList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/allOrderList")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetQuery("isIsolated", "TRUE")
+	}
+	if s.fromID != nil {
+		r.SetQuery("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*MarginOrderList, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginOpenOrderListsService list the open margin order lists
+type ListMarginOpenOrderListsService struct {
+	c          *Client
+	symbol     *string
+	isIsolated bool
+}
+
+// Symbol set symbol, mandatory for isolated margin
+func (s *ListMarginOpenOrderListsService) Symbol(symbol string) *ListMarginOpenOrderListsService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *ListMarginOpenOrderListsService) IsIsolated(isIsolated bool) *ListMarginOpenOrderListsService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Do send Request
+func (s *ListMarginOpenOrderListsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginOrderList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/margin/openOrderList")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.isIsolated {
+		r.SetQuery("isIsolated", "TRUE")
+	}
+
+	res = make([]*MarginOrderList, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginOrderList define a margin order list
+type MarginOrderList struct {
+	OrderListID       int64       `json:"orderListId"`
+	ContingencyType   string      `json:"contingencyType"`
+	ListStatusType    string      `json:"listStatusType"`
+	ListOrderStatus   string      `json:"listOrderStatus"`
+	ListClientOrderID string      `json:"listClientOrderId"`
+	TransactionTime   int64       `json:"transactionTime"`
+	Symbol            string      `json:"symbol"`
+	IsIsolated        bool        `json:"isIsolated"`
+	Orders            []*OCOOrder `json:"orders"`
+}
+
+// CancelMarginOpenOrdersService cancel all the open margin orders and order lists of a symbol
+type CancelMarginOpenOrdersService struct {
+	c          *Client
+	symbol     string
+	isIsolated bool
+}
+
+// Symbol set symbol
+func (s *CancelMarginOpenOrdersService) Symbol(symbol string) *CancelMarginOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// IsIsolated set isIsolated
+func (s *CancelMarginOpenOrdersService) IsIsolated(isIsolated bool) *CancelMarginOpenOrdersService {
+	s.isIsolated = isIsolated
+	return s
+}
+
+// Do send Request
+func (s *CancelMarginOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res *CancelOpenOrdersResponse, err error) {
+	r := common.NewDeleteRequestSigned("/sapi/v1/margin/openOrders")
+	r.SetForm("symbol", s.symbol)
+	if s.isIsolated {
+		r.SetForm("isIsolated", "TRUE")
+	}
+
+	rawMessages := make([]*json.RawMessage, 0)
+	if err = s.c.CallAPI(ctx, r, &rawMessages, opts...); err != nil {
+		return nil, err
+	}
+	return decodeCancelOpenOrders(rawMessages)
+}
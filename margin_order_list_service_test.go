@@ -0,0 +1,267 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marginOrderListServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarginOrderListService(t *testing.T) {
+	suite.Run(t, new(marginOrderListServiceTestSuite))
+}
+
+func (s *marginOrderListServiceTestSuite) TestCreateMarginOCO() {
+	data := []byte(`{
+		"orderListId": 0,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "JYVpp3F0f5CAG15DhtrqLp",
+		"transactionTime": 1563417480525,
+		"symbol": "LTCBTC",
+		"marginBuyBorrowAmount": "5",
+		"marginBuyBorrowAsset": "BTC",
+		"isIsolated": true,
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 2, "clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos"},
+			{"symbol": "LTCBTC", "orderId": 3, "clientOrderId": "xTXKaGYd4bluPVp78IVRvl"}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 2,
+				"orderListId": 0,
+				"clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos",
+				"transactTime": 1563417480525,
+				"price": "0.000000",
+				"origQty": "0.624363",
+				"executedQty": "0.000000",
+				"cummulativeQuoteQty": "0.000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "STOP_LOSS",
+				"side": "BUY",
+				"stopPrice": "0.960664"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":               "LTCBTC",
+			"isIsolated":           "TRUE",
+			"side":                 SideTypeBuy,
+			"quantity":             "0.624363",
+			"price":                "1.00000",
+			"stopPrice":            "0.960664",
+			"stopLimitPrice":       "0.960660",
+			"stopLimitTimeInForce": TimeInForceTypeGTC,
+			"sideEffectType":       SideEffectTypeMarginBuy,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateMarginOCOService().Symbol("LTCBTC").IsIsolated(true).Side(SideTypeBuy).
+		Quantity("0.624363").Price("1.00000").StopPrice("0.960664").StopLimitPrice("0.960660").
+		StopLimitTimeInForce(TimeInForceTypeGTC).SideEffectType(SideEffectTypeMarginBuy).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("5", res.MarginBuyBorrowAmount)
+	s.r().Equal("BTC", res.MarginBuyBorrowAsset)
+	s.r().True(res.IsIsolated)
+	s.r().Equal("JYVpp3F0f5CAG15DhtrqLp", res.ListClientOrderID)
+	s.r().Len(res.Orders, 2)
+	s.r().Equal(&OCOOrder{Symbol: "LTCBTC", OrderID: 3, ClientOrderID: "xTXKaGYd4bluPVp78IVRvl"}, res.Orders[1])
+	s.r().Len(res.OrderReports, 1)
+	s.r().Equal(OrderStatusTypeNew, res.OrderReports[0].Status)
+	s.r().Equal("0.960664", res.OrderReports[0].StopPrice)
+}
+
+func (s *marginOrderListServiceTestSuite) TestCancelMarginOCO() {
+	data := []byte(`{
+		"orderListId": 0,
+		"contingencyType": "OCO",
+		"listStatusType": "ALL_DONE",
+		"listOrderStatus": "ALL_DONE",
+		"listClientOrderId": "C3wyj4WVEktd7u9aVBRXcN",
+		"transactionTime": 1574040868128,
+		"symbol": "LTCBTC",
+		"isIsolated": false,
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 2, "clientOrderId": "pO9ufTiFGg3nw2fOdgeOXa"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":      "LTCBTC",
+			"orderListId": 0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMarginOCOService().Symbol("LTCBTC").OrderListID(0).Do(newContext())
+	s.r().NoError(err)
+	s.r().False(res.IsIsolated)
+	s.r().Equal("ALL_DONE", res.ListStatusType)
+	s.r().Equal(int64(1574040868128), res.TransactionTime)
+	s.r().Len(res.Orders, 1)
+}
+
+func (s *marginOrderListServiceTestSuite) TestGetMarginOrderList() {
+	data := []byte(`{
+		"orderListId": 27,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+		"transactionTime": 1565245656253,
+		"symbol": "LTCBTC",
+		"isIsolated": true,
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":      "LTCBTC",
+			"isIsolated":  "TRUE",
+			"orderListId": 27,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetMarginOrderListService().Symbol("LTCBTC").IsIsolated(true).OrderListID(27).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginOrderList{
+		OrderListID:       27,
+		ContingencyType:   "OCO",
+		ListStatusType:    "EXEC_STARTED",
+		ListOrderStatus:   "EXECUTING",
+		ListClientOrderID: "h2USkA5YQpaXHPIrkd96xE",
+		TransactionTime:   1565245656253,
+		Symbol:            "LTCBTC",
+		IsIsolated:        true,
+		Orders:            []*OCOOrder{{Symbol: "LTCBTC", OrderID: 4, ClientOrderID: "qD1gy3kc3Gx0rihm9Y3xwS"}},
+	}, res)
+}
+
+func (s *marginOrderListServiceTestSuite) TestListMarginOrderLists() {
+	data := []byte(`[
+		{
+			"orderListId": 29,
+			"contingencyType": "OCO",
+			"listStatusType": "EXEC_STARTED",
+			"listOrderStatus": "EXECUTING",
+			"listClientOrderId": "amEEAXryFzFwYF1FeRpUoZ",
+			"transactionTime": 1565245913483,
+			"symbol": "LTCBTC",
+			"isIsolated": false,
+			"orders": []
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"fromId": 29,
+			"limit":  1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginOrderListsService().FromID(29).Limit(1).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(29), res[0].OrderListID)
+	s.r().Equal("amEEAXryFzFwYF1FeRpUoZ", res[0].ListClientOrderID)
+}
+
+func (s *marginOrderListServiceTestSuite) TestListMarginOpenOrderLists() {
+	data := []byte(`[
+		{
+			"orderListId": 31,
+			"contingencyType": "OCO",
+			"listStatusType": "EXEC_STARTED",
+			"listOrderStatus": "EXECUTING",
+			"listClientOrderId": "wuB13fmulKj3YjdqWEcsnp",
+			"transactionTime": 1565246080644,
+			"symbol": "LTCBTC",
+			"isIsolated": true,
+			"orders": []
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":     "LTCBTC",
+			"isIsolated": "TRUE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginOpenOrderListsService().Symbol("LTCBTC").IsIsolated(true).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(31), res[0].OrderListID)
+	s.r().True(res[0].IsIsolated)
+}
+
+func (s *marginOrderListServiceTestSuite) TestCancelMarginOpenOrders() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+			"orderId": 11,
+			"orderListId": -1,
+			"clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+			"price": "0.089853",
+			"origQty": "0.178622",
+			"executedQty": "0.000000",
+			"cummulativeQuoteQty": "0.000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY"
+		},
+		{
+			"orderListId": 1929,
+			"contingencyType": "OCO",
+			"listStatusType": "ALL_DONE",
+			"listOrderStatus": "ALL_DONE",
+			"listClientOrderId": "2inzWQdDvZLHbbAmAozX2N",
+			"transactionTime": 1585230948299,
+			"symbol": "BTCUSDT",
+			"orders": [],
+			"orderReports": []
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":     "BTCUSDT",
+			"isIsolated": "TRUE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMarginOpenOrdersService().Symbol("BTCUSDT").IsIsolated(true).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Orders, 1)
+	s.r().Equal(int64(11), res.Orders[0].OrderID)
+	s.r().Equal(OrderStatusTypeCanceled, res.Orders[0].Status)
+	s.r().Len(res.OCOOrders, 1)
+	s.r().Equal(int64(1929), res.OCOOrders[0].OrderListID)
+}
@@ -2,17 +2,25 @@ package binance
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/crypto-zero/go-binance/v2/common"
 )
 
-// MarginTransferService transfer between spot account and margin account
+// marginTransferTypes map the margin transfer types to the universal ones.
+var marginTransferTypes = map[MarginTransferType]UniversalTransferType{
+	MarginTransferTypeToMargin: UniversalTransferTypeMainMargin,
+	MarginTransferTypeToMain:   UniversalTransferTypeMarginMain,
+}
+
+// MarginTransferService transfer between spot account and margin account, it
+// is a universal transfer
 type MarginTransferService struct {
 	c            *Client
 	asset        string
 	amount       string
-	transferType int
+	transferType MarginTransferType
 }
 
 // Asset set asset being transferred, e.g., BTC
@@ -27,27 +35,20 @@ func (s *MarginTransferService) Amount(amount string) *MarginTransferService {
 	return s
 }
 
-// Type 1: transfer from main account to margin account 2: transfer from margin account to main account
+// Type set the transfer direction, it is sent as the matching universal transfer
+// type, like MAIN_MARGIN for MarginTransferTypeToMargin
 func (s *MarginTransferService) Type(transferType MarginTransferType) *MarginTransferService {
-	s.transferType = int(transferType)
+	s.transferType = transferType
 	return s
 }
 
 // Do send Request
 func (s *MarginTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *TransactionResponse, err error) {
-	r := common.NewPostRequestSigned("/sapi/v1/margin/transfer")
-	m := common.Params{
-		"asset":  s.asset,
-		"amount": s.amount,
-		"type":   s.transferType,
+	transferType, ok := marginTransferTypes[s.transferType]
+	if !ok {
+		return nil, fmt.Errorf("unknown margin transfer type %d", s.transferType)
 	}
-	r.SetFormParams(m)
-
-	res = new(TransactionResponse)
-	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
-		return nil, err
-	}
-	return res, nil
+	return s.c.NewUniversalTransferService().Type(transferType).Asset(s.asset).Amount(s.amount).Do(ctx, opts...)
 }
 
 // TransactionResponse define transaction response
@@ -147,13 +148,14 @@ func (s *MarginRepayService) Do(ctx context.Context, opts ...common.RequestOptio
 
 // ListMarginLoansService list loan record
 type ListMarginLoansService struct {
-	c         *Client
-	asset     string
-	txID      *int64
-	startTime *int64
-	endTime   *int64
-	current   *int64
-	size      *int64
+	c              *Client
+	asset          string
+	isolatedSymbol *string
+	txID           *int64
+	startTime      *int64
+	endTime        *int64
+	current        *int64
+	size           *int64
 }
 
 // Asset set asset
@@ -162,6 +164,12 @@ func (s *ListMarginLoansService) Asset(asset string) *ListMarginLoansService {
 	return s
 }
 
+// IsolatedSymbol set isolated symbol, the cross margin account is listed when unset
+func (s *ListMarginLoansService) IsolatedSymbol(isolatedSymbol string) *ListMarginLoansService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
 // TxID set transaction id
 func (s *ListMarginLoansService) TxID(txID int64) *ListMarginLoansService {
 	s.txID = &txID
@@ -196,6 +204,9 @@ func (s *ListMarginLoansService) Size(size int64) *ListMarginLoansService {
 func (s *ListMarginLoansService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginLoanResponse, err error) {
 	r := common.NewGetRequestSigned("/sapi/v1/margin/loan")
 	r.SetQuery("asset", s.asset)
+	if s.isolatedSymbol != nil {
+		r.SetQuery("isolatedSymbol", *s.isolatedSymbol)
+	}
 	if s.txID != nil {
 		r.SetQuery("txId", *s.txID)
 	}
@@ -227,21 +238,24 @@ type MarginLoanResponse struct {
 
 // MarginLoan define margin loan
 type MarginLoan struct {
-	Asset     string               `json:"asset"`
-	Principal string               `json:"principal"`
-	Timestamp int64                `json:"timestamp"`
-	Status    MarginLoanStatusType `json:"status"`
+	IsolatedSymbol string               `json:"isolatedSymbol"`
+	TxID           int64                `json:"txId"`
+	Asset          string               `json:"asset"`
+	Principal      string               `json:"principal"`
+	Timestamp      int64                `json:"timestamp"`
+	Status         MarginLoanStatusType `json:"status"`
 }
 
 // ListMarginRepaysService list repay record
 type ListMarginRepaysService struct {
-	c         *Client
-	asset     string
-	txID      *int64
-	startTime *int64
-	endTime   *int64
-	current   *int64
-	size      *int64
+	c              *Client
+	asset          string
+	isolatedSymbol *string
+	txID           *int64
+	startTime      *int64
+	endTime        *int64
+	current        *int64
+	size           *int64
 }
 
 // Asset set asset
@@ -250,6 +264,12 @@ func (s *ListMarginRepaysService) Asset(asset string) *ListMarginRepaysService {
 	return s
 }
 
+// IsolatedSymbol set isolated symbol, the cross margin account is listed when unset
+func (s *ListMarginRepaysService) IsolatedSymbol(isolatedSymbol string) *ListMarginRepaysService {
+	s.isolatedSymbol = &isolatedSymbol
+	return s
+}
+
 // TxID set transaction id
 func (s *ListMarginRepaysService) TxID(txID int64) *ListMarginRepaysService {
 	s.txID = &txID
@@ -284,6 +304,9 @@ func (s *ListMarginRepaysService) Size(size int64) *ListMarginRepaysService {
 func (s *ListMarginRepaysService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginRepayResponse, err error) {
 	r := common.NewGetRequestSigned("/sapi/v1/margin/repay")
 	r.SetQuery("asset", s.asset)
+	if s.isolatedSymbol != nil {
+		r.SetQuery("isolatedSymbol", *s.isolatedSymbol)
+	}
 	if s.txID != nil {
 		r.SetQuery("txId", *s.txID)
 	}
@@ -315,13 +338,14 @@ type MarginRepayResponse struct {
 
 // MarginRepay define margin repay
 type MarginRepay struct {
-	Asset     string                `json:"asset"`
-	Amount    string                `json:"amount"`
-	Interest  string                `json:"interest"`
-	Principal string                `json:"principal"`
-	Timestamp int64                 `json:"timestamp"`
-	Status    MarginRepayStatusType `json:"status"`
-	TxID      int64                 `json:"txId"`
+	IsolatedSymbol string                `json:"isolatedSymbol"`
+	Asset          string                `json:"asset"`
+	Amount         string                `json:"amount"`
+	Interest       string                `json:"interest"`
+	Principal      string                `json:"principal"`
+	Timestamp      int64                 `json:"timestamp"`
+	Status         MarginRepayStatusType `json:"status"`
+	TxID           int64                 `json:"txId"`
 }
 
 // GetIsolatedMarginAccountService gets isolated margin account info
@@ -29,7 +29,7 @@ func (s *marginTestSuite) TestTransfer() {
 		e := newSignedRequest().SetFormParams(common.Params{
 			"asset":  asset,
 			"amount": amount,
-			"type":   transferType,
+			"type":   UniversalTransferTypeMainMargin,
 		})
 		s.assertRequestEqual(e, r)
 	})
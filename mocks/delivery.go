@@ -0,0 +1,120 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/delivery"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// DeliveryClient is a mock of delivery.API for the COIN-M futures client.
+type DeliveryClient struct {
+	mock.Mock
+}
+
+var _ delivery.API = (*DeliveryClient)(nil)
+
+// ServerTime provides a mock function
+func (m *DeliveryClient) ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(int64)
+	return res, args.Error(1)
+}
+
+// Klines provides a mock function
+func (m *DeliveryClient) Klines(ctx context.Context, params delivery.KlinesParams, opts ...common.RequestOption) ([]*delivery.Kline, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*delivery.Kline)
+	return res, args.Error(1)
+}
+
+// ListPrices provides a mock function
+func (m *DeliveryClient) ListPrices(ctx context.Context, symbol, pair string, opts ...common.RequestOption) ([]*delivery.SymbolPrice, error) {
+	args := m.Called(ctx, symbol, pair)
+	res, _ := args.Get(0).([]*delivery.SymbolPrice)
+	return res, args.Error(1)
+}
+
+// ListBookTickers provides a mock function
+func (m *DeliveryClient) ListBookTickers(ctx context.Context, symbol, pair string, opts ...common.RequestOption) ([]*delivery.BookTicker, error) {
+	args := m.Called(ctx, symbol, pair)
+	res, _ := args.Get(0).([]*delivery.BookTicker)
+	return res, args.Error(1)
+}
+
+// ExchangeInfo provides a mock function
+func (m *DeliveryClient) ExchangeInfo(ctx context.Context, opts ...common.RequestOption) (*delivery.ExchangeInfo, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*delivery.ExchangeInfo)
+	return res, args.Error(1)
+}
+
+// CreateOrder provides a mock function
+func (m *DeliveryClient) CreateOrder(ctx context.Context, params delivery.CreateOrderParams, opts ...common.RequestOption) (*delivery.CreateOrderResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*delivery.CreateOrderResponse)
+	return res, args.Error(1)
+}
+
+// GetOrder provides a mock function
+func (m *DeliveryClient) GetOrder(ctx context.Context, query delivery.OrderQuery, opts ...common.RequestOption) (*delivery.Order, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*delivery.Order)
+	return res, args.Error(1)
+}
+
+// CancelOrder provides a mock function
+func (m *DeliveryClient) CancelOrder(ctx context.Context, query delivery.OrderQuery, opts ...common.RequestOption) (*delivery.CancelOrderResponse, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*delivery.CancelOrderResponse)
+	return res, args.Error(1)
+}
+
+// CancelAllOpenOrders provides a mock function
+func (m *DeliveryClient) CancelAllOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) error {
+	args := m.Called(ctx, symbol)
+	return args.Error(0)
+}
+
+// CountdownCancelAll provides a mock function
+func (m *DeliveryClient) CountdownCancelAll(ctx context.Context, symbol string, countdownTime int64, opts ...common.RequestOption) error {
+	args := m.Called(ctx, symbol, countdownTime)
+	return args.Error(0)
+}
+
+// ListOpenOrders provides a mock function
+func (m *DeliveryClient) ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*delivery.Order, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*delivery.Order)
+	return res, args.Error(1)
+}
+
+// ListOrders provides a mock function
+func (m *DeliveryClient) ListOrders(ctx context.Context, params delivery.ListOrdersParams, opts ...common.RequestOption) ([]*delivery.Order, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*delivery.Order)
+	return res, args.Error(1)
+}
+
+// GetAccount provides a mock function
+func (m *DeliveryClient) GetAccount(ctx context.Context, opts ...common.RequestOption) (*delivery.Account, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*delivery.Account)
+	return res, args.Error(1)
+}
+
+// GetBalance provides a mock function
+func (m *DeliveryClient) GetBalance(ctx context.Context, opts ...common.RequestOption) ([]*delivery.Balance, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).([]*delivery.Balance)
+	return res, args.Error(1)
+}
+
+// GetPositionRisk provides a mock function
+func (m *DeliveryClient) GetPositionRisk(ctx context.Context, pair string, opts ...common.RequestOption) ([]*delivery.PositionRisk, error) {
+	args := m.Called(ctx, pair)
+	res, _ := args.Get(0).([]*delivery.PositionRisk)
+	return res, args.Error(1)
+}
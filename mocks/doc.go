@@ -0,0 +1,12 @@
+// Package mocks provides testify mocks of the binance.API, futures.API and
+// delivery.API interfaces, so code depending on them can be tested without
+// HTTP fixtures:
+//
+//	m := new(mocks.FuturesClient)
+//	m.On("GetPositionRisk", mock.Anything, "BTCUSDT").Return([]*futures.PositionRisk{
+//		{Symbol: "BTCUSDT", PositionAmt: "0.1"},
+//	}, nil)
+//	defer m.AssertExpectations(t)
+//
+// Request options are not part of the expected arguments.
+package mocks
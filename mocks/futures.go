@@ -0,0 +1,134 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/futures"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// FuturesClient is a mock of futures.API for the USDⓈ-M futures client.
+type FuturesClient struct {
+	mock.Mock
+}
+
+var _ futures.API = (*FuturesClient)(nil)
+
+// ServerTime provides a mock function
+func (m *FuturesClient) ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(int64)
+	return res, args.Error(1)
+}
+
+// Depth provides a mock function
+func (m *FuturesClient) Depth(ctx context.Context, symbol string, limit int, opts ...common.RequestOption) (*futures.DepthResponse, error) {
+	args := m.Called(ctx, symbol, limit)
+	res, _ := args.Get(0).(*futures.DepthResponse)
+	return res, args.Error(1)
+}
+
+// Klines provides a mock function
+func (m *FuturesClient) Klines(ctx context.Context, params futures.KlinesParams, opts ...common.RequestOption) ([]*futures.Kline, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*futures.Kline)
+	return res, args.Error(1)
+}
+
+// ListPrices provides a mock function
+func (m *FuturesClient) ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*futures.SymbolPrice, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*futures.SymbolPrice)
+	return res, args.Error(1)
+}
+
+// ListBookTickers provides a mock function
+func (m *FuturesClient) ListBookTickers(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*futures.BookTicker, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*futures.BookTicker)
+	return res, args.Error(1)
+}
+
+// ExchangeInfo provides a mock function
+func (m *FuturesClient) ExchangeInfo(ctx context.Context, opts ...common.RequestOption) (*futures.ExchangeInfo, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*futures.ExchangeInfo)
+	return res, args.Error(1)
+}
+
+// CreateOrder provides a mock function
+func (m *FuturesClient) CreateOrder(ctx context.Context, params futures.CreateOrderParams, opts ...common.RequestOption) (*futures.CreateOrderResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*futures.CreateOrderResponse)
+	return res, args.Error(1)
+}
+
+// GetOrder provides a mock function
+func (m *FuturesClient) GetOrder(ctx context.Context, query futures.OrderQuery, opts ...common.RequestOption) (*futures.Order, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*futures.Order)
+	return res, args.Error(1)
+}
+
+// CancelOrder provides a mock function
+func (m *FuturesClient) CancelOrder(ctx context.Context, query futures.OrderQuery, opts ...common.RequestOption) (*futures.CancelOrderResponse, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*futures.CancelOrderResponse)
+	return res, args.Error(1)
+}
+
+// CancelAllOpenOrders provides a mock function
+func (m *FuturesClient) CancelAllOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) error {
+	args := m.Called(ctx, symbol)
+	return args.Error(0)
+}
+
+// CountdownCancelAll provides a mock function
+func (m *FuturesClient) CountdownCancelAll(ctx context.Context, symbol string, countdownTime int64, opts ...common.RequestOption) error {
+	args := m.Called(ctx, symbol, countdownTime)
+	return args.Error(0)
+}
+
+// ListOpenOrders provides a mock function
+func (m *FuturesClient) ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*futures.Order, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*futures.Order)
+	return res, args.Error(1)
+}
+
+// ListOrders provides a mock function
+func (m *FuturesClient) ListOrders(ctx context.Context, params futures.ListOrdersParams, opts ...common.RequestOption) ([]*futures.Order, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*futures.Order)
+	return res, args.Error(1)
+}
+
+// GetAccount provides a mock function
+func (m *FuturesClient) GetAccount(ctx context.Context, opts ...common.RequestOption) (*futures.Account, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*futures.Account)
+	return res, args.Error(1)
+}
+
+// GetBalance provides a mock function
+func (m *FuturesClient) GetBalance(ctx context.Context, opts ...common.RequestOption) ([]*futures.Balance, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).([]*futures.Balance)
+	return res, args.Error(1)
+}
+
+// GetPositionRisk provides a mock function
+func (m *FuturesClient) GetPositionRisk(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*futures.PositionRisk, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*futures.PositionRisk)
+	return res, args.Error(1)
+}
+
+// ListAccountTrades provides a mock function
+func (m *FuturesClient) ListAccountTrades(ctx context.Context, params futures.ListAccountTradesParams, opts ...common.RequestOption) ([]*futures.AccountTrade, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*futures.AccountTrade)
+	return res, args.Error(1)
+}
@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// lastPrice is the kind of narrow consumer the interfaces are meant for.
+func lastPrice(ctx context.Context, api binance.MarketDataAPI, symbol string) (string, error) {
+	prices, err := api.ListPrices(ctx, symbol)
+	if err != nil {
+		return "", err
+	}
+	if len(prices) == 0 {
+		return "", errors.New("no price")
+	}
+	return prices[0].Price, nil
+}
+
+func TestClient(t *testing.T) {
+	m := new(Client)
+	m.On("ListPrices", mock.Anything, "BTCUSDT").
+		Return([]*binance.SymbolPrice{{Symbol: "BTCUSDT", Price: "20000"}}, nil).Once()
+	m.On("ListPrices", mock.Anything, "ETHUSDT").Return(nil, errors.New("boom")).Once()
+	defer m.AssertExpectations(t)
+
+	price, err := lastPrice(context.Background(), m, "BTCUSDT")
+	require.NoError(t, err)
+	assert.Equal(t, "20000", price)
+	_, err = lastPrice(context.Background(), m, "ETHUSDT")
+	assert.EqualError(t, err, "boom")
+}
+
+func TestFuturesClient(t *testing.T) {
+	m := new(FuturesClient)
+	params := futures.CreateOrderParams{
+		Symbol: "BTCUSDT", Side: futures.SideTypeBuy, Type: futures.OrderTypeMarket, Quantity: "1",
+	}
+	m.On("CreateOrder", mock.Anything, params).
+		Return(&futures.CreateOrderResponse{OrderID: 1, Status: futures.OrderStatusTypeFilled}, nil)
+	m.On("CancelAllOpenOrders", mock.Anything, "BTCUSDT").Return(nil)
+	defer m.AssertExpectations(t)
+
+	var api futures.TradingAPI = m
+	res, err := api.CreateOrder(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res.OrderID)
+	assert.NoError(t, api.CancelAllOpenOrders(context.Background(), "BTCUSDT"))
+}
+
+func TestDeliveryClient(t *testing.T) {
+	m := new(DeliveryClient)
+	m.On("ServerTime", mock.Anything).Return(int64(1499827319559), nil)
+	defer m.AssertExpectations(t)
+
+	var api delivery.MarketDataAPI = m
+	serverTime, err := api.ServerTime(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1499827319559), serverTime)
+}
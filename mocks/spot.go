@@ -0,0 +1,178 @@
+package mocks
+
+import (
+	"context"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Client is a mock of binance.API for the spot client.
+type Client struct {
+	mock.Mock
+}
+
+var _ binance.API = (*Client)(nil)
+
+// ServerTime provides a mock function
+func (m *Client) ServerTime(ctx context.Context, opts ...common.RequestOption) (int64, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(int64)
+	return res, args.Error(1)
+}
+
+// Depth provides a mock function
+func (m *Client) Depth(ctx context.Context, symbol string, limit int, opts ...common.RequestOption) (*binance.DepthResponse, error) {
+	args := m.Called(ctx, symbol, limit)
+	res, _ := args.Get(0).(*binance.DepthResponse)
+	return res, args.Error(1)
+}
+
+// Klines provides a mock function
+func (m *Client) Klines(ctx context.Context, params binance.KlinesParams, opts ...common.RequestOption) ([]*binance.Kline, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*binance.Kline)
+	return res, args.Error(1)
+}
+
+// ListPrices provides a mock function
+func (m *Client) ListPrices(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*binance.SymbolPrice, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*binance.SymbolPrice)
+	return res, args.Error(1)
+}
+
+// ListBookTickers provides a mock function
+func (m *Client) ListBookTickers(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*binance.BookTicker, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*binance.BookTicker)
+	return res, args.Error(1)
+}
+
+// ExchangeInfo provides a mock function
+func (m *Client) ExchangeInfo(ctx context.Context, symbols []string, opts ...common.RequestOption) (*binance.ExchangeInfo, error) {
+	args := m.Called(ctx, symbols)
+	res, _ := args.Get(0).(*binance.ExchangeInfo)
+	return res, args.Error(1)
+}
+
+// CreateOrder provides a mock function
+func (m *Client) CreateOrder(ctx context.Context, params binance.CreateOrderParams, opts ...common.RequestOption) (*binance.CreateOrderResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.CreateOrderResponse)
+	return res, args.Error(1)
+}
+
+// GetOrder provides a mock function
+func (m *Client) GetOrder(ctx context.Context, query binance.OrderQuery, opts ...common.RequestOption) (*binance.Order, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*binance.Order)
+	return res, args.Error(1)
+}
+
+// CancelOrder provides a mock function
+func (m *Client) CancelOrder(ctx context.Context, query binance.OrderQuery, opts ...common.RequestOption) (*binance.CancelOrderResponse, error) {
+	args := m.Called(ctx, query)
+	res, _ := args.Get(0).(*binance.CancelOrderResponse)
+	return res, args.Error(1)
+}
+
+// CancelOpenOrders provides a mock function
+func (m *Client) CancelOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) (*binance.CancelOpenOrdersResponse, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).(*binance.CancelOpenOrdersResponse)
+	return res, args.Error(1)
+}
+
+// ListOpenOrders provides a mock function
+func (m *Client) ListOpenOrders(ctx context.Context, symbol string, opts ...common.RequestOption) ([]*binance.Order, error) {
+	args := m.Called(ctx, symbol)
+	res, _ := args.Get(0).([]*binance.Order)
+	return res, args.Error(1)
+}
+
+// ListOrders provides a mock function
+func (m *Client) ListOrders(ctx context.Context, params binance.ListOrdersParams, opts ...common.RequestOption) ([]*binance.Order, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*binance.Order)
+	return res, args.Error(1)
+}
+
+// GetAccount provides a mock function
+func (m *Client) GetAccount(ctx context.Context, opts ...common.RequestOption) (*binance.Account, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*binance.Account)
+	return res, args.Error(1)
+}
+
+// ListTrades provides a mock function
+func (m *Client) ListTrades(ctx context.Context, params binance.ListTradesParams, opts ...common.RequestOption) ([]*binance.TradeV3, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).([]*binance.TradeV3)
+	return res, args.Error(1)
+}
+
+// GetMarginAccount provides a mock function
+func (m *Client) GetMarginAccount(ctx context.Context, opts ...common.RequestOption) (*binance.MarginAccount, error) {
+	args := m.Called(ctx)
+	res, _ := args.Get(0).(*binance.MarginAccount)
+	return res, args.Error(1)
+}
+
+// GetIsolatedMarginAccount provides a mock function
+func (m *Client) GetIsolatedMarginAccount(ctx context.Context, symbols []string, opts ...common.RequestOption) (*binance.IsolatedMarginAccount, error) {
+	args := m.Called(ctx, symbols)
+	res, _ := args.Get(0).(*binance.IsolatedMarginAccount)
+	return res, args.Error(1)
+}
+
+// CancelMarginOpenOrders provides a mock function
+func (m *Client) CancelMarginOpenOrders(ctx context.Context, symbol string, isIsolated bool, opts ...common.RequestOption) (*binance.CancelOpenOrdersResponse, error) {
+	args := m.Called(ctx, symbol, isIsolated)
+	res, _ := args.Get(0).(*binance.CancelOpenOrdersResponse)
+	return res, args.Error(1)
+}
+
+// MarginRepay provides a mock function
+func (m *Client) MarginRepay(ctx context.Context, params binance.MarginRepayParams, opts ...common.RequestOption) (*binance.TransactionResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.TransactionResponse)
+	return res, args.Error(1)
+}
+
+// MarginTransfer provides a mock function
+func (m *Client) MarginTransfer(ctx context.Context, params binance.MarginTransferParams, opts ...common.RequestOption) (*binance.TransactionResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.TransactionResponse)
+	return res, args.Error(1)
+}
+
+// IsolatedMarginTransfer provides a mock function
+func (m *Client) IsolatedMarginTransfer(ctx context.Context, params binance.IsolatedMarginTransferParams, opts ...common.RequestOption) (*binance.TransactionResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.TransactionResponse)
+	return res, args.Error(1)
+}
+
+// ListMarginLoans provides a mock function
+func (m *Client) ListMarginLoans(ctx context.Context, params binance.MarginHistoryParams, opts ...common.RequestOption) (*binance.MarginLoanResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.MarginLoanResponse)
+	return res, args.Error(1)
+}
+
+// ListMarginRepays provides a mock function
+func (m *Client) ListMarginRepays(ctx context.Context, params binance.MarginHistoryParams, opts ...common.RequestOption) (*binance.MarginRepayResponse, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.MarginRepayResponse)
+	return res, args.Error(1)
+}
+
+// ListMarginInterestHistory provides a mock function
+func (m *Client) ListMarginInterestHistory(ctx context.Context, params binance.MarginHistoryParams, opts ...common.RequestOption) (*binance.MarginInterestHistory, error) {
+	args := m.Called(ctx, params)
+	res, _ := args.Get(0).(*binance.MarginInterestHistory)
+	return res, args.Error(1)
+}
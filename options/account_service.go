@@ -0,0 +1,53 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetAccountService get the margin account of the options account
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...common.RequestOption) (res *Account, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/marginAccount")
+
+	res = new(Account)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Account define the options account, with the balances of its assets and
+// the greeks of its positions by underlying
+type Account struct {
+	Assets    []*AccountAsset `json:"asset"`
+	Greeks    []*AccountGreek `json:"greek"`
+	Time      int64           `json:"time"`
+	RiskLevel string          `json:"riskLevel"`
+}
+
+// AccountAsset define the balance of an asset of the options account
+type AccountAsset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	Equity        string `json:"equity"`
+	Available     string `json:"available"`
+	InitialMargin string `json:"initialMargin"`
+	MaintMargin   string `json:"maintMargin"`
+	UnrealizedPNL string `json:"unrealizedPNL"`
+	LpProfit      string `json:"lpProfit"`
+}
+
+// AccountGreek define the greeks of the positions of an underlying
+type AccountGreek struct {
+	Underlying string `json:"underlying"`
+	Delta      string `json:"delta"`
+	Gamma      string `json:"gamma"`
+	Theta      string `json:"theta"`
+	Vega       string `json:"vega"`
+}
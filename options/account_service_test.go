@@ -0,0 +1,75 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountService(t *testing.T) {
+	suite.Run(t, new(accountServiceTestSuite))
+}
+
+func (s *accountServiceTestSuite) TestGetAccount() {
+	data := []byte(`{
+		"asset": [
+			{
+				"asset": "USDT",
+				"marginBalance": "10099.448",
+				"equity": "10094.44662",
+				"available": "8725.92524",
+				"initialMargin": "1084.52138",
+				"maintMargin": "151.00138",
+				"unrealizedPNL": "-5.00138",
+				"lpProfit": "-5.00138"
+			}
+		],
+		"greek": [
+			{
+				"underlying": "BTCUSDT",
+				"delta": "-0.05",
+				"gamma": "-0.002",
+				"theta": "-0.05",
+				"vega": "-0.002"
+			}
+		],
+		"time": 1592449455993,
+		"riskLevel": "NORMAL"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&Account{
+		Assets: []*AccountAsset{{
+			Asset:         "USDT",
+			MarginBalance: "10099.448",
+			Equity:        "10094.44662",
+			Available:     "8725.92524",
+			InitialMargin: "1084.52138",
+			MaintMargin:   "151.00138",
+			UnrealizedPNL: "-5.00138",
+			LpProfit:      "-5.00138",
+		}},
+		Greeks: []*AccountGreek{{
+			Underlying: "BTCUSDT",
+			Delta:      "-0.05",
+			Gamma:      "-0.002",
+			Theta:      "-0.05",
+			Vega:       "-0.002",
+		}},
+		Time:      1592449455993,
+		RiskLevel: "NORMAL",
+	}, res)
+}
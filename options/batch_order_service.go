@@ -0,0 +1,129 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MaxBatchOrders is the maximum number of orders of a batch request
+const MaxBatchOrders = 10
+
+// CreateBatchOrdersService create up to 10 orders in one request
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// OrderList set the orders to create, build them with NewCreateOrderService
+func (s *CreateBatchOrdersService) OrderList(orders ...*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request, the request fails only when the whole batch is rejected,
+// the errors of single orders are reported in the response
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res *BatchOrdersResponse, err error) {
+	if len(s.orders) == 0 || len(s.orders) > MaxBatchOrders {
+		return nil, fmt.Errorf("batch of %d orders, it must have 1 to %d orders", len(s.orders), MaxBatchOrders)
+	}
+	list := make([]map[string]string, 0, len(s.orders))
+	for _, o := range s.orders {
+		order := make(map[string]string)
+		for k, v := range o.params() {
+			order[k] = fmt.Sprintf("%v", v)
+		}
+		list = append(list, order)
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	r := common.NewPostRequestSigned("/eapi/v1/batchOrders")
+	r.SetForm("orders", string(data))
+	return s.c.doBatchOrders(ctx, r, opts...)
+}
+
+// CancelBatchOrdersService cancel up to 10 orders of a symbol in one request
+type CancelBatchOrdersService struct {
+	c              *Client
+	symbol         string
+	orderIDs       []int64
+	clientOrderIDs []string
+}
+
+// Symbol set symbol
+func (s *CancelBatchOrdersService) Symbol(symbol string) *CancelBatchOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDs set the ids of the orders to cancel
+func (s *CancelBatchOrdersService) OrderIDs(orderIDs ...int64) *CancelBatchOrdersService {
+	s.orderIDs = orderIDs
+	return s
+}
+
+// ClientOrderIDs set the client ids of the orders to cancel
+func (s *CancelBatchOrdersService) ClientOrderIDs(clientOrderIDs ...string) *CancelBatchOrdersService {
+	s.clientOrderIDs = clientOrderIDs
+	return s
+}
+
+// Do send request, it is laid out like CreateBatchOrdersService
+func (s *CancelBatchOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res *BatchOrdersResponse, err error) {
+	r := common.NewDeleteRequestSigned("/eapi/v1/batchOrders")
+	r.SetForm("symbol", s.symbol)
+	if len(s.orderIDs) > 0 {
+		// convert a slice of integers to a string e.g. [1 2 3] => "[1,2,3]"
+		r.SetForm("orderIds", strings.Join(strings.Fields(fmt.Sprint(s.orderIDs)), ","))
+	}
+	if len(s.clientOrderIDs) > 0 {
+		data, err := json.Marshal(s.clientOrderIDs)
+		if err != nil {
+			return nil, err
+		}
+		r.SetForm("clientOrderIds", string(data))
+	}
+	return s.c.doBatchOrders(ctx, r, opts...)
+}
+
+// BatchOrdersResponse define batch orders response. Orders and Errors have one
+// item per requested order in the same order, Orders[i] is nil when the order
+// was rejected with Errors[i], and Errors[i] is nil when it succeeded.
+type BatchOrdersResponse struct {
+	Orders []*Order
+	Errors []*common.APIError
+}
+
+func (c *Client) doBatchOrders(ctx context.Context, r *common.Request, opts ...common.RequestOption) (res *BatchOrdersResponse, err error) {
+	items := make([]json.RawMessage, 0)
+	if err = c.CallAPI(ctx, r, &items, opts...); err != nil {
+		return nil, err
+	}
+	res = &BatchOrdersResponse{
+		Orders: make([]*Order, len(items)),
+		Errors: make([]*common.APIError, len(items)),
+	}
+	for i, item := range items {
+		var itemErr struct {
+			Code    int64  `json:"code"`
+			Message string `json:"msg"`
+		}
+		if err = json.Unmarshal(item, &itemErr); err != nil {
+			return nil, err
+		}
+		if itemErr.Code != 0 {
+			res.Errors[i] = &common.APIError{Code: itemErr.Code, Message: itemErr.Message}
+			continue
+		}
+		res.Orders[i] = new(Order)
+		if err = json.Unmarshal(item, res.Orders[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type batchOrderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBatchOrderService(t *testing.T) {
+	suite.Run(t, new(batchOrderServiceTestSuite))
+}
+
+func (s *batchOrderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4612288550799409153,
+			"symbol": "ETH-220826-1800-C",
+			"price": "100",
+			"quantity": "0.01",
+			"side": "BUY",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"postOnly": false,
+			"clientOrderId": "1001",
+			"mmp": false
+		},
+		{
+			"code": -4001,
+			"msg": "Price less than 0."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"orders": `[{"clientOrderId":"1001","price":"100","quantity":"0.01","side":"BUY","symbol":"ETH-220826-1800-C","type":"LIMIT"},` +
+				`{"price":"-1","quantity":"0.01","side":"SELL","symbol":"ETH-220826-1800-C","type":"LIMIT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(
+		s.client.NewCreateOrderService().Symbol("ETH-220826-1800-C").Side(SideTypeBuy).
+			Type(OrderTypeLimit).Quantity("0.01").Price("100").ClientOrderID("1001"),
+		s.client.NewCreateOrderService().Symbol("ETH-220826-1800-C").Side(SideTypeSell).
+			Type(OrderTypeLimit).Quantity("0.01").Price("-1"),
+	).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Orders, 2)
+	s.r().Len(res.Errors, 2)
+	s.assertOrderEqual(&Order{
+		OrderID:       4612288550799409153,
+		Symbol:        "ETH-220826-1800-C",
+		Price:         "100",
+		Quantity:      "0.01",
+		Side:          SideTypeBuy,
+		Type:          OrderTypeLimit,
+		ClientOrderID: "1001",
+	}, res.Orders[0])
+	s.r().Nil(res.Errors[0])
+	s.r().Nil(res.Orders[1])
+	s.r().Equal(&common.APIError{Code: -4001, Message: "Price less than 0."}, res.Errors[1])
+}
+
+func (s *batchOrderServiceTestSuite) TestCreateBatchOrdersTooMany() {
+	orders := make([]*CreateOrderService, MaxBatchOrders+1)
+	for i := range orders {
+		orders[i] = s.client.NewCreateOrderService()
+	}
+	_, err := s.client.NewCreateBatchOrdersService().OrderList(orders...).Do(newContext())
+	s.r().Error(err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *batchOrderServiceTestSuite) TestCancelBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4611875134427365377,
+			"symbol": "BTC-200730-9000-C",
+			"price": "100",
+			"quantity": "1",
+			"executedQty": "0",
+			"side": "BUY",
+			"type": "LIMIT",
+			"status": "CANCELLED",
+			"clientOrderId": "myOrder1"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":         "BTC-200730-9000-C",
+			"orderIds":       "[4611875134427365377,4611875134427365378]",
+			"clientOrderIds": `["myOrder1"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelBatchOrdersService().Symbol("BTC-200730-9000-C").
+		OrderIDs(4611875134427365377, 4611875134427365378).ClientOrderIDs("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Orders, 1)
+	s.r().Nil(res.Errors[0])
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "0",
+		Side:             SideTypeBuy,
+		Type:             OrderTypeLimit,
+		Status:           OrderStatusTypeCancelled,
+		ClientOrderID:    "myOrder1",
+	}, res.Orders[0])
+}
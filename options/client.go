@@ -0,0 +1,239 @@
+package options
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/bitly/go-simplejson"
+)
+
+// SideType define side type of order
+type SideType string
+
+// OptionSideType define the side of an option contract
+type OptionSideType string
+
+// PositionSideType define the side of a position
+type PositionSideType string
+
+// OrderType define order type
+type OrderType string
+
+// TimeInForceType define time in force type of order
+type TimeInForceType string
+
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// SymbolFilterType define symbol filter type
+type SymbolFilterType string
+
+// UserDataEventType define user data event type
+type UserDataEventType string
+
+// KlineInterval define valid kline interval
+type KlineInterval string
+
+// Endpoints
+const (
+	baseApiMainUrl = "https://eapi.binance.com"
+)
+
+// Global enums
+const (
+	SideTypeBuy  SideType = "BUY"
+	SideTypeSell SideType = "SELL"
+
+	OptionSideTypeCall OptionSideType = "CALL"
+	OptionSideTypePut  OptionSideType = "PUT"
+
+	PositionSideTypeLong  PositionSideType = "LONG"
+	PositionSideTypeShort PositionSideType = "SHORT"
+
+	OrderTypeLimit OrderType = "LIMIT"
+
+	TimeInForceTypeGTC TimeInForceType = "GTC" // Good Till Cancel
+	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
+	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
+
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+
+	OrderStatusTypeAccepted        OrderStatusType = "ACCEPTED"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCancelled       OrderStatusType = "CANCELLED"
+
+	SymbolFilterTypePrice   SymbolFilterType = "PRICE_FILTER"
+	SymbolFilterTypeLotSize SymbolFilterType = "LOT_SIZE"
+
+	UserDataEventTypeListenKeyExpired UserDataEventType = "listenKeyExpired"
+	UserDataEventTypeAccountUpdate    UserDataEventType = "ACCOUNT_UPDATE"
+	UserDataEventTypeOrderTradeUpdate UserDataEventType = "ORDER_TRADE_UPDATE"
+
+	KlineInterval1Minute  KlineInterval = "1m"
+	KlineInterval3Minute  KlineInterval = "3m"
+	KlineInterval5Minute  KlineInterval = "5m"
+	KlineInterval15Minute KlineInterval = "15m"
+	KlineInterval30Minute KlineInterval = "30m"
+	KlineInterval1Hour    KlineInterval = "1h"
+	KlineInterval2Hour    KlineInterval = "2h"
+	KlineInterval4Hour    KlineInterval = "4h"
+	KlineInterval6Hour    KlineInterval = "6h"
+	KlineInterval12Hour   KlineInterval = "12h"
+	KlineInterval1Day     KlineInterval = "1d"
+	KlineInterval3Day     KlineInterval = "3d"
+	KlineInterval1Week    KlineInterval = "1w"
+
+	timestampKey  = "timestamp"
+	signatureKey  = "signature"
+	recvWindowKey = "recvWindow"
+)
+
+func currentTimestamp() int64 {
+	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func newJSON(data []byte) (j *simplejson.Json, err error) {
+	j, err = simplejson.NewJson(data)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+// The options API has no testnet.
+func NewClient(apiKey, secretKey string) *Client {
+	logger := common.NewDefaultLogger(common.LogInfo, log.New(os.Stderr,
+		"Binance-golang-options ", log.LstdFlags))
+	return &Client{
+		Client: common.NewClient(apiKey, secretKey, baseApiMainUrl,
+			"Binance/golang-options", http.DefaultClient, logger),
+	}
+}
+
+// Client define API client
+type Client struct {
+	common.Client
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewServerTimeService init server time service
+func (c *Client) NewServerTimeService() *ServerTimeService {
+	return &ServerTimeService{c: c}
+}
+
+// NewSetServerTimeService init set server time service
+func (c *Client) NewSetServerTimeService() *SetServerTimeService {
+	return &SetServerTimeService{c: c}
+}
+
+// NewExchangeInfoService init exchange info service
+func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
+	return &ExchangeInfoService{c: c}
+}
+
+// NewMarkPriceService init mark price service
+func (c *Client) NewMarkPriceService() *MarkPriceService {
+	return &MarkPriceService{c: c}
+}
+
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
+// NewKlinesService init klines service
+func (c *Client) NewKlinesService() *KlinesService {
+	return &KlinesService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewListUserTradesService init list user trades service
+func (c *Client) NewListUserTradesService() *ListUserTradesService {
+	return &ListUserTradesService{c: c}
+}
+
+// NewCreateOrderService init creating order service
+func (c *Client) NewCreateOrderService() *CreateOrderService {
+	return &CreateOrderService{c: c}
+}
+
+// NewGetOrderService init get order service
+func (c *Client) NewGetOrderService() *GetOrderService {
+	return &GetOrderService{c: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *Client) NewCancelOrderService() *CancelOrderService {
+	return &CancelOrderService{c: c}
+}
+
+// NewCancelAllOpenOrdersService init cancel all open orders service
+func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
+	return &CancelAllOpenOrdersService{c: c}
+}
+
+// NewListOpenOrdersService init list open orders service
+func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
+	return &ListOpenOrdersService{c: c}
+}
+
+// NewListOrdersService init listing orders service
+func (c *Client) NewListOrdersService() *ListOrdersService {
+	return &ListOrdersService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch orders service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewCancelBatchOrdersService init cancel batch orders service
+func (c *Client) NewCancelBatchOrdersService() *CancelBatchOrdersService {
+	return &CancelBatchOrdersService{c: c}
+}
+
+// NewGetPositionService init getting position service
+func (c *Client) NewGetPositionService() *GetPositionService {
+	return &GetPositionService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
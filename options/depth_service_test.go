@@ -0,0 +1,47 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type depthServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDepthService(t *testing.T) {
+	suite.Run(t, new(depthServiceTestSuite))
+}
+
+func (s *depthServiceTestSuite) TestDepth() {
+	data := []byte(`{
+		"T": 1589436922972,
+		"u": 37461,
+		"bids": [["1000.000", "0.9000"]],
+		"asks": [["1100.000", "0.1000"], ["1200.000", "0.2000"]]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(common.Params{
+			"symbol": "BTC-200730-9000-C",
+			"limit":  10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewDepthService().Symbol("BTC-200730-9000-C").Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&DepthResponse{
+		TransactionTime: 1589436922972,
+		UpdateID:        37461,
+		Bids:            []Bid{{Price: "1000.000", Quantity: "0.9000"}},
+		Asks: []Ask{
+			{Price: "1100.000", Quantity: "0.1000"},
+			{Price: "1200.000", Quantity: "0.2000"},
+		},
+	}, res)
+}
@@ -0,0 +1,134 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ExchangeInfoService exchange info service
+type ExchangeInfoService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ExchangeInfoService) Do(ctx context.Context, opts ...common.RequestOption) (res *ExchangeInfo, err error) {
+	r := common.NewGetRequestPublic("/eapi/v1/exchangeInfo")
+
+	res = new(ExchangeInfo)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExchangeInfo exchange info
+type ExchangeInfo struct {
+	Timezone        string           `json:"timezone"`
+	ServerTime      int64            `json:"serverTime"`
+	OptionContracts []OptionContract `json:"optionContracts"`
+	OptionAssets    []OptionAsset    `json:"optionAssets"`
+	OptionSymbols   []Symbol         `json:"optionSymbols"`
+	RateLimits      []RateLimit      `json:"rateLimits"`
+}
+
+// OptionContract define the underlying of a group of option symbols
+type OptionContract struct {
+	ID          int64  `json:"id"`
+	BaseAsset   string `json:"baseAsset"`
+	QuoteAsset  string `json:"quoteAsset"`
+	Underlying  string `json:"underlying"`
+	SettleAsset string `json:"settleAsset"`
+}
+
+// OptionAsset define an asset of the options account
+type OptionAsset struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// RateLimit struct
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// Symbol option symbol
+type Symbol struct {
+	ID                   int64                    `json:"id"`
+	ContractID           int64                    `json:"contractId"`
+	Symbol               string                   `json:"symbol"`
+	Underlying           string                   `json:"underlying"`
+	Side                 OptionSideType           `json:"side"`
+	StrikePrice          string                   `json:"strikePrice"`
+	ExpiryDate           int64                    `json:"expiryDate"`
+	Unit                 int64                    `json:"unit"`
+	MakerFeeRate         string                   `json:"makerFeeRate"`
+	TakerFeeRate         string                   `json:"takerFeeRate"`
+	MinQty               string                   `json:"minQty"`
+	MaxQty               string                   `json:"maxQty"`
+	InitialMargin        string                   `json:"initialMargin"`
+	MaintenanceMargin    string                   `json:"maintenanceMargin"`
+	MinInitialMargin     string                   `json:"minInitialMargin"`
+	MinMaintenanceMargin string                   `json:"minMaintenanceMargin"`
+	PriceScale           int                      `json:"priceScale"`
+	QuantityScale        int                      `json:"quantityScale"`
+	QuoteAsset           string                   `json:"quoteAsset"`
+	Filters              []map[string]interface{} `json:"filters"`
+}
+
+// LotSizeFilter define lot size filter of symbol
+type LotSizeFilter struct {
+	MaxQuantity string `json:"maxQty"`
+	MinQuantity string `json:"minQty"`
+	StepSize    string `json:"stepSize"`
+}
+
+// PriceFilter define price filter of symbol
+type PriceFilter struct {
+	MaxPrice string `json:"maxPrice"`
+	MinPrice string `json:"minPrice"`
+	TickSize string `json:"tickSize"`
+}
+
+// LotSizeFilter return lot size filter of symbol
+func (s *Symbol) LotSizeFilter() *LotSizeFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypeLotSize) {
+			f := &LotSizeFilter{}
+			if i, ok := filter["maxQty"]; ok {
+				f.MaxQuantity = i.(string)
+			}
+			if i, ok := filter["minQty"]; ok {
+				f.MinQuantity = i.(string)
+			}
+			if i, ok := filter["stepSize"]; ok {
+				f.StepSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
+
+// PriceFilter return price filter of symbol
+func (s *Symbol) PriceFilter() *PriceFilter {
+	for _, filter := range s.Filters {
+		if filter["filterType"].(string) == string(SymbolFilterTypePrice) {
+			f := &PriceFilter{}
+			if i, ok := filter["maxPrice"]; ok {
+				f.MaxPrice = i.(string)
+			}
+			if i, ok := filter["minPrice"]; ok {
+				f.MinPrice = i.(string)
+			}
+			if i, ok := filter["tickSize"]; ok {
+				f.TickSize = i.(string)
+			}
+			return f
+		}
+	}
+	return nil
+}
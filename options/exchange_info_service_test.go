@@ -0,0 +1,84 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeInfoServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeInfoService(t *testing.T) {
+	suite.Run(t, new(exchangeInfoServiceTestSuite))
+}
+
+func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1592387337630,
+		"optionContracts": [
+			{"id": 1, "baseAsset": "BTC", "quoteAsset": "USDT", "underlying": "BTCUSDT", "settleAsset": "USDT"}
+		],
+		"optionAssets": [
+			{"id": 1, "name": "USDT"}
+		],
+		"optionSymbols": [
+			{
+				"contractId": 2,
+				"expiryDate": 1660521600000,
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "0.02", "maxPrice": "80000.01", "tickSize": "0.01"},
+					{"filterType": "LOT_SIZE", "minQty": "0.01", "maxQty": "100", "stepSize": "0.01"}
+				],
+				"id": 17,
+				"symbol": "BTC-220815-50000-C",
+				"side": "CALL",
+				"strikePrice": "50000",
+				"underlying": "BTCUSDT",
+				"unit": 1,
+				"makerFeeRate": "0.0002",
+				"takerFeeRate": "0.0002",
+				"minQty": "0.01",
+				"maxQty": "100",
+				"initialMargin": "0.15",
+				"maintenanceMargin": "0.075",
+				"minInitialMargin": "0.1",
+				"minMaintenanceMargin": "0.05",
+				"priceScale": 2,
+				"quantityScale": 2,
+				"quoteAsset": "USDT"
+			}
+		],
+		"rateLimits": [
+			{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("UTC", res.Timezone)
+	s.r().Equal([]OptionContract{{
+		ID: 1, BaseAsset: "BTC", QuoteAsset: "USDT", Underlying: "BTCUSDT", SettleAsset: "USDT",
+	}}, res.OptionContracts)
+	s.r().Equal([]OptionAsset{{ID: 1, Name: "USDT"}}, res.OptionAssets)
+	s.r().Equal([]RateLimit{{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 2400}},
+		res.RateLimits)
+	s.r().Len(res.OptionSymbols, 1)
+	symbol := res.OptionSymbols[0]
+	s.r().Equal("BTC-220815-50000-C", symbol.Symbol)
+	s.r().Equal(OptionSideTypeCall, symbol.Side)
+	s.r().Equal(int64(1660521600000), symbol.ExpiryDate)
+	s.r().Equal("0.075", symbol.MaintenanceMargin)
+	s.r().Equal(&PriceFilter{MinPrice: "0.02", MaxPrice: "80000.01", TickSize: "0.01"}, symbol.PriceFilter())
+	s.r().Equal(&LotSizeFilter{MinQuantity: "0.01", MaxQuantity: "100", StepSize: "0.01"}, symbol.LotSizeFilter())
+}
@@ -0,0 +1,85 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// KlinesService list klines
+type KlinesService struct {
+	c         *Client
+	symbol    string
+	interval  KlineInterval
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *KlinesService) Symbol(symbol string) *KlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesService) Interval(interval KlineInterval) *KlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit, default:500 max:1500
+func (s *KlinesService) Limit(limit int) *KlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesService) StartTime(startTime int64) *KlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesService) EndTime(endTime int64) *KlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *KlinesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Kline, err error) {
+	r := common.NewGetRequestPublic("/eapi/v1/klines")
+	r.SetQuery("symbol", s.symbol)
+	r.SetQuery("interval", s.interval)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	res = make([]*Kline, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Kline define kline info, unlike the futures klines it is a JSON object
+type Kline struct {
+	OpenTime    int64         `json:"openTime"`
+	CloseTime   int64         `json:"closeTime"`
+	Interval    KlineInterval `json:"interval"`
+	Open        string        `json:"open"`
+	High        string        `json:"high"`
+	Low         string        `json:"low"`
+	Close       string        `json:"close"`
+	Volume      string        `json:"volume"`
+	Amount      string        `json:"amount"`
+	TradeCount  int64         `json:"tradeCount"`
+	TakerVolume string        `json:"takerVolume"`
+	TakerAmount string        `json:"takerAmount"`
+}
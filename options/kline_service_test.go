@@ -0,0 +1,66 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type klineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestKlineService(t *testing.T) {
+	suite.Run(t, new(klineServiceTestSuite))
+}
+
+func (s *klineServiceTestSuite) TestKlines() {
+	data := []byte(`[
+		{
+			"open": "950",
+			"high": "1100",
+			"low": "950",
+			"close": "1100",
+			"volume": "13",
+			"amount": "13300",
+			"interval": "5m",
+			"tradeCount": 3,
+			"takerVolume": "11",
+			"takerAmount": "11200",
+			"openTime": 1499040000000,
+			"closeTime": 1499644799999
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(common.Params{
+			"symbol":    "BTC-200730-9000-C",
+			"interval":  KlineInterval5Minute,
+			"limit":     10,
+			"startTime": 1499040000000,
+			"endTime":   1499644799999,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewKlinesService().Symbol("BTC-200730-9000-C").Interval(KlineInterval5Minute).
+		Limit(10).StartTime(1499040000000).EndTime(1499644799999).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Kline{{
+		OpenTime:    1499040000000,
+		CloseTime:   1499644799999,
+		Interval:    KlineInterval5Minute,
+		Open:        "950",
+		High:        "1100",
+		Low:         "950",
+		Close:       "1100",
+		Volume:      "13",
+		Amount:      "13300",
+		TradeCount:  3,
+		TakerVolume: "11",
+		TakerAmount: "11200",
+	}}, res)
+}
@@ -0,0 +1,49 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// MarkPriceService get the mark price, implied volatility and greeks of option symbols
+type MarkPriceService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol, the mark prices of all symbols are returned when unset
+func (s *MarkPriceService) Symbol(symbol string) *MarkPriceService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *MarkPriceService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarkPrice, err error) {
+	r := common.NewGetRequestPublic("/eapi/v1/mark")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*MarkPrice, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarkPrice define the mark price and greeks of an option symbol
+type MarkPrice struct {
+	Symbol           string `json:"symbol"`
+	MarkPrice        string `json:"markPrice"`
+	BidIV            string `json:"bidIV"`
+	AskIV            string `json:"askIV"`
+	MarkIV           string `json:"markIV"`
+	Delta            string `json:"delta"`
+	Theta            string `json:"theta"`
+	Gamma            string `json:"gamma"`
+	Vega             string `json:"vega"`
+	HighPriceLimit   string `json:"highPriceLimit"`
+	LowPriceLimit    string `json:"lowPriceLimit"`
+	RiskFreeInterest string `json:"riskFreeInterest"`
+}
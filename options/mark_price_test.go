@@ -0,0 +1,59 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type markPriceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarkPriceService(t *testing.T) {
+	suite.Run(t, new(markPriceServiceTestSuite))
+}
+
+func (s *markPriceServiceTestSuite) TestMarkPrice() {
+	data := []byte(`[
+		{
+			"symbol": "BTC-200730-9000-C",
+			"markPrice": "1343.2883",
+			"bidIV": "1.40000077",
+			"askIV": "1.50000153",
+			"markIV": "1.45000000",
+			"delta": "0.55937056",
+			"theta": "3739.82509871",
+			"gamma": "0.00010969",
+			"vega": "978.58874732",
+			"highPriceLimit": "1618.241",
+			"lowPriceLimit": "1068.3356",
+			"riskFreeInterest": "0.1"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQuery("symbol", "BTC-200730-9000-C")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewMarkPriceService().Symbol("BTC-200730-9000-C").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*MarkPrice{{
+		Symbol:           "BTC-200730-9000-C",
+		MarkPrice:        "1343.2883",
+		BidIV:            "1.40000077",
+		AskIV:            "1.50000153",
+		MarkIV:           "1.45000000",
+		Delta:            "0.55937056",
+		Theta:            "3739.82509871",
+		Gamma:            "0.00010969",
+		Vega:             "978.58874732",
+		HighPriceLimit:   "1618.241",
+		LowPriceLimit:    "1068.3356",
+		RiskFreeInterest: "0.1",
+	}}, res)
+}
@@ -0,0 +1,404 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// CreateOrderService create order
+type CreateOrderService struct {
+	c                *Client
+	symbol           string
+	side             SideType
+	orderType        OrderType
+	quantity         string
+	price            *string
+	timeInForce      *TimeInForceType
+	reduceOnly       *bool
+	postOnly         *bool
+	newOrderRespType *NewOrderRespType
+	clientOrderID    *string
+	isMMP            *bool
+}
+
+// Symbol set symbol
+func (s *CreateOrderService) Symbol(symbol string) *CreateOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderService) Side(side SideType) *CreateOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateOrderService) Type(orderType OrderType) *CreateOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *CreateOrderService) Price(price string) *CreateOrderService {
+	s.price = &price
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateOrderService) TimeInForce(timeInForce TimeInForceType) *CreateOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// PostOnly set postOnly
+func (s *CreateOrderService) PostOnly(postOnly bool) *CreateOrderService {
+	s.postOnly = &postOnly
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CreateOrderService) ClientOrderID(clientOrderID string) *CreateOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// IsMMP set whether the order is a market maker protection order
+func (s *CreateOrderService) IsMMP(isMMP bool) *CreateOrderService {
+	s.isMMP = &isMMP
+	return s
+}
+
+func (s *CreateOrderService) params() common.Params {
+	m := common.Params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.postOnly != nil {
+		m["postOnly"] = *s.postOnly
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.clientOrderID != nil {
+		m["clientOrderId"] = *s.clientOrderID
+	}
+	if s.isMMP != nil {
+		m["isMmp"] = *s.isMMP
+	}
+	return m
+}
+
+// Do send request
+func (s *CreateOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *Order, err error) {
+	r := common.NewPostRequestSigned("/eapi/v1/order")
+	r.SetFormParams(s.params())
+
+	res = new(Order)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Order define order info, the ACK response of CreateOrderService only fills
+// the fields up to Mmp
+type Order struct {
+	OrderID          int64           `json:"orderId"`
+	Symbol           string          `json:"symbol"`
+	Price            string          `json:"price"`
+	Quantity         string          `json:"quantity"`
+	Side             SideType        `json:"side"`
+	Type             OrderType       `json:"type"`
+	CreateDate       int64           `json:"createDate"`
+	ReduceOnly       bool            `json:"reduceOnly"`
+	PostOnly         bool            `json:"postOnly"`
+	Mmp              bool            `json:"mmp"`
+	ExecutedQuantity string          `json:"executedQty"`
+	Fee              string          `json:"fee"`
+	TimeInForce      TimeInForceType `json:"timeInForce"`
+	CreateTime       int64           `json:"createTime"`
+	UpdateTime       int64           `json:"updateTime"`
+	Status           OrderStatusType `json:"status"`
+	AvgPrice         string          `json:"avgPrice"`
+	Source           string          `json:"source"`
+	ClientOrderID    string          `json:"clientOrderId"`
+	PriceScale       int             `json:"priceScale"`
+	QuantityScale    int             `json:"quantityScale"`
+	OptionSide       OptionSideType  `json:"optionSide"`
+	QuoteAsset       string          `json:"quoteAsset"`
+}
+
+// GetOrderService get an order
+type GetOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetOrderService) Symbol(symbol string) *GetOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderService) OrderID(orderID int64) *GetOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *GetOrderService) ClientOrderID(clientOrderID string) *GetOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *Order, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/order")
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetQuery("clientOrderId", *s.clientOrderID)
+	}
+
+	res = new(Order)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelOrderService cancel an order
+type CancelOrderService struct {
+	c             *Client
+	symbol        string
+	orderID       *int64
+	clientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelOrderService) Symbol(symbol string) *CancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelOrderService) OrderID(orderID int64) *CancelOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// ClientOrderID set clientOrderID
+func (s *CancelOrderService) ClientOrderID(clientOrderID string) *CancelOrderService {
+	s.clientOrderID = &clientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *Order, err error) {
+	r := common.NewDeleteRequestSigned("/eapi/v1/order")
+	r.SetForm("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetForm("orderId", *s.orderID)
+	}
+	if s.clientOrderID != nil {
+		r.SetForm("clientOrderId", *s.clientOrderID)
+	}
+
+	res = new(Order)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllOpenOrdersService cancel all open orders of a symbol, or of all
+// the symbols of an underlying
+type CancelAllOpenOrdersService struct {
+	c          *Client
+	symbol     *string
+	underlying *string
+}
+
+// Symbol set symbol
+func (s *CancelAllOpenOrdersService) Symbol(symbol string) *CancelAllOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Underlying set underlying, e.g. BTCUSDT, the orders of all its symbols are
+// canceled and Symbol is ignored
+func (s *CancelAllOpenOrdersService) Underlying(underlying string) *CancelAllOpenOrdersService {
+	s.underlying = &underlying
+	return s
+}
+
+// Do send request
+func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	var r *common.Request
+	if s.underlying != nil {
+		r = common.NewDeleteRequestSigned("/eapi/v1/allOpenOrdersByUnderlying")
+		r.SetForm("underlying", *s.underlying)
+	} else {
+		r = common.NewDeleteRequestSigned("/eapi/v1/allOpenOrders")
+		if s.symbol != nil {
+			r.SetForm("symbol", *s.symbol)
+		}
+	}
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
+
+// ListOpenOrdersService list opened orders
+type ListOpenOrdersService struct {
+	c         *Client
+	symbol    *string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *ListOpenOrdersService) Symbol(symbol string) *ListOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// OrderID set orderID, only this order is returned when set
+func (s *ListOpenOrdersService) OrderID(orderID int64) *ListOpenOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOpenOrdersService) StartTime(startTime int64) *ListOpenOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOpenOrdersService) EndTime(endTime int64) *ListOpenOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Order, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/openOrders")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+
+	res = make([]*Order, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListOrdersService list the finished orders of a symbol, canceled or filled
+type ListOrdersService struct {
+	c         *Client
+	symbol    string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListOrdersService) Symbol(symbol string) *ListOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set the order id to list from
+func (s *ListOrdersService) OrderID(orderID int64) *ListOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrdersService) StartTime(startTime int64) *ListOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrdersService) EndTime(endTime int64) *ListOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default:100 max:1000
+func (s *ListOrdersService) Limit(limit int) *ListOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Order, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/historyOrders")
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*Order, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
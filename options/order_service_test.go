@@ -0,0 +1,310 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderService(t *testing.T) {
+	suite.Run(t, new(orderServiceTestSuite))
+}
+
+func (s *orderServiceTestSuite) TestCreateOrder() {
+	data := []byte(`{
+		"orderId": 4611875134427365377,
+		"symbol": "BTC-200730-9000-C",
+		"price": "100",
+		"quantity": "1",
+		"executedQty": "0",
+		"fee": "0",
+		"side": "BUY",
+		"type": "LIMIT",
+		"timeInForce": "GTC",
+		"reduceOnly": false,
+		"postOnly": false,
+		"createTime": 1592465880683,
+		"updateTime": 1566818724722,
+		"status": "ACCEPTED",
+		"avgPrice": "0",
+		"clientOrderId": "myOrder1",
+		"priceScale": 2,
+		"quantityScale": 2,
+		"optionSide": "CALL",
+		"quoteAsset": "USDT",
+		"mmp": false
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":           "BTC-200730-9000-C",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"quantity":         "1",
+			"price":            "100",
+			"timeInForce":      TimeInForceTypeGTC,
+			"newOrderRespType": NewOrderRespTypeRESULT,
+			"clientOrderId":    "myOrder1",
+			"isMmp":            false,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTC-200730-9000-C").Side(SideTypeBuy).
+		Type(OrderTypeLimit).Quantity("1").Price("100").TimeInForce(TimeInForceTypeGTC).
+		NewOrderRespType(NewOrderRespTypeRESULT).ClientOrderID("myOrder1").IsMMP(false).
+		Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "0",
+		Fee:              "0",
+		Side:             SideTypeBuy,
+		Type:             OrderTypeLimit,
+		TimeInForce:      TimeInForceTypeGTC,
+		CreateTime:       1592465880683,
+		UpdateTime:       1566818724722,
+		Status:           OrderStatusTypeAccepted,
+		AvgPrice:         "0",
+		ClientOrderID:    "myOrder1",
+		PriceScale:       2,
+		QuantityScale:    2,
+		OptionSide:       OptionSideTypeCall,
+		QuoteAsset:       "USDT",
+	}, res)
+}
+
+func (s *orderServiceTestSuite) TestGetOrder() {
+	data := []byte(`{
+		"orderId": 4611875134427365377,
+		"symbol": "BTC-200730-9000-C",
+		"price": "100",
+		"quantity": "1",
+		"executedQty": "1",
+		"fee": "0.02",
+		"side": "SELL",
+		"type": "LIMIT",
+		"timeInForce": "GTC",
+		"createTime": 1592465880683,
+		"updateTime": 1566818724722,
+		"status": "FILLED",
+		"avgPrice": "100",
+		"clientOrderId": "myOrder1",
+		"optionSide": "PUT",
+		"quoteAsset": "USDT"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":        "BTC-200730-9000-C",
+			"orderId":       4611875134427365377,
+			"clientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderService().Symbol("BTC-200730-9000-C").OrderID(4611875134427365377).
+		ClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "1",
+		Fee:              "0.02",
+		Side:             SideTypeSell,
+		Type:             OrderTypeLimit,
+		TimeInForce:      TimeInForceTypeGTC,
+		CreateTime:       1592465880683,
+		UpdateTime:       1566818724722,
+		Status:           OrderStatusTypeFilled,
+		AvgPrice:         "100",
+		ClientOrderID:    "myOrder1",
+		OptionSide:       OptionSideTypePut,
+		QuoteAsset:       "USDT",
+	}, res)
+}
+
+func (s *orderServiceTestSuite) TestCancelOrder() {
+	data := []byte(`{
+		"orderId": 4611875134427365377,
+		"symbol": "BTC-200730-9000-C",
+		"price": "100",
+		"quantity": "1",
+		"executedQty": "0",
+		"side": "BUY",
+		"type": "LIMIT",
+		"status": "CANCELLED",
+		"clientOrderId": "myOrder1"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":        "BTC-200730-9000-C",
+			"orderId":       4611875134427365377,
+			"clientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelOrderService().Symbol("BTC-200730-9000-C").OrderID(4611875134427365377).
+		ClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "0",
+		Side:             SideTypeBuy,
+		Type:             OrderTypeLimit,
+		Status:           OrderStatusTypeCancelled,
+		ClientOrderID:    "myOrder1",
+	}, res)
+}
+
+func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
+	data := []byte(`{"code": 0, "msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol": "BTC-200730-9000-C",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewCancelAllOpenOrdersService().Symbol("BTC-200730-9000-C").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *orderServiceTestSuite) TestCancelAllOpenOrdersByUnderlying() {
+	data := []byte(`{"code": 0, "data": 0}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"underlying": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewCancelAllOpenOrdersService().Symbol("BTC-200730-9000-C").Underlying("BTCUSDT").
+		Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *orderServiceTestSuite) TestListOpenOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4611875134427365377,
+			"symbol": "BTC-200730-9000-C",
+			"price": "100",
+			"quantity": "1",
+			"executedQty": "0",
+			"side": "BUY",
+			"type": "LIMIT",
+			"status": "ACCEPTED"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":    "BTC-200730-9000-C",
+			"startTime": 1592465880000,
+			"endTime":   1592465890000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListOpenOrdersService().Symbol("BTC-200730-9000-C").StartTime(1592465880000).
+		EndTime(1592465890000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "0",
+		Side:             SideTypeBuy,
+		Type:             OrderTypeLimit,
+		Status:           OrderStatusTypeAccepted,
+	}, res[0])
+}
+
+func (s *orderServiceTestSuite) TestListOrders() {
+	data := []byte(`[
+		{
+			"orderId": 4611875134427365377,
+			"symbol": "BTC-200730-9000-C",
+			"price": "100",
+			"quantity": "1",
+			"executedQty": "1",
+			"side": "BUY",
+			"type": "LIMIT",
+			"status": "FILLED"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":  "BTC-200730-9000-C",
+			"orderId": 4611875134427365370,
+			"limit":   10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListOrdersService().Symbol("BTC-200730-9000-C").OrderID(4611875134427365370).
+		Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.assertOrderEqual(&Order{
+		OrderID:          4611875134427365377,
+		Symbol:           "BTC-200730-9000-C",
+		Price:            "100",
+		Quantity:         "1",
+		ExecutedQuantity: "1",
+		Side:             SideTypeBuy,
+		Type:             OrderTypeLimit,
+		Status:           OrderStatusTypeFilled,
+	}, res[0])
+}
+
+func (s *baseTestSuite) assertOrderEqual(e, a *Order) {
+	r := s.r()
+	r.Equal(e.OrderID, a.OrderID, "OrderID")
+	r.Equal(e.Symbol, a.Symbol, "Symbol")
+	r.Equal(e.Price, a.Price, "Price")
+	r.Equal(e.Quantity, a.Quantity, "Quantity")
+	r.Equal(e.ExecutedQuantity, a.ExecutedQuantity, "ExecutedQuantity")
+	r.Equal(e.Fee, a.Fee, "Fee")
+	r.Equal(e.Side, a.Side, "Side")
+	r.Equal(e.Type, a.Type, "Type")
+	r.Equal(e.TimeInForce, a.TimeInForce, "TimeInForce")
+	r.Equal(e.CreateTime, a.CreateTime, "CreateTime")
+	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
+	r.Equal(e.Status, a.Status, "Status")
+	r.Equal(e.AvgPrice, a.AvgPrice, "AvgPrice")
+	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
+	r.Equal(e.PriceScale, a.PriceScale, "PriceScale")
+	r.Equal(e.QuantityScale, a.QuantityScale, "QuantityScale")
+	r.Equal(e.OptionSide, a.OptionSide, "OptionSide")
+	r.Equal(e.QuoteAsset, a.QuoteAsset, "QuoteAsset")
+}
@@ -0,0 +1,53 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetPositionService get the positions of the account
+type GetPositionService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetPositionService) Symbol(symbol string) *GetPositionService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetPositionService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Position, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/position")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*Position, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Position define an option position
+type Position struct {
+	EntryPrice    string           `json:"entryPrice"`
+	Symbol        string           `json:"symbol"`
+	Side          PositionSideType `json:"side"`
+	Quantity      string           `json:"quantity"`
+	ReducibleQty  string           `json:"reducibleQty"`
+	MarkValue     string           `json:"markValue"`
+	Ror           string           `json:"ror"`
+	UnrealizedPNL string           `json:"unrealizedPNL"`
+	MarkPrice     string           `json:"markPrice"`
+	StrikePrice   string           `json:"strikePrice"`
+	PositionCost  string           `json:"positionCost"`
+	ExpiryDate    int64            `json:"expiryDate"`
+	PriceScale    int              `json:"priceScale"`
+	QuantityScale int              `json:"quantityScale"`
+	OptionSide    OptionSideType   `json:"optionSide"`
+	QuoteAsset    string           `json:"quoteAsset"`
+}
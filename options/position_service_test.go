@@ -0,0 +1,67 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionService(t *testing.T) {
+	suite.Run(t, new(positionServiceTestSuite))
+}
+
+func (s *positionServiceTestSuite) TestGetPosition() {
+	data := []byte(`[
+		{
+			"entryPrice": "1000",
+			"symbol": "BTC-200730-9000-C",
+			"side": "SHORT",
+			"quantity": "-0.1",
+			"reducibleQty": "0",
+			"markValue": "105.00138",
+			"ror": "-0.05",
+			"unrealizedPNL": "-5.00138",
+			"markPrice": "1050.0138",
+			"strikePrice": "9000",
+			"positionCost": "1000.0000",
+			"expiryDate": 1593511200000,
+			"priceScale": 2,
+			"quantityScale": 2,
+			"optionSide": "CALL",
+			"quoteAsset": "USDT"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("symbol", "BTC-200730-9000-C")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetPositionService().Symbol("BTC-200730-9000-C").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Position{{
+		EntryPrice:    "1000",
+		Symbol:        "BTC-200730-9000-C",
+		Side:          PositionSideTypeShort,
+		Quantity:      "-0.1",
+		ReducibleQty:  "0",
+		MarkValue:     "105.00138",
+		Ror:           "-0.05",
+		UnrealizedPNL: "-5.00138",
+		MarkPrice:     "1050.0138",
+		StrikePrice:   "9000",
+		PositionCost:  "1000.0000",
+		ExpiryDate:    1593511200000,
+		PriceScale:    2,
+		QuantityScale: 2,
+		OptionSide:    OptionSideTypeCall,
+		QuoteAsset:    "USDT",
+	}}, res)
+}
@@ -0,0 +1,194 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// Session define a websocket session of the options market streams, the
+// symbols of the stream names are upper case, e.g. BTC-230630-30000-C
+type Session struct {
+	common.WebsocketSession
+	handler SessionHandler
+}
+
+// SessionHandler handle the events of a Session
+type SessionHandler interface {
+	common.WebsocketSessionHandler
+	OnTrade(*WsTradeEvent)
+	OnIndex(*WsIndexEvent)
+	OnMarkPrice(*WsMarkPriceEvent)
+	OnKline(*WsKlineEvent)
+	OnTicker(*WsTickerEvent)
+	OnOpenInterest(*WsOpenInterestEvent)
+	OnOptionPair(*WsOptionPairEvent)
+	OnDepth(*WsDepthEvent)
+	OnUserData(*WsUserDataEvent)
+}
+
+// SubscribeTrade subscribe the trades of symbols, or of all the symbols of
+// underlying assets, e.g. BTC
+func (s *Session) SubscribeTrade(ctx context.Context, symbol ...string) error {
+	var streams []string
+	for _, s := range symbol {
+		streams = append(streams, fmt.Sprintf("%s@trade", s))
+	}
+	return s.SubscribeNoReply(ctx, streams...)
+}
+
+// SubscribeIndex subscribe the index prices of underlyings, e.g. BTCUSDT
+func (s *Session) SubscribeIndex(ctx context.Context, underlying ...string) error {
+	var streams []string
+	for _, u := range underlying {
+		streams = append(streams, fmt.Sprintf("%s@index", u))
+	}
+	return s.SubscribeNoReply(ctx, streams...)
+}
+
+// SubscribeMarkPrice subscribe the mark prices of all the symbols of
+// underlying assets, e.g. BTC
+func (s *Session) SubscribeMarkPrice(ctx context.Context, underlyingAsset ...string) error {
+	var streams []string
+	for _, u := range underlyingAsset {
+		streams = append(streams, fmt.Sprintf("%s@markPrice", u))
+	}
+	return s.SubscribeNoReply(ctx, streams...)
+}
+
+// KlineStreamName return the kline stream name of a symbol and interval
+func (s *Session) KlineStreamName(symbol string, interval KlineInterval) string {
+	return fmt.Sprintf("%s@kline_%s", symbol, interval)
+}
+
+// SubscribeKline subscribe the klines of a symbol
+func (s *Session) SubscribeKline(ctx context.Context, symbol string, interval KlineInterval) error {
+	return s.SubscribeNoReply(ctx, s.KlineStreamName(symbol, interval))
+}
+
+// SubscribeTicker subscribe the 24hr tickers of symbols
+func (s *Session) SubscribeTicker(ctx context.Context, symbol ...string) error {
+	var streams []string
+	for _, s := range symbol {
+		streams = append(streams, fmt.Sprintf("%s@ticker", s))
+	}
+	return s.SubscribeNoReply(ctx, streams...)
+}
+
+// SubscribeExpiryTicker subscribe the 24hr tickers of all the symbols of an
+// underlying asset expiring at expiry, formatted as YYMMDD
+func (s *Session) SubscribeExpiryTicker(ctx context.Context, underlyingAsset, expiry string) error {
+	return s.SubscribeNoReply(ctx, fmt.Sprintf("%s@ticker@%s", underlyingAsset, expiry))
+}
+
+// SubscribeOpenInterest subscribe the open interest of all the symbols of an
+// underlying asset expiring at expiry, formatted as YYMMDD
+func (s *Session) SubscribeOpenInterest(ctx context.Context, underlyingAsset, expiry string) error {
+	return s.SubscribeNoReply(ctx, fmt.Sprintf("%s@openInterest@%s", underlyingAsset, expiry))
+}
+
+// SubscribeOptionPair subscribe the new symbols
+func (s *Session) SubscribeOptionPair(ctx context.Context) error {
+	return s.SubscribeNoReply(ctx, "option_pair")
+}
+
+// SubscribeDepth subscribe the depth of a symbol, level is one of 10, 20, 50
+// and 100, interval is one of 100ms, 500ms and 1s, or zero for the default
+func (s *Session) SubscribeDepth(ctx context.Context, symbol string, level int,
+	interval time.Duration,
+) error {
+	stream := fmt.Sprintf("%s@depth%d", symbol, level)
+	if interval > 0 {
+		stream = fmt.Sprintf("%s@%dms", stream, interval.Milliseconds())
+	}
+	return s.SubscribeNoReply(ctx, stream)
+}
+
+func (s *Session) registerHandler(handler SessionHandler) {
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsTradeEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnTrade),
+		s.RequireMapKeyValue("e", "trade"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsIndexEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnIndex),
+		s.RequireMapKeyValue("e", "index"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsMarkPriceEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnMarkPrice),
+		s.RequireMapKeyValue("e", "markPrice"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsKlineEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnKline),
+		s.RequireMapKeyValue("e", "kline"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsTickerEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnTicker),
+		s.RequireMapKeyValue("e", "24hrTicker"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsOpenInterestEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnOpenInterest),
+		s.RequireMapKeyValue("e", "openInterest"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsOptionPairEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnOptionPair),
+		s.RequireMapKeyValue("e", "OPTION_PAIR"),
+	)
+	s.RegisterMessageHandler(
+		common.WebsocketSessionMessageFactoryBuild[WsDepthEvent](),
+		common.WebsocketSessionMessageHandlerBuild(handler.OnDepth),
+		s.RequireMapKeyValue("e", "depth"),
+	)
+
+	for _, event := range []UserDataEventType{
+		UserDataEventTypeListenKeyExpired,
+		UserDataEventTypeAccountUpdate,
+		UserDataEventTypeOrderTradeUpdate,
+	} {
+		s.RegisterMessageHandler(
+			common.WebsocketSessionMessageFactoryBuild[WsUserDataEvent](),
+			common.WebsocketSessionMessageHandlerBuild(handler.OnUserData),
+			s.RequireMapKeyValue("e", string(event)),
+		)
+	}
+}
+
+func newMockSession(handler SessionHandler) (*Session, common.MockWebsocketSession) {
+	wss := common.NewMockWebsocketSession(handler)
+	session := new(Session)
+	session.WebsocketSession = wss
+	session.handler = handler
+	session.registerHandler(handler)
+	return session, wss
+}
+
+// NewSession connect a session of the market streams, or of the user data
+// stream of listenKey when it is not empty
+func NewSession(ctx context.Context, listenKey string, proxyURL *url.URL,
+	handler SessionHandler,
+) (session *Session, err error) {
+	address := baseWsMainUrl
+	if listenKey != "" {
+		address = fmt.Sprintf("%s/%s", address, listenKey)
+	}
+
+	cli, err := common.DefaultWebsocketProvider(ctx, address, proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	session = new(Session)
+	session.WebsocketSession = common.NewWebsocketSession(cli, handler)
+	session.handler = handler
+	session.registerHandler(handler)
+	return session, nil
+}
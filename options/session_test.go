@@ -0,0 +1,204 @@
+package options
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+type testSessionHandler struct {
+	*testing.T
+	done chan struct{}
+
+	trade, index, markPrice, kline, ticker, openInterest, optionPair, depth, userData bool
+	userDataListenKeyExpired, userDataAccountUpdate, userDataOrderUpdate              bool
+	doneWhenUserData                                                                  bool
+
+	markPriceCount int
+}
+
+func newTestSessionHandler(t *testing.T) *testSessionHandler {
+	return &testSessionHandler{T: t, done: make(chan struct{})}
+}
+
+func (t *testSessionHandler) OnUnknownMessage(bytes []byte, i interface{}) error {
+	t.Logf("got unknown message: %v\n", i)
+	return nil
+}
+
+func (t *testSessionHandler) OnClose(err error) {
+	t.Logf("got on close err: %v\n", err)
+}
+
+func (t *testSessionHandler) OnTrade(event *WsTradeEvent) {
+	t.trade = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnIndex(event *WsIndexEvent) {
+	t.index = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnMarkPrice(event *WsMarkPriceEvent) {
+	t.markPrice = true
+	t.markPriceCount++
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnKline(event *WsKlineEvent) {
+	t.kline = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnTicker(event *WsTickerEvent) {
+	t.ticker = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnOpenInterest(event *WsOpenInterestEvent) {
+	t.openInterest = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnOptionPair(event *WsOptionPairEvent) {
+	t.optionPair = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnDepth(event *WsDepthEvent) {
+	t.depth = true
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) OnUserData(event *WsUserDataEvent) {
+	t.Logf("user data event: %#v\n", event)
+	t.userData = true
+	switch event.Event {
+	case UserDataEventTypeListenKeyExpired:
+		t.userDataListenKeyExpired = true
+	case UserDataEventTypeAccountUpdate:
+		t.userDataAccountUpdate = true
+	case UserDataEventTypeOrderTradeUpdate:
+		t.userDataOrderUpdate = true
+	}
+	t.triggerDone()
+}
+
+func (t *testSessionHandler) triggerDone() {
+	if t.done == nil {
+		return
+	}
+	if !t.doneWhenUserData {
+		// trades and new symbols are rare, they are not waited for
+		if !t.index || !t.markPrice || !t.kline || !t.ticker || !t.openInterest ||
+			!t.depth || t.markPriceCount < 10 {
+			return
+		}
+	} else {
+		if !t.userData || !t.userDataOrderUpdate {
+			return
+		}
+	}
+	close(t.done)
+	t.done = nil
+	t.Log("handler ok.")
+}
+
+func TestSession(t *testing.T) {
+	if value := os.Getenv("TEST_OPTIONS_WS_SESSION"); value == "" {
+		t.Skip("skip options websocket session tests")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	info, err := NewClient("", "").NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.OptionSymbols) == 0 {
+		t.Fatal("no option symbols")
+	}
+	symbol := info.OptionSymbols[0]
+	expiry := time.UnixMilli(symbol.ExpiryDate).UTC().Format("060102")
+
+	handler := newTestSessionHandler(t)
+	session, err := NewSession(ctx, "", nil, handler)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	errC := session.RunLoop()
+
+	if err = session.SubscribeTrade(ctx, symbol.Symbol); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeIndex(ctx, symbol.Underlying); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeMarkPrice(ctx, "BTC"); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeKline(ctx, symbol.Symbol, KlineInterval1Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeTicker(ctx, symbol.Symbol); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeOpenInterest(ctx, "BTC", expiry); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeOptionPair(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = session.SubscribeDepth(ctx, symbol.Symbol, 10, 100*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log("waiting ..")
+	if handler.done != nil {
+		<-handler.done
+	}
+	t.Log("wait ok ..")
+
+	cancel()
+	if err = <-errC; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockSession(t *testing.T) {
+	handler := newTestSessionHandler(t)
+	session, wss := newMockSession(handler)
+
+	messages := []string{
+		`{"e":"trade","E":1591677941092,"s":"BTC-200630-9000-P","t":"315","p":"2.0","q":"-0.1","b":4611781675939004417,"a":4611690416473996289,"T":1591677567872,"S":"-1","X":"TRADE"}`,
+		`{"e":"index","E":1614840020000,"s":"ETHUSDT","p":"1530.74"}`,
+		`[{"e":"markPrice","E":1663684594227,"s":"ETH-220930-1500-C","mp":"30.3"},{"e":"markPrice","E":1663684594228,"s":"ETH-220930-1500-P","mp":"6.5"}]`,
+		`{"e":"kline","E":1638747660000,"s":"BTC-200630-9000-P","k":{"t":1638747660000,"T":1638747719999,"s":"BTC-200630-9000-P","i":"1m","F":0,"L":0,"o":"1000","c":"1000","h":"1000","l":"1000","v":"0","n":0,"x":false,"q":"0","V":"0","Q":"0"}}`,
+		`{"e":"24hrTicker","E":1657706425200,"T":1657706425220,"s":"BTC-220930-18000-C","o":"2000","h":"2020","l":"2000","c":"2020","V":"1.42","A":"2841.9","P":"0.01","p":"20","Q":"0.01","F":"27","L":"48","n":22,"bo":"2012","ao":"2021","bq":"4.9","aq":"0.03","b":"0.1202","a":"0.1318","d":"0.98911","t":"-0.16961","g":"0.00004","v":"2.66584","vo":"0.10001","mp":"2003.5102","hl":"2023.511","ll":"1983.511","eep":"0"}`,
+		`[{"e":"openInterest","E":1668759300045,"s":"ETH-221125-2700-C","o":"1580.87","h":"1912208.1"}]`,
+		`{"e":"OPTION_PAIR","E":1668573571842,"id":652,"cid":2,"u":"BTCUSDT","qa":"USDT","s":"BTC-221116-21000-C","unit":1,"mq":"0.01","d":"CALL","sp":"21000","ed":1668585600000}`,
+		`{"e":"depth","E":1591695934010,"T":1591695934000,"s":"BTC-200630-9000-P","u":162,"pu":162,"b":[["200","3"],["101","1"]],"a":[["1000","89"]]}`,
+		`{"e":"listenKeyExpired","E":1576653824250}`,
+		`{"e":"ACCOUNT_UPDATE","E":1591696384141,"B":[{"b":"100007992.26053177","m":"0","u":"458.782655111111","U":458.782655111111,"M":"-15452.328456","i":"-18852.328456","a":"USDT"}],"G":[{"ui":"SOLUSDT","d":-33.2933905,"t":35.5926375,"g":-13.3923481,"v":-0.0000199}],"P":[{"s":"SOL-220912-35-C","c":"-50","r":"-50","p":"-100","a":"2.2"}],"uid":1000006559949}`,
+		`{"e":"ORDER_TRADE_UPDATE","E":1657613775883,"o":[{"T":1657613342918,"t":1657613342918,"s":"BTC-220930-18000-C","c":"","oid":"4611869636869226548","p":"1993","q":"1","stp":0,"r":false,"po":true,"S":"PARTIALLY_FILLED","e":"0.1","ec":"199.3","f":"2","tif":"GTC","oty":"LIMIT","fi":[{"t":"20","p":"1993","q":"0.1","T":1657613774336,"m":"TAKER","f":"0.0002"}]}]}`,
+	}
+
+	for _, msg := range messages {
+		if err := wss.MockProcessMessage([]byte(msg)); err != nil {
+			t.Fatal(err, msg)
+		}
+	}
+	t.Log(session)
+	if !handler.trade || !handler.index || !handler.markPrice || !handler.kline || !handler.ticker ||
+		!handler.openInterest || !handler.optionPair || !handler.depth || handler.markPriceCount != 2 {
+		t.Fatal("handler did not get market events")
+	}
+	if !handler.userData || !handler.userDataListenKeyExpired || !handler.userDataAccountUpdate ||
+		!handler.userDataOrderUpdate {
+		t.Fatal("handler did not get user events")
+	}
+}
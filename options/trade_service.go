@@ -0,0 +1,140 @@
+package options
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// RecentTradesService list recent trades
+type RecentTradesService struct {
+	c      *Client
+	symbol string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *RecentTradesService) Symbol(symbol string) *RecentTradesService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit, default:100 max:500
+func (s *RecentTradesService) Limit(limit int) *RecentTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *RecentTradesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Trade, err error) {
+	r := common.NewGetRequestPublic("/eapi/v1/trades")
+	r.SetQuery("symbol", s.symbol)
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*Trade, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Trade define trade info, Side is 1 when the taker bought and -1 when it sold
+type Trade struct {
+	ID            int64  `json:"id"`
+	TradeID       int64  `json:"tradeId"`
+	Symbol        string `json:"symbol"`
+	Price         string `json:"price"`
+	Quantity      string `json:"qty"`
+	QuoteQuantity string `json:"quoteQty"`
+	Side          int    `json:"side"`
+	Time          int64  `json:"time"`
+}
+
+// ListUserTradesService list the trades of the account
+type ListUserTradesService struct {
+	c         *Client
+	symbol    *string
+	fromID    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListUserTradesService) Symbol(symbol string) *ListUserTradesService {
+	s.symbol = &symbol
+	return s
+}
+
+// FromID set the trade id to fetch from
+func (s *ListUserTradesService) FromID(fromID int64) *ListUserTradesService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListUserTradesService) StartTime(startTime int64) *ListUserTradesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListUserTradesService) EndTime(endTime int64) *ListUserTradesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default:100 max:1000
+func (s *ListUserTradesService) Limit(limit int) *ListUserTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListUserTradesService) Do(ctx context.Context, opts ...common.RequestOption) (res []*UserTrade, err error) {
+	r := common.NewGetRequestSigned("/eapi/v1/userTrades")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.fromID != nil {
+		r.SetQuery("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*UserTrade, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UserTrade define a trade of the account
+type UserTrade struct {
+	ID             int64          `json:"id"`
+	TradeID        int64          `json:"tradeId"`
+	OrderID        int64          `json:"orderId"`
+	Symbol         string         `json:"symbol"`
+	Price          string         `json:"price"`
+	Quantity       string         `json:"quantity"`
+	Fee            string         `json:"fee"`
+	RealizedProfit string         `json:"realizedProfit"`
+	Side           SideType       `json:"side"`
+	Type           OrderType      `json:"type"`
+	Volatility     string         `json:"volatility"`
+	Liquidity      string         `json:"liquidity"`
+	QuoteAsset     string         `json:"quoteAsset"`
+	Time           int64          `json:"time"`
+	PriceScale     int            `json:"priceScale"`
+	QuantityScale  int            `json:"quantityScale"`
+	OptionSide     OptionSideType `json:"optionSide"`
+}
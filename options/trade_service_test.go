@@ -0,0 +1,111 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradeService(t *testing.T) {
+	suite.Run(t, new(tradeServiceTestSuite))
+}
+
+func (s *tradeServiceTestSuite) TestRecentTrades() {
+	data := []byte(`[
+		{
+			"id": 1,
+			"tradeId": 159244329455,
+			"symbol": "BTC-220722-19000-C",
+			"price": "1000",
+			"qty": "-0.1",
+			"quoteQty": "-100",
+			"side": -1,
+			"time": 1592449455993
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest().SetQueryParams(common.Params{
+			"symbol": "BTC-220722-19000-C",
+			"limit":  1,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRecentTradesService().Symbol("BTC-220722-19000-C").Limit(1).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Trade{{
+		ID:            1,
+		TradeID:       159244329455,
+		Symbol:        "BTC-220722-19000-C",
+		Price:         "1000",
+		Quantity:      "-0.1",
+		QuoteQuantity: "-100",
+		Side:          -1,
+		Time:          1592449455993,
+	}}, res)
+}
+
+func (s *tradeServiceTestSuite) TestListUserTrades() {
+	data := []byte(`[
+		{
+			"id": 4611875134427365377,
+			"tradeId": 239,
+			"orderId": 4611875134427365377,
+			"symbol": "BTC-200730-9000-C",
+			"price": "100",
+			"quantity": "1",
+			"fee": "0",
+			"realizedProfit": "0.00000000",
+			"side": "BUY",
+			"type": "LIMIT",
+			"volatility": "0.9",
+			"liquidity": "TAKER",
+			"quoteAsset": "USDT",
+			"time": 1592465880683,
+			"priceScale": 2,
+			"quantityScale": 2,
+			"optionSide": "CALL"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol": "BTC-200730-9000-C",
+			"fromId": 238,
+			"limit":  100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListUserTradesService().Symbol("BTC-200730-9000-C").FromID(238).Limit(100).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*UserTrade{{
+		ID:             4611875134427365377,
+		TradeID:        239,
+		OrderID:        4611875134427365377,
+		Symbol:         "BTC-200730-9000-C",
+		Price:          "100",
+		Quantity:       "1",
+		Fee:            "0",
+		RealizedProfit: "0.00000000",
+		Side:           SideTypeBuy,
+		Type:           OrderTypeLimit,
+		Volatility:     "0.9",
+		Liquidity:      "TAKER",
+		QuoteAsset:     "USDT",
+		Time:           1592465880683,
+		PriceScale:     2,
+		QuantityScale:  2,
+		OptionSide:     OptionSideTypeCall,
+	}}, res)
+}
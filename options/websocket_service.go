@@ -0,0 +1,215 @@
+package options
+
+import (
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// Endpoints
+const (
+	baseWsMainUrl = "wss://nbstream.binance.com/eapi/ws"
+)
+
+// WsTradeEvent define websocket trade event, Side is "1" when the taker
+// bought and "-1" when it sold
+type WsTradeEvent struct {
+	Event         string `json:"e"`
+	Time          int64  `json:"E"`
+	Symbol        string `json:"s"`
+	TradeID       string `json:"t"`
+	Price         string `json:"p"`
+	Quantity      string `json:"q"`
+	BuyerOrderID  int64  `json:"b"`
+	SellerOrderID int64  `json:"a"`
+	TradeTime     int64  `json:"T"`
+	Side          string `json:"S"`
+	TradeType     string `json:"X"`
+}
+
+// WsIndexEvent define websocket index price event of an underlying
+type WsIndexEvent struct {
+	Event  string `json:"e"`
+	Time   int64  `json:"E"`
+	Symbol string `json:"s"`
+	Price  string `json:"p"`
+}
+
+// WsMarkPriceEvent define websocket mark price event, the events of all the
+// symbols of an underlying are pushed together
+type WsMarkPriceEvent struct {
+	Event     string `json:"e"`
+	Time      int64  `json:"E"`
+	Symbol    string `json:"s"`
+	MarkPrice string `json:"mp"`
+}
+
+// WsKlineEvent define websocket kline event
+type WsKlineEvent struct {
+	Event  string  `json:"e"`
+	Time   int64   `json:"E"`
+	Symbol string  `json:"s"`
+	Kline  WsKline `json:"k"`
+}
+
+// WsKline define websocket kline
+type WsKline struct {
+	StartTime    int64  `json:"t"`
+	EndTime      int64  `json:"T"`
+	Symbol       string `json:"s"`
+	Interval     string `json:"i"`
+	FirstTradeID int64  `json:"F"`
+	LastTradeID  int64  `json:"L"`
+	Open         string `json:"o"`
+	Close        string `json:"c"`
+	High         string `json:"h"`
+	Low          string `json:"l"`
+	Volume       string `json:"v"`
+	TradeNum     int64  `json:"n"`
+	IsFinal      bool   `json:"x"`
+	Amount       string `json:"q"`
+	TakerVolume  string `json:"V"`
+	TakerAmount  string `json:"Q"`
+}
+
+// WsTickerEvent define websocket 24hr ticker event, with the implied
+// volatilities and greeks of the symbol
+type WsTickerEvent struct {
+	Event                string `json:"e"`
+	Time                 int64  `json:"E"`
+	TransactionTime      int64  `json:"T"`
+	Symbol               string `json:"s"`
+	OpenPrice            string `json:"o"`
+	HighPrice            string `json:"h"`
+	LowPrice             string `json:"l"`
+	ClosePrice           string `json:"c"`
+	Volume               string `json:"V"`
+	Amount               string `json:"A"`
+	PriceChangePercent   string `json:"P"`
+	PriceChange          string `json:"p"`
+	LastQuantity         string `json:"Q"`
+	FirstTradeID         string `json:"F"`
+	LastTradeID          string `json:"L"`
+	TradeCount           int64  `json:"n"`
+	BidPrice             string `json:"bo"`
+	AskPrice             string `json:"ao"`
+	BidQuantity          string `json:"bq"`
+	AskQuantity          string `json:"aq"`
+	BidIV                string `json:"b"`
+	AskIV                string `json:"a"`
+	Delta                string `json:"d"`
+	Theta                string `json:"t"`
+	Gamma                string `json:"g"`
+	Vega                 string `json:"v"`
+	IV                   string `json:"vo"`
+	MarkPrice            string `json:"mp"`
+	HighPriceLimit       string `json:"hl"`
+	LowPriceLimit        string `json:"ll"`
+	EstimatedStrikePrice string `json:"eep"`
+}
+
+// WsOpenInterestEvent define websocket open interest event, the events of
+// all the symbols of an underlying and an expiry are pushed together
+type WsOpenInterestEvent struct {
+	Event             string `json:"e"`
+	Time              int64  `json:"E"`
+	Symbol            string `json:"s"`
+	OpenInterest      string `json:"o"`
+	OpenInterestValue string `json:"h"`
+}
+
+// WsOptionPairEvent define websocket event of a new option symbol
+type WsOptionPairEvent struct {
+	Event       string         `json:"e"`
+	Time        int64          `json:"E"`
+	ID          int64          `json:"id"`
+	ContractID  int64          `json:"cid"`
+	Underlying  string         `json:"u"`
+	QuoteAsset  string         `json:"qa"`
+	Symbol      string         `json:"s"`
+	Unit        int64          `json:"unit"`
+	MinQuantity string         `json:"mq"`
+	Side        OptionSideType `json:"d"`
+	StrikePrice string         `json:"sp"`
+	ExpiryDate  int64          `json:"ed"`
+}
+
+// WsDepthEvent define websocket depth event
+type WsDepthEvent struct {
+	Event            string                   `json:"e"`
+	Time             int64                    `json:"E"`
+	TransactionTime  int64                    `json:"T"`
+	Symbol           string                   `json:"s"`
+	LastUpdateID     int64                    `json:"u"`
+	PrevLastUpdateID int64                    `json:"pu"`
+	Bids             []common.PriceLevelArray `json:"b"`
+	Asks             []common.PriceLevelArray `json:"a"`
+}
+
+// WsUserDataEvent define user data event
+type WsUserDataEvent struct {
+	Event     UserDataEventType `json:"e"`
+	Time      int64             `json:"E"`
+	UserID    int64             `json:"uid"`
+	Balances  []WsBalance       `json:"B"`
+	Greeks    []WsGreek         `json:"G"`
+	Positions []WsPosition      `json:"P"`
+	Orders    []WsOrder         `json:"o"`
+}
+
+// WsBalance define the balance of an asset of an account update
+type WsBalance struct {
+	Asset                 string  `json:"a"`
+	Balance               string  `json:"b"`
+	PositionValue         string  `json:"m"`
+	UnrealizedPnL         string  `json:"u"`
+	PositiveUnrealizedPnL float64 `json:"U"`
+	MaintenanceMargin     string  `json:"M"`
+	InitialMargin         string  `json:"i"`
+}
+
+// WsGreek define the greeks of an underlying of an account update
+type WsGreek struct {
+	Underlying string  `json:"ui"`
+	Delta      float64 `json:"d"`
+	Theta      float64 `json:"t"`
+	Gamma      float64 `json:"g"`
+	Vega       float64 `json:"v"`
+}
+
+// WsPosition define a position of an account update
+type WsPosition struct {
+	Symbol            string `json:"s"`
+	Quantity          string `json:"c"`
+	ReducibleQuantity string `json:"r"`
+	PositionValue     string `json:"p"`
+	EntryPrice        string `json:"a"`
+}
+
+// WsOrder define an order of an order trade update
+type WsOrder struct {
+	CreateTime       int64           `json:"T"`
+	UpdateTime       int64           `json:"t"`
+	Symbol           string          `json:"s"`
+	ClientOrderID    string          `json:"c"`
+	OrderID          string          `json:"oid"`
+	Price            string          `json:"p"`
+	Quantity         string          `json:"q"`
+	ReduceOnly       bool            `json:"r"`
+	PostOnly         bool            `json:"po"`
+	Status           OrderStatusType `json:"S"`
+	ExecutedQuantity string          `json:"e"`
+	ExecutedCost     string          `json:"ec"`
+	Fee              string          `json:"f"`
+	TimeInForce      TimeInForceType `json:"tif"`
+	Type             OrderType       `json:"oty"`
+	Fills            []WsOrderFill   `json:"fi"`
+}
+
+// WsOrderFill define a fill of an order trade update
+type WsOrderFill struct {
+	TradeID   string `json:"t"`
+	Price     string `json:"p"`
+	Quantity  string `json:"q"`
+	TradeTime int64  `json:"T"`
+	Liquidity string `json:"m"`
+	Fee       string `json:"f"`
+}
@@ -0,0 +1,202 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// OrderListOrder define an order of an order list, empty fields are not sent
+type OrderListOrder struct {
+	Type            OrderType
+	Side            SideType
+	ClientOrderID   string
+	Price           string
+	StopPrice       string
+	TrailingDelta   int64
+	Quantity        string
+	IcebergQuantity string
+	TimeInForce     TimeInForceType
+}
+
+// setParams adds the fields of o to m, each name prefixed with prefix like working or pendingAbove.
+func (o *OrderListOrder) setParams(m common.Params, prefix string) {
+	set := func(name string, value string) {
+		if value != "" {
+			m[prefix+name] = value
+		}
+	}
+	set("Type", string(o.Type))
+	set("Side", string(o.Side))
+	set("ClientOrderId", o.ClientOrderID)
+	set("Price", o.Price)
+	set("StopPrice", o.StopPrice)
+	set("Quantity", o.Quantity)
+	set("IcebergQty", o.IcebergQuantity)
+	set("TimeInForce", string(o.TimeInForce))
+	if o.TrailingDelta != 0 {
+		m[prefix+"TrailingDelta"] = o.TrailingDelta
+	}
+}
+
+// CreateOrderListResponse define create order list response, the order lists
+// share the response of an OCO
+type CreateOrderListResponse = CreateOCOResponse
+
+// CreateOTOService create a one-triggers-the-other order list: the pending
+// order is placed once the working order is fully filled
+type CreateOTOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	newOrderRespType  *NewOrderRespType
+	working           OrderListOrder
+	pending           OrderListOrder
+}
+
+// Symbol set symbol
+func (s *CreateOTOService) Symbol(symbol string) *CreateOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOTOService) ListClientOrderID(listClientOrderID string) *CreateOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Working set the working order, a LIMIT or LIMIT_MAKER order
+func (s *CreateOTOService) Working(order OrderListOrder) *CreateOTOService {
+	s.working = order
+	return s
+}
+
+// Pending set the pending order
+func (s *CreateOTOService) Pending(order OrderListOrder) *CreateOTOService {
+	s.pending = order
+	return s
+}
+
+// Do send Request
+func (s *CreateOTOService) Do(ctx context.Context, opts ...common.RequestOption) (res *CreateOrderListResponse, err error) {
+	r := common.NewPostRequestSigned("/api/v3/orderList/oto")
+	m := common.Params{
+		"symbol": s.symbol,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	s.working.setParams(m, "working")
+	s.pending.setParams(m, "pending")
+	r.SetFormParams(m)
+
+	res = new(CreateOrderListResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOTOCOService create a one-triggers-a-one-cancels-the-other order list:
+// once the working order is fully filled, an OCO made of the pending above and
+// pending below orders is placed
+type CreateOTOCOService struct {
+	c                 *Client
+	symbol            string
+	listClientOrderID *string
+	newOrderRespType  *NewOrderRespType
+	working           OrderListOrder
+	pendingSide       SideType
+	pendingQuantity   string
+	pendingAbove      OrderListOrder
+	pendingBelow      OrderListOrder
+}
+
+// Symbol set symbol
+func (s *CreateOTOCOService) Symbol(symbol string) *CreateOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Working set the working order, a LIMIT or LIMIT_MAKER order
+func (s *CreateOTOCOService) Working(order OrderListOrder) *CreateOTOCOService {
+	s.working = order
+	return s
+}
+
+// PendingSide set the side of both pending orders
+func (s *CreateOTOCOService) PendingSide(side SideType) *CreateOTOCOService {
+	s.pendingSide = side
+	return s
+}
+
+// PendingQuantity set the quantity of both pending orders
+func (s *CreateOTOCOService) PendingQuantity(quantity string) *CreateOTOCOService {
+	s.pendingQuantity = quantity
+	return s
+}
+
+// PendingAbove set the pending order above the price, its Side and Quantity
+// are ignored in favor of PendingSide and PendingQuantity
+func (s *CreateOTOCOService) PendingAbove(order OrderListOrder) *CreateOTOCOService {
+	s.pendingAbove = order
+	return s
+}
+
+// PendingBelow set the pending order below the price, its Side and Quantity
+// are ignored in favor of PendingSide and PendingQuantity
+func (s *CreateOTOCOService) PendingBelow(order OrderListOrder) *CreateOTOCOService {
+	s.pendingBelow = order
+	return s
+}
+
+// Do send Request
+func (s *CreateOTOCOService) Do(ctx context.Context, opts ...common.RequestOption) (res *CreateOrderListResponse, err error) {
+	r := common.NewPostRequestSigned("/api/v3/orderList/otoco")
+	m := common.Params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	s.working.setParams(m, "working")
+	above, below := s.pendingAbove, s.pendingBelow
+	above.Side, above.Quantity = "", ""
+	below.Side, below.Quantity = "", ""
+	above.setParams(m, "pendingAbove")
+	below.setParams(m, "pendingBelow")
+	r.SetFormParams(m)
+
+	res = new(CreateOrderListResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderListServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderListService(t *testing.T) {
+	suite.Run(t, new(orderListServiceTestSuite))
+}
+
+func (s *orderListServiceTestSuite) TestCreateOTO() {
+	data := []byte(`{
+		"orderListId": 0,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "yl2ERtcar1o25zcWtqVBTC",
+		"transactionTime": 1712289389158,
+		"symbol": "BTCUSDT",
+		"orders": [
+			{"symbol": "BTCUSDT", "orderId": 4, "clientOrderId": "Bq17mn9fP6vyCn75Jw1xya"},
+			{"symbol": "BTCUSDT", "orderId": 5, "clientOrderId": "arLFo0zGJVDE69cvGBaU0d"}
+		],
+		"orderReports": [
+			{
+				"symbol": "BTCUSDT",
+				"orderId": 4,
+				"orderListId": 0,
+				"clientOrderId": "Bq17mn9fP6vyCn75Jw1xya",
+				"transactTime": 1712289389158,
+				"price": "1.00000000",
+				"origQty": "1.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			},
+			{
+				"symbol": "BTCUSDT",
+				"orderId": 5,
+				"orderListId": 0,
+				"clientOrderId": "arLFo0zGJVDE69cvGBaU0d",
+				"transactTime": 1712289389158,
+				"price": "0.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "PENDING_NEW",
+				"timeInForce": "GTC",
+				"type": "MARKET",
+				"side": "BUY"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":             "BTCUSDT",
+			"workingType":        OrderTypeLimit,
+			"workingSide":        SideTypeSell,
+			"workingPrice":       "1",
+			"workingQuantity":    "1",
+			"workingTimeInForce": TimeInForceTypeGTC,
+			"pendingType":        OrderTypeMarket,
+			"pendingSide":        SideTypeBuy,
+			"pendingQuantity":    "5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOTOService().Symbol("BTCUSDT").
+		Working(OrderListOrder{
+			Type: OrderTypeLimit, Side: SideTypeSell, Price: "1", Quantity: "1",
+			TimeInForce: TimeInForceTypeGTC,
+		}).
+		Pending(OrderListOrder{Type: OrderTypeMarket, Side: SideTypeBuy, Quantity: "5"}).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("OTO", res.ContingencyType)
+	r.Len(res.Orders, 2)
+	r.Len(res.OrderReports, 2)
+	r.Equal(OrderTypeMarket, res.OrderReports[1].Type)
+}
+
+func (s *orderListServiceTestSuite) TestCreateOTOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "bracket1",
+		"transactionTime": 1712291372842,
+		"symbol": "BTCUSDT",
+		"orders": [
+			{"symbol": "BTCUSDT", "orderId": 6, "clientOrderId": "entry"},
+			{"symbol": "BTCUSDT", "orderId": 7, "clientOrderId": "stop"},
+			{"symbol": "BTCUSDT", "orderId": 8, "clientOrderId": "target"}
+		],
+		"orderReports": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":                    "BTCUSDT",
+			"listClientOrderId":         "bracket1",
+			"newOrderRespType":          NewOrderRespTypeRESULT,
+			"workingType":               OrderTypeLimit,
+			"workingSide":               SideTypeBuy,
+			"workingClientOrderId":      "entry",
+			"workingPrice":              "60000",
+			"workingQuantity":           "0.1",
+			"workingTimeInForce":        TimeInForceTypeGTC,
+			"pendingSide":               SideTypeSell,
+			"pendingQuantity":           "0.1",
+			"pendingAboveType":          OrderTypeLimitMaker,
+			"pendingAboveClientOrderId": "target",
+			"pendingAbovePrice":         "66000",
+			"pendingBelowType":          OrderTypeStopLoss,
+			"pendingBelowClientOrderId": "stop",
+			"pendingBelowStopPrice":     "57000",
+			"pendingBelowTrailingDelta": 100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOTOCOService().Symbol("BTCUSDT").ListClientOrderID("bracket1").
+		NewOrderRespType(NewOrderRespTypeRESULT).
+		Working(OrderListOrder{
+			Type: OrderTypeLimit, Side: SideTypeBuy, ClientOrderID: "entry", Price: "60000",
+			Quantity: "0.1", TimeInForce: TimeInForceTypeGTC,
+		}).
+		PendingSide(SideTypeSell).PendingQuantity("0.1").
+		PendingAbove(OrderListOrder{
+			Type: OrderTypeLimitMaker, ClientOrderID: "target", Price: "66000",
+			Side: SideTypeBuy, Quantity: "9",
+		}).
+		PendingBelow(OrderListOrder{
+			Type: OrderTypeStopLoss, ClientOrderID: "stop", StopPrice: "57000", TrailingDelta: 100,
+		}).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1), res.OrderListID)
+	r.Equal("bracket1", res.ListClientOrderID)
+	r.Len(res.Orders, 3)
+}
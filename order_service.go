@@ -21,6 +21,9 @@ type CreateOrderService struct {
 	newClientOrderID *string
 	stopPrice        *string
 	icebergQuantity  *string
+	stpMode          *SelfTradePreventionModeType
+	strategyID       *int64
+	strategyType     *int
 }
 
 // Symbol set symbol
@@ -89,6 +92,24 @@ func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode, the default of the symbol is used when unset
+func (s *CreateOrderService) SelfTradePreventionMode(mode SelfTradePreventionModeType) *CreateOrderService {
+	s.stpMode = &mode
+	return s
+}
+
+// StrategyID set strategyId, an arbitrary id tying the order to a strategy
+func (s *CreateOrderService) StrategyID(strategyID int64) *CreateOrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType, it must be at least 1000000
+func (s *CreateOrderService) StrategyType(strategyType int) *CreateOrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, result interface{},
 	opts ...common.RequestOption,
 ) (err error) {
@@ -122,6 +143,15 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, r
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
+	if s.stpMode != nil {
+		m["selfTradePreventionMode"] = *s.stpMode
+	}
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
 	r.SetFormParams(m)
 
 	if err = s.c.CallAPI(ctx, r, result, opts...); err != nil {
@@ -161,6 +191,10 @@ type CreateOrderResponse struct {
 	Type        OrderType       `json:"type"`
 	Side        SideType        `json:"side"`
 
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"selfTradePreventionMode"`
+	StrategyID              int64                       `json:"strategyId"`
+	StrategyType            int                         `json:"strategyType"`
+
 	// for order response is set to FULL
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
@@ -648,7 +682,11 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...common.Request
 	if err = s.c.CallAPI(ctx, r, &rawMessages, opts...); err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
+	return decodeCancelOpenOrders(rawMessages)
+}
 
+// decodeCancelOpenOrders splits the canceled orders from the canceled order lists.
+func decodeCancelOpenOrders(rawMessages []*json.RawMessage) (*CancelOpenOrdersResponse, error) {
 	cancelOpenOrdersResponse := new(CancelOpenOrdersResponse)
 	for _, j := range rawMessages {
 		o := new(CancelOrderResponse)
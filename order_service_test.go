@@ -84,6 +84,47 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderSelfTradePrevention() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"price": "0.00000000",
+		"origQty": "10.00000000",
+		"executedQty": "10.00000000",
+		"cummulativeQuoteQty": "10.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "MARKET",
+		"side": "SELL",
+		"selfTradePreventionMode": "EXPIRE_BOTH",
+		"strategyId": 37463720,
+		"strategyType": 1000000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeMarket,
+			"quantity":                "10",
+			"selfTradePreventionMode": SelfTradePreventionModeTypeExpireBoth,
+			"strategyId":              37463720,
+			"strategyType":            1000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("10").SelfTradePreventionMode(SelfTradePreventionModeTypeExpireBoth).
+		StrategyID(37463720).StrategyType(1000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(SelfTradePreventionModeTypeExpireBoth, res.SelfTradePreventionMode)
+	s.r().Equal(int64(37463720), res.StrategyID)
+	s.r().Equal(1000000, res.StrategyType)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
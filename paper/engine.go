@@ -0,0 +1,316 @@
+package paper
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	orderTypeLimit      = "LIMIT"
+	orderTypeMarket     = "MARKET"
+	orderTypeLimitMaker = "LIMIT_MAKER"
+
+	timeInForceGTC = "GTC"
+	timeInForceIOC = "IOC"
+	timeInForceFOK = "FOK"
+	timeInForceGTX = "GTX"
+
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+
+	executionNew      = "NEW"
+	executionTrade    = "TRADE"
+	executionCanceled = "CANCELED"
+	executionExpired  = "EXPIRED"
+)
+
+// order is the simulated state of one order, timestamps are in milliseconds.
+type order struct {
+	Symbol        string
+	ID            int64
+	ClientOrderID string
+	Side          string
+	Type          string
+	TimeInForce   string
+	PositionSide  string
+	ReduceOnly    bool
+	Price         float64
+	StopPrice     float64
+	Quantity      float64
+	QuoteQuantity float64
+	Executed      float64
+	CumQuote      float64
+	Status        string
+	Time          int64
+	UpdateTime    int64
+}
+
+func (o *order) isBuy() bool {
+	return o.Side == sideBuy
+}
+
+func (o *order) isOpen() bool {
+	return o.Status == statusNew || o.Status == statusPartiallyFilled
+}
+
+func (o *order) avgPrice() float64 {
+	if o.Executed == 0 {
+		return 0
+	}
+	return o.CumQuote / o.Executed
+}
+
+// crosses tells if the order limit price allows a trade at price.
+func (o *order) crosses(price float64) bool {
+	if o.Type == orderTypeMarket {
+		return true
+	}
+	if o.isBuy() {
+		return price <= o.Price
+	}
+	return price >= o.Price
+}
+
+// remaining returns the quantity left to fill at price, market orders placed
+// with a quote quantity are bounded by the quote left to spend.
+func (o *order) remaining(price float64) float64 {
+	if o.QuoteQuantity > 0 {
+		return round(math.Max(o.QuoteQuantity-o.CumQuote, 0) / price)
+	}
+	return round(o.Quantity - o.Executed)
+}
+
+type fill struct {
+	TradeID    int64
+	Price      float64
+	Quantity   float64
+	Commission float64
+	Asset      string
+	Maker      bool
+}
+
+// execution is an order change reported on the user data stream.
+type execution struct {
+	Order         order
+	ExecutionType string
+	Fill          *fill
+	Time          int64
+}
+
+type book struct {
+	bids []Level
+	asks []Level
+}
+
+// levels returns the side of the book an order of the given side trades against.
+func (b *book) levels(buy bool) []Level {
+	if buy {
+		return b.asks
+	}
+	return b.bids
+}
+
+func newBook(bids, asks []Level) *book {
+	b := &book{
+		bids: append([]Level(nil), bids...),
+		asks: append([]Level(nil), asks...),
+	}
+	sort.SliceStable(b.bids, func(i, j int) bool { return b.bids[i].Price > b.bids[j].Price })
+	sort.SliceStable(b.asks, func(i, j int) bool { return b.asks[i].Price < b.asks[j].Price })
+	return b
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1e8) / 1e8
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(round(v), 'f', -1, 64)
+}
+
+func parseFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// available returns how much of the opposite book the order can trade right
+// now, with at most fillRatio of every level.
+func available(o *order, b *book, fillRatio float64) (quantity float64) {
+	if b == nil {
+		return 0
+	}
+	for _, level := range b.levels(o.isBuy()) {
+		if !o.crosses(level.Price) {
+			break
+		}
+		quantity += level.Quantity * fillRatio
+	}
+	return round(quantity)
+}
+
+// match trades o against the opposite side of b and consumes the traded
+// liquidity so it is not filled twice before the next book update. Taker
+// orders trade at the level prices, resting (maker) orders at their limit price.
+func (x *Exchange) match(o *order, b *book, maker bool, now int64) (executions []execution) {
+	if b == nil {
+		return nil
+	}
+	fee := x.config.TakerFee
+	if maker {
+		fee = x.config.MakerFee
+	}
+	levels := b.levels(o.isBuy())
+	for i := range levels {
+		level := &levels[i]
+		if !o.crosses(level.Price) {
+			break
+		}
+		price := level.Price
+		if maker {
+			price = o.Price
+		}
+		quantity := round(math.Min(level.Quantity*x.config.FillRatio, o.remaining(price)))
+		if quantity <= 0 {
+			if o.remaining(price) <= 0 {
+				break
+			}
+			continue
+		}
+		level.Quantity = round(level.Quantity - quantity)
+
+		x.tradeID++
+		o.Executed = round(o.Executed + quantity)
+		o.CumQuote = round(o.CumQuote + quantity*price)
+		o.Status = statusPartiallyFilled
+		if o.remaining(price) <= 0 {
+			o.Status = statusFilled
+		}
+		o.UpdateTime = now
+		executions = append(executions, execution{
+			Order:         *o,
+			ExecutionType: executionTrade,
+			Fill: &fill{
+				TradeID:    x.tradeID,
+				Price:      price,
+				Quantity:   quantity,
+				Commission: round(quantity * price * fee),
+				Asset:      x.config.CommissionAsset,
+				Maker:      maker,
+			},
+			Time: now,
+		})
+		if o.Status == statusFilled {
+			break
+		}
+	}
+	return executions
+}
+
+// place validates and executes a new order, the returned executions start with
+// the NEW execution. It returns errWouldMatch for post only orders which are
+// rejected rather than expired when crossing the book.
+func (x *Exchange) place(o *order, rejectCrossing bool) (executions []execution, err error) {
+	now := x.now()
+	b := x.books[o.Symbol]
+
+	postOnly := o.Type == orderTypeLimitMaker || o.TimeInForce == timeInForceGTX
+	if postOnly && available(o, b, 1) > 0 && rejectCrossing {
+		return nil, errWouldMatch
+	}
+
+	x.orderID++
+	o.ID = x.orderID
+	if o.ClientOrderID == "" {
+		o.ClientOrderID = "paper_" + strconv.FormatInt(o.ID, 10)
+	}
+	o.Status = statusNew
+	o.Time, o.UpdateTime = now, now
+	executions = append(executions, execution{Order: *o, ExecutionType: executionNew, Time: now})
+	// closed orders are moved to the history by prune.
+	x.orders = append(x.orders, o)
+
+	switch {
+	case postOnly && available(o, b, 1) > 0:
+		return append(executions, x.expire(o, now)), nil
+	case postOnly:
+	case o.TimeInForce == timeInForceFOK && available(o, b, x.config.FillRatio) < o.remaining(o.Price):
+		return append(executions, x.expire(o, now)), nil
+	default:
+		executions = append(executions, x.match(o, b, false, now)...)
+	}
+
+	if !o.isOpen() {
+		return executions, nil
+	}
+	if o.Type == orderTypeMarket || o.TimeInForce == timeInForceIOC || o.TimeInForce == timeInForceFOK {
+		return append(executions, x.expire(o, now)), nil
+	}
+	return executions, nil
+}
+
+func (x *Exchange) expire(o *order, now int64) execution {
+	o.Status = statusExpired
+	o.UpdateTime = now
+	return execution{Order: *o, ExecutionType: executionExpired, Time: now}
+}
+
+// find looks up an order by id, or client order id when id is 0, in the open
+// orders first and then in the order history.
+func (x *Exchange) find(symbol string, id int64, clientOrderID string) *order {
+	for _, orders := range [][]*order{x.orders, x.history} {
+		for _, o := range orders {
+			if o.Symbol != symbol {
+				continue
+			}
+			if (id != 0 && o.ID == id) || (id == 0 && clientOrderID != "" && o.ClientOrderID == clientOrderID) {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+func (x *Exchange) cancel(o *order) execution {
+	now := x.now()
+	o.Status = statusCanceled
+	o.UpdateTime = now
+	return execution{Order: *o, ExecutionType: executionCanceled, Time: now}
+}
+
+// openOrders returns the open orders of symbol, or of all symbols when empty.
+func (x *Exchange) openOrders(symbol string) (res []*order) {
+	for _, o := range x.orders {
+		if symbol == "" || o.Symbol == symbol {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+// prune moves closed orders to the history.
+func (x *Exchange) prune() {
+	open := x.orders[:0]
+	for _, o := range x.orders {
+		if o.isOpen() {
+			open = append(open, o)
+			continue
+		}
+		x.history = append(x.history, o)
+	}
+	for i := len(open); i < len(x.orders); i++ {
+		x.orders[i] = nil
+	}
+	x.orders = open
+	if over := len(x.history) - x.config.HistorySize; over > 0 {
+		x.history = append(x.history[:0], x.history[over:]...)
+	}
+}
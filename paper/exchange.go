@@ -0,0 +1,402 @@
+// Package paper provides a simulated execution venue which answers the spot
+// and futures order endpoints without sending real orders.
+//
+// An Exchange is plugged into a client through UpdateDoFunc, so the usual
+// CreateOrderService, CancelOrderService, GetOrderService and
+// ListOpenOrdersService calls keep working:
+//
+//	client := binance.NewClient(apiKey, secretKey, false)
+//	exchange := paper.NewSpotExchange(paper.Config{TakerFee: 0.001})
+//	exchange.Attach(client)
+//
+// Orders are filled against the book given to UpdateBook, which is usually fed
+// from a live or recorded depth stream. Every other request is passed through
+// to the real API, so market data services are not affected.
+package paper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/futures"
+)
+
+const defaultHistorySize = 10000
+
+var errWouldMatch = errors.New("order would immediately match and take")
+
+// Level is a price level of the simulated book.
+type Level struct {
+	Price    float64
+	Quantity float64
+}
+
+// LevelsFromPriceLevels converts the levels of a spot depth response or event.
+func LevelsFromPriceLevels(levels []common.PriceLevel) ([]Level, error) {
+	res := make([]Level, 0, len(levels))
+	for i := range levels {
+		price, quantity, err := levels[i].Parse()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Level{Price: price, Quantity: quantity})
+	}
+	return res, nil
+}
+
+// LevelsFromPriceLevelArrays converts the levels of a futures depth response or event.
+func LevelsFromPriceLevelArrays(levels []common.PriceLevelArray) ([]Level, error) {
+	res := make([]Level, 0, len(levels))
+	for _, level := range levels {
+		price, quantity, err := level.Parse()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Level{Price: price, Quantity: quantity})
+	}
+	return res, nil
+}
+
+// Config define the simulation parameters.
+type Config struct {
+	// Latency delays every order request before it is executed.
+	Latency time.Duration
+	// MakerFee and TakerFee are rates applied to the traded quote amount.
+	MakerFee float64
+	TakerFee float64
+	// CommissionAsset is reported with every fill, USDT by default.
+	CommissionAsset string
+	// FillRatio is the share of every book level an order can take, lower it
+	// to simulate partial fills caused by other participants. Defaults to 1.
+	FillRatio float64
+	// HistorySize is the number of closed orders kept for GetOrderService,
+	// 10000 by default.
+	HistorySize int
+	// Now returns the simulated time, time.Now by default. Set it to the
+	// recorded event time when replaying.
+	Now func() time.Time
+	// OnUserData receives the user data events, encoded like the real stream,
+	// in the order they happened. It is called without holding any lock.
+	OnUserData func(data []byte)
+}
+
+// venue translates the requests and events of one market.
+type venue interface {
+	// serves tells if the request is simulated.
+	serves(method, path string) bool
+	// handle serves a simulated request, the executions it causes are added to
+	// the pending events of x.
+	handle(x *Exchange, method, path string, params url.Values) (res interface{}, err error)
+	// event encodes an execution as a user data event.
+	event(e execution) ([]byte, error)
+}
+
+// Exchange is a simulated venue for one market.
+type Exchange struct {
+	config Config
+	venue  venue
+
+	lock    sync.Mutex
+	books   map[string]*book
+	orders  []*order
+	history []*order
+	orderID int64
+	tradeID int64
+	pending []execution
+	// flushing is set while a goroutine delivers the pending events.
+	flushing bool
+}
+
+func newExchange(config Config, v venue) *Exchange {
+	if config.CommissionAsset == "" {
+		config.CommissionAsset = "USDT"
+	}
+	if config.FillRatio <= 0 || config.FillRatio > 1 {
+		config.FillRatio = 1
+	}
+	if config.HistorySize <= 0 {
+		config.HistorySize = defaultHistorySize
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Exchange{config: config, venue: v, books: make(map[string]*book)}
+}
+
+func (x *Exchange) now() int64 {
+	return x.config.Now().UnixMilli()
+}
+
+// UpdateBook replaces the book of symbol, resting orders crossed by the new
+// book are filled as maker at their limit price.
+func (x *Exchange) UpdateBook(symbol string, bids, asks []Level) {
+	x.lock.Lock()
+	b := newBook(bids, asks)
+	x.books[symbol] = b
+	now := x.now()
+	for _, o := range x.orders {
+		if o.Symbol == symbol && o.isOpen() {
+			x.pending = append(x.pending, x.match(o, b, true, now)...)
+		}
+	}
+	x.prune()
+	x.lock.Unlock()
+	x.flush()
+}
+
+// UpdateBookTicker replaces the book of symbol with its best levels only.
+func (x *Exchange) UpdateBookTicker(symbol string, bestBid, bestAsk Level) {
+	x.UpdateBook(symbol, []Level{bestBid}, []Level{bestAsk})
+}
+
+// flush delivers the pending events outside of the lock. Only one goroutine
+// delivers at a time so the events keep their order, events added meanwhile,
+// including by the OnUserData callback itself, are delivered by that goroutine.
+func (x *Exchange) flush() {
+	x.lock.Lock()
+	if x.flushing {
+		x.lock.Unlock()
+		return
+	}
+	x.flushing = true
+	for len(x.pending) > 0 {
+		executions := x.pending
+		x.pending = nil
+		x.lock.Unlock()
+		x.deliver(executions)
+		x.lock.Lock()
+	}
+	x.flushing = false
+	x.lock.Unlock()
+}
+
+func (x *Exchange) deliver(executions []execution) {
+	if x.config.OnUserData == nil {
+		return
+	}
+	for _, e := range executions {
+		data, err := x.venue.event(e)
+		if err != nil {
+			continue
+		}
+		x.config.OnUserData(data)
+	}
+}
+
+// DoFunc returns a function for common.Client.UpdateDoFunc which serves the
+// simulated endpoints and passes the other requests to next.
+func (x *Exchange) DoFunc(next common.DoFunc) common.DoFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if !x.venue.serves(req.Method, req.URL.Path) {
+			return next(req)
+		}
+		params, err := requestParams(req)
+		if err != nil {
+			return nil, err
+		}
+		if err = sleep(req.Context(), x.config.Latency); err != nil {
+			return nil, err
+		}
+
+		x.lock.Lock()
+		res, err := x.venue.handle(x, req.Method, req.URL.Path, params)
+		x.prune()
+		x.lock.Unlock()
+		x.flush()
+		return response(res, err)
+	}
+}
+
+// doFuncGetter is implemented by the clients of common.NewClient, which
+// expose the function they send requests with.
+type doFuncGetter interface {
+	GetDoFunc() common.DoFunc
+}
+
+// Attach routes the order requests of c to the exchange, c is usually a
+// *binance.Client or *futures.Client. The other requests are sent with the
+// function c used before, so its HTTP client settings are kept, or with
+// http.DefaultClient when c does not expose it.
+func (x *Exchange) Attach(c common.Client) {
+	inner := c
+	switch v := c.(type) {
+	case *binance.Client:
+		inner = v.Client
+	case *futures.Client:
+		inner = v.Client
+	}
+	next := http.DefaultClient.Do
+	if g, ok := inner.(doFuncGetter); ok {
+		next = g.GetDoFunc()
+	}
+	c.UpdateDoFunc(x.DoFunc(next))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func requestParams(req *http.Request) (url.Values, error) {
+	params := req.URL.Query()
+	if req.Body == nil {
+		return params, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err = req.Body.Close(); err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range form {
+		params[k] = v
+	}
+	return params, nil
+}
+
+func response(res interface{}, err error) (*http.Response, error) {
+	status := http.StatusOK
+	if err != nil {
+		apiErr := new(common.APIError)
+		if !errors.As(err, &apiErr) {
+			return nil, err
+		}
+		status = http.StatusBadRequest
+		res = map[string]interface{}{"code": apiErr.Code, "msg": apiErr.Message}
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:     http.StatusText(status),
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(data)),
+	}, nil
+}
+
+func apiError(code int64, message string) error {
+	return &common.APIError{Code: code, Message: message}
+}
+
+func errMandatory(name string) error {
+	return apiError(-1102, "Mandatory parameter '"+name+"' was not sent, was empty/null, or malformed.")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOrder reads a new order request, types and timeInForces are the values
+// supported by the venue.
+func parseOrder(params url.Values, types, timeInForces []string) (o *order, err error) {
+	o = &order{
+		Symbol:        params.Get("symbol"),
+		ClientOrderID: params.Get("newClientOrderId"),
+		Side:          params.Get("side"),
+		Type:          params.Get("type"),
+		TimeInForce:   params.Get("timeInForce"),
+		PositionSide:  params.Get("positionSide"),
+		ReduceOnly:    params.Get("reduceOnly") == "true",
+	}
+	if o.Symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	if o.Side != sideBuy && o.Side != sideSell {
+		return nil, errMandatory("side")
+	}
+	if !contains(types, o.Type) {
+		return nil, apiError(-1116, "Invalid orderType.")
+	}
+	for name, v := range map[string]*float64{
+		"quantity":      &o.Quantity,
+		"quoteOrderQty": &o.QuoteQuantity,
+		"price":         &o.Price,
+		"stopPrice":     &o.StopPrice,
+	} {
+		if *v, err = parseFloat(params.Get(name)); err != nil || *v < 0 {
+			return nil, errMandatory(name)
+		}
+	}
+
+	switch o.Type {
+	case orderTypeMarket:
+		if o.Quantity == 0 && o.QuoteQuantity == 0 {
+			return nil, errMandatory("quantity")
+		}
+		if o.TimeInForce != "" {
+			return nil, apiError(-1106, "Parameter 'timeInForce' sent when not required.")
+		}
+	case orderTypeLimitMaker:
+		if o.Price == 0 {
+			return nil, errMandatory("price")
+		}
+	default:
+		if o.Price == 0 {
+			return nil, errMandatory("price")
+		}
+		if o.TimeInForce == "" {
+			return nil, errMandatory("timeInForce")
+		}
+	}
+	if o.Type != orderTypeMarket && o.QuoteQuantity > 0 {
+		return nil, apiError(-1106, "Parameter 'quoteOrderQty' sent when not required.")
+	}
+	if o.Type != orderTypeMarket && o.Quantity == 0 {
+		return nil, errMandatory("quantity")
+	}
+	if o.TimeInForce != "" && !contains(timeInForces, o.TimeInForce) {
+		return nil, apiError(-1115, "Invalid timeInForce.")
+	}
+	return o, nil
+}
+
+// lookup finds the order referenced by the orderId or origClientOrderId parameters.
+func (x *Exchange) lookup(params url.Values) (*order, error) {
+	symbol := params.Get("symbol")
+	if symbol == "" {
+		return nil, errMandatory("symbol")
+	}
+	var id int64
+	if v := params.Get("orderId"); v != "" {
+		var err error
+		if id, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, errMandatory("orderId")
+		}
+	}
+	clientOrderID := params.Get("origClientOrderId")
+	if id == 0 && clientOrderID == "" {
+		return nil, apiError(-1102, "Param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null!")
+	}
+	return x.find(symbol, id, clientOrderID), nil
+}
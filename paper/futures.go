@@ -0,0 +1,216 @@
+package paper
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/crypto-zero/go-binance/v2/futures"
+)
+
+var (
+	futuresOrderTypes   = []string{orderTypeLimit, orderTypeMarket}
+	futuresTimeInForces = []string{timeInForceGTC, timeInForceIOC, timeInForceFOK, timeInForceGTX}
+
+	futuresRoutes = map[string]bool{
+		"POST /fapi/v1/order":           true,
+		"GET /fapi/v1/order":            true,
+		"DELETE /fapi/v1/order":         true,
+		"GET /fapi/v1/openOrders":       true,
+		"DELETE /fapi/v1/allOpenOrders": true,
+	}
+)
+
+type futuresVenue struct{}
+
+// NewFuturesExchange creates a simulated USDⓈ-M futures exchange which serves
+// the create, get and cancel order endpoints, the open orders listing and
+// cancellation. Events are ORDER_TRADE_UPDATE user data events, GTX orders
+// crossing the book expire like the real API does. Positions and margin are not
+// simulated, so realized profit is always reported as zero.
+func NewFuturesExchange(config Config) *Exchange {
+	return newExchange(config, futuresVenue{})
+}
+
+func (futuresVenue) serves(method, path string) bool {
+	return futuresRoutes[method+" "+path]
+}
+
+func (futuresVenue) handle(x *Exchange, method, path string, params url.Values) (res interface{}, err error) {
+	switch method + " " + path {
+	case "POST /fapi/v1/order":
+		o, err := parseOrder(params, futuresOrderTypes, futuresTimeInForces)
+		if err != nil {
+			return nil, err
+		}
+		if o.PositionSide == "" {
+			o.PositionSide = string(futures.PositionSideTypeBoth)
+		}
+		executions, err := x.place(o, false)
+		if err != nil {
+			return nil, err
+		}
+		x.pending = append(x.pending, executions...)
+		return futuresCreateOrderResponse(o), nil
+	case "GET /fapi/v1/order":
+		o, err := x.lookup(params)
+		if err != nil {
+			return nil, err
+		}
+		if o == nil {
+			return nil, apiError(-2013, "Order does not exist.")
+		}
+		return futuresOrder(o), nil
+	case "DELETE /fapi/v1/order":
+		o, err := x.lookup(params)
+		if err != nil {
+			return nil, err
+		}
+		if o == nil || !o.isOpen() {
+			return nil, apiError(-2011, "Unknown order sent.")
+		}
+		x.pending = append(x.pending, x.cancel(o))
+		return futuresCancelOrderResponse(o), nil
+	case "GET /fapi/v1/openOrders":
+		orders := make([]*futures.Order, 0)
+		for _, o := range x.openOrders(params.Get("symbol")) {
+			orders = append(orders, futuresOrder(o))
+		}
+		return orders, nil
+	case "DELETE /fapi/v1/allOpenOrders":
+		symbol := params.Get("symbol")
+		if symbol == "" {
+			return nil, errMandatory("symbol")
+		}
+		for _, o := range x.openOrders(symbol) {
+			x.pending = append(x.pending, x.cancel(o))
+		}
+		return map[string]interface{}{
+			"code": 200,
+			"msg":  "The operation of cancel all open order is done.",
+		}, nil
+	}
+	return nil, apiError(-1000, "Unsupported request.")
+}
+
+func futuresCreateOrderResponse(o *order) *futures.CreateOrderResponse {
+	return &futures.CreateOrderResponse{
+		Symbol:           o.Symbol,
+		OrderID:          o.ID,
+		ClientOrderID:    o.ClientOrderID,
+		Price:            formatFloat(o.Price),
+		OrigQuantity:     formatFloat(o.Quantity),
+		ExecutedQuantity: formatFloat(o.Executed),
+		CumQuote:         formatFloat(o.CumQuote),
+		ReduceOnly:       o.ReduceOnly,
+		Status:           futures.OrderStatusType(o.Status),
+		StopPrice:        formatFloat(o.StopPrice),
+		TimeInForce:      futures.TimeInForceType(o.TimeInForce),
+		Type:             futures.OrderType(o.Type),
+		Side:             futures.SideType(o.Side),
+		UpdateTime:       o.UpdateTime,
+		WorkingType:      futures.WorkingTypeContractPrice,
+		AvgPrice:         formatFloat(o.avgPrice()),
+		PositionSide:     futures.PositionSideType(o.PositionSide),
+	}
+}
+
+func futuresOrder(o *order) *futures.Order {
+	return &futures.Order{
+		Symbol:           o.Symbol,
+		OrderID:          o.ID,
+		ClientOrderID:    o.ClientOrderID,
+		Price:            formatFloat(o.Price),
+		ReduceOnly:       o.ReduceOnly,
+		OrigQuantity:     formatFloat(o.Quantity),
+		ExecutedQuantity: formatFloat(o.Executed),
+		CumQuantity:      formatFloat(o.Executed),
+		CumQuote:         formatFloat(o.CumQuote),
+		Status:           futures.OrderStatusType(o.Status),
+		TimeInForce:      futures.TimeInForceType(o.TimeInForce),
+		Type:             futures.OrderType(o.Type),
+		Side:             futures.SideType(o.Side),
+		StopPrice:        formatFloat(o.StopPrice),
+		Time:             o.Time,
+		UpdateTime:       o.UpdateTime,
+		WorkingType:      futures.WorkingTypeContractPrice,
+		AvgPrice:         formatFloat(o.avgPrice()),
+		OrigType:         o.Type,
+		PositionSide:     futures.PositionSideType(o.PositionSide),
+	}
+}
+
+func futuresCancelOrderResponse(o *order) *futures.CancelOrderResponse {
+	return &futures.CancelOrderResponse{
+		ClientOrderID:    o.ClientOrderID,
+		CumQuantity:      formatFloat(o.Executed),
+		CumQuote:         formatFloat(o.CumQuote),
+		ExecutedQuantity: formatFloat(o.Executed),
+		OrderID:          o.ID,
+		OrigQuantity:     formatFloat(o.Quantity),
+		Price:            formatFloat(o.Price),
+		ReduceOnly:       o.ReduceOnly,
+		Side:             futures.SideType(o.Side),
+		Status:           futures.OrderStatusType(o.Status),
+		StopPrice:        formatFloat(o.StopPrice),
+		Symbol:           o.Symbol,
+		TimeInForce:      futures.TimeInForceType(o.TimeInForce),
+		Type:             futures.OrderType(o.Type),
+		UpdateTime:       o.UpdateTime,
+		WorkingType:      futures.WorkingTypeContractPrice,
+		OrigType:         o.Type,
+		PositionSide:     futures.PositionSideType(o.PositionSide),
+	}
+}
+
+// futuresOrderTradeUpdateEvent is the subset of futures.WsUserDataEvent sent
+// with ORDER_TRADE_UPDATE.
+type futuresOrderTradeUpdateEvent struct {
+	Event            futures.UserDataEventType  `json:"e"`
+	Time             int64                      `json:"E"`
+	TransactionTime  int64                      `json:"T"`
+	OrderTradeUpdate futures.WsOrderTradeUpdate `json:"o"`
+}
+
+func (futuresVenue) event(e execution) ([]byte, error) {
+	o := e.Order
+	update := futures.WsOrderTradeUpdate{
+		Symbol:               o.Symbol,
+		ClientOrderID:        o.ClientOrderID,
+		Side:                 futures.SideType(o.Side),
+		Type:                 futures.OrderType(o.Type),
+		TimeInForce:          futures.TimeInForceType(o.TimeInForce),
+		OriginalQty:          formatFloat(o.Quantity),
+		OriginalPrice:        formatFloat(o.Price),
+		AveragePrice:         formatFloat(o.avgPrice()),
+		StopPrice:            formatFloat(o.StopPrice),
+		ExecutionType:        futures.OrderExecutionType(e.ExecutionType),
+		Status:               futures.OrderStatusType(o.Status),
+		ID:                   o.ID,
+		LastFilledQty:        "0",
+		AccumulatedFilledQty: formatFloat(o.Executed),
+		LastFilledPrice:      "0",
+		Commission:           "0",
+		TradeTime:            e.Time,
+		BidsNotional:         "0",
+		AsksNotional:         "0",
+		IsReduceOnly:         o.ReduceOnly,
+		WorkingType:          futures.WorkingTypeContractPrice,
+		OriginalType:         futures.OrderType(o.Type),
+		PositionSide:         futures.PositionSideType(o.PositionSide),
+		RealizedPnL:          "0",
+	}
+	if e.Fill != nil {
+		update.LastFilledQty = formatFloat(e.Fill.Quantity)
+		update.LastFilledPrice = formatFloat(e.Fill.Price)
+		update.Commission = formatFloat(e.Fill.Commission)
+		update.CommissionAsset = e.Fill.Asset
+		update.TradeID = e.Fill.TradeID
+		update.IsMaker = e.Fill.Maker
+	}
+	return json.Marshal(&futuresOrderTradeUpdateEvent{
+		Event:            futures.UserDataEventTypeOrderTradeUpdate,
+		Time:             e.Time,
+		TransactionTime:  e.Time,
+		OrderTradeUpdate: update,
+	})
+}
@@ -0,0 +1,110 @@
+package paper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/futures"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type futuresExchangeTestSuite struct {
+	suite.Suite
+	client   *futures.Client
+	exchange *Exchange
+	events   []*futures.WsUserDataEvent
+}
+
+func TestFuturesExchange(t *testing.T) {
+	suite.Run(t, new(futuresExchangeTestSuite))
+}
+
+func (s *futuresExchangeTestSuite) SetupTest() {
+	s.events = nil
+	s.exchange = NewFuturesExchange(Config{
+		TakerFee: 0.0004,
+		Now:      func() time.Time { return time.UnixMilli(2000) },
+		OnUserData: func(data []byte) {
+			e := new(futures.WsUserDataEvent)
+			s.Require().NoError(json.Unmarshal(data, e))
+			s.events = append(s.events, e)
+		},
+	})
+	s.client = futures.NewClient("", "", false)
+	s.client.UpdateDoFunc(s.exchange.DoFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errPassthrough
+	}))
+	bids, err := LevelsFromPriceLevelArrays([]common.PriceLevelArray{{"19999", "5"}})
+	s.Require().NoError(err)
+	asks, err := LevelsFromPriceLevelArrays([]common.PriceLevelArray{{"20001", "1"}, {"20002", "5"}})
+	s.Require().NoError(err)
+	s.exchange.UpdateBook("BTCUSDT", bids, asks)
+}
+
+func (s *futuresExchangeTestSuite) TestMarketOrder() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("2").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeFilled, res.Status)
+	s.Equal("40003", res.CumQuote)
+	s.Equal("20001.5", res.AvgPrice)
+	s.Equal(futures.PositionSideTypeBoth, res.PositionSide)
+
+	s.Require().Len(s.events, 3)
+	for _, e := range s.events {
+		s.Equal(futures.UserDataEventTypeOrderTradeUpdate, e.Event)
+		s.Equal(int64(2000), e.Time)
+	}
+	update := s.events[2].OrderTradeUpdate
+	s.Equal(futures.OrderExecutionTypeTrade, update.ExecutionType)
+	s.Equal(futures.OrderStatusTypeFilled, update.Status)
+	s.Equal("20002", update.LastFilledPrice)
+	s.Equal("8.0008", update.Commission)
+	s.Equal("USDT", update.CommissionAsset)
+	s.Equal(res.OrderID, update.ID)
+}
+
+func (s *futuresExchangeTestSuite) TestPostOnlyExpires() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTX).
+		Quantity("1").Price("19999").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeExpired, res.Status)
+	s.Require().Len(s.events, 2)
+	s.Equal(futures.OrderExecutionTypeExpired, s.events[1].OrderTradeUpdate.ExecutionType)
+}
+
+func (s *futuresExchangeTestSuite) TestRestingOrderAndCancelAll() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTX).
+		PositionSide(futures.PositionSideTypeShort).Quantity("3").Price("20010").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypeNew, res.Status)
+
+	s.exchange.UpdateBookTicker("BTCUSDT", Level{Price: 20011, Quantity: 1}, Level{Price: 20012, Quantity: 1})
+	order, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(futures.OrderStatusTypePartiallyFilled, order.Status)
+	s.Equal("1", order.ExecutedQuantity)
+	s.Equal("20010", order.AvgPrice)
+	s.True(s.events[len(s.events)-1].OrderTradeUpdate.IsMaker)
+
+	orders, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	s.Require().NoError(err)
+	s.Len(orders, 1)
+
+	s.Require().NoError(s.client.NewCancelAllOpenOrdersService().Symbol("BTCUSDT").Do(context.Background()))
+	orders, err = s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	s.Require().NoError(err)
+	s.Empty(orders)
+	s.Equal(futures.OrderExecutionTypeCanceled, s.events[len(s.events)-1].OrderTradeUpdate.ExecutionType)
+
+	_, err = s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-2011), err.(*common.APIError).Code)
+}
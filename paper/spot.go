@@ -0,0 +1,215 @@
+package paper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+)
+
+var (
+	spotOrderTypes   = []string{orderTypeLimit, orderTypeMarket, orderTypeLimitMaker}
+	spotTimeInForces = []string{timeInForceGTC, timeInForceIOC, timeInForceFOK}
+
+	spotRoutes = map[string]bool{
+		"POST /api/v3/order":        true,
+		"POST /api/v3/order/test":   true,
+		"GET /api/v3/order":         true,
+		"DELETE /api/v3/order":      true,
+		"GET /api/v3/openOrders":    true,
+		"DELETE /api/v3/openOrders": true,
+	}
+)
+
+type spotVenue struct{}
+
+// NewSpotExchange creates a simulated spot exchange which serves the
+// create, test, get and cancel order endpoints, the open orders listing and
+// cancellation. Events are executionReport user data events, LIMIT_MAKER
+// orders crossing the book are rejected like the real API does.
+func NewSpotExchange(config Config) *Exchange {
+	return newExchange(config, spotVenue{})
+}
+
+func (spotVenue) serves(method, path string) bool {
+	return spotRoutes[method+" "+path]
+}
+
+func (spotVenue) handle(x *Exchange, method, path string, params url.Values) (res interface{}, err error) {
+	switch method + " " + path {
+	case "POST /api/v3/order/test":
+		if _, err = parseOrder(params, spotOrderTypes, spotTimeInForces); err != nil {
+			return nil, err
+		}
+		return struct{}{}, nil
+	case "POST /api/v3/order":
+		o, err := parseOrder(params, spotOrderTypes, spotTimeInForces)
+		if err != nil {
+			return nil, err
+		}
+		if o.Type == orderTypeLimitMaker {
+			// LIMIT_MAKER orders take no time in force, the API reports GTC.
+			o.TimeInForce = timeInForceGTC
+		}
+		executions, err := x.place(o, true)
+		if errors.Is(err, errWouldMatch) {
+			return nil, apiError(-2010, "Order would immediately match and take.")
+		}
+		if err != nil {
+			return nil, err
+		}
+		x.pending = append(x.pending, executions...)
+		return spotCreateOrderResponse(o, executions), nil
+	case "GET /api/v3/order":
+		o, err := x.lookup(params)
+		if err != nil {
+			return nil, err
+		}
+		if o == nil {
+			return nil, apiError(-2013, "Order does not exist.")
+		}
+		return spotOrder(o), nil
+	case "DELETE /api/v3/order":
+		o, err := x.lookup(params)
+		if err != nil {
+			return nil, err
+		}
+		if o == nil || !o.isOpen() {
+			return nil, apiError(-2011, "Unknown order sent.")
+		}
+		x.pending = append(x.pending, x.cancel(o))
+		return spotCancelOrderResponse(o), nil
+	case "GET /api/v3/openOrders":
+		orders := make([]*binance.Order, 0)
+		for _, o := range x.openOrders(params.Get("symbol")) {
+			orders = append(orders, spotOrder(o))
+		}
+		return orders, nil
+	case "DELETE /api/v3/openOrders":
+		symbol := params.Get("symbol")
+		if symbol == "" {
+			return nil, errMandatory("symbol")
+		}
+		orders := make([]*binance.CancelOrderResponse, 0)
+		for _, o := range x.openOrders(symbol) {
+			x.pending = append(x.pending, x.cancel(o))
+			orders = append(orders, spotCancelOrderResponse(o))
+		}
+		return orders, nil
+	}
+	return nil, apiError(-1000, "Unsupported request.")
+}
+
+func spotCreateOrderResponse(o *order, executions []execution) *binance.CreateOrderResponse {
+	res := &binance.CreateOrderResponse{
+		Symbol:                   o.Symbol,
+		OrderID:                  o.ID,
+		ClientOrderID:            o.ClientOrderID,
+		TransactTime:             o.Time,
+		Price:                    formatFloat(o.Price),
+		OrigQuantity:             formatFloat(o.Quantity),
+		ExecutedQuantity:         formatFloat(o.Executed),
+		CummulativeQuoteQuantity: formatFloat(o.CumQuote),
+		Status:                   binance.OrderStatusType(o.Status),
+		TimeInForce:              binance.TimeInForceType(o.TimeInForce),
+		Type:                     binance.OrderType(o.Type),
+		Side:                     binance.SideType(o.Side),
+		Fills:                    make([]*binance.Fill, 0),
+	}
+	for _, e := range executions {
+		if e.Fill == nil {
+			continue
+		}
+		res.Fills = append(res.Fills, &binance.Fill{
+			Price:           formatFloat(e.Fill.Price),
+			Quantity:        formatFloat(e.Fill.Quantity),
+			Commission:      formatFloat(e.Fill.Commission),
+			CommissionAsset: e.Fill.Asset,
+		})
+	}
+	return res
+}
+
+func spotOrder(o *order) *binance.Order {
+	return &binance.Order{
+		Symbol:                   o.Symbol,
+		OrderID:                  o.ID,
+		ClientOrderID:            o.ClientOrderID,
+		Price:                    formatFloat(o.Price),
+		OrigQuantity:             formatFloat(o.Quantity),
+		ExecutedQuantity:         formatFloat(o.Executed),
+		CummulativeQuoteQuantity: formatFloat(o.CumQuote),
+		Status:                   binance.OrderStatusType(o.Status),
+		TimeInForce:              binance.TimeInForceType(o.TimeInForce),
+		Type:                     binance.OrderType(o.Type),
+		Side:                     binance.SideType(o.Side),
+		StopPrice:                formatFloat(o.StopPrice),
+		IcebergQuantity:          "0",
+		Time:                     o.Time,
+		UpdateTime:               o.UpdateTime,
+		IsWorking:                true,
+	}
+}
+
+func spotCancelOrderResponse(o *order) *binance.CancelOrderResponse {
+	return &binance.CancelOrderResponse{
+		Symbol:                   o.Symbol,
+		OrigClientOrderID:        o.ClientOrderID,
+		OrderID:                  o.ID,
+		OrderListID:              -1,
+		ClientOrderID:            o.ClientOrderID,
+		TransactTime:             o.UpdateTime,
+		Price:                    formatFloat(o.Price),
+		OrigQuantity:             formatFloat(o.Quantity),
+		ExecutedQuantity:         formatFloat(o.Executed),
+		CummulativeQuoteQuantity: formatFloat(o.CumQuote),
+		Status:                   binance.OrderStatusType(o.Status),
+		TimeInForce:              binance.TimeInForceType(o.TimeInForce),
+		Type:                     binance.OrderType(o.Type),
+		Side:                     binance.SideType(o.Side),
+	}
+}
+
+func (spotVenue) event(e execution) ([]byte, error) {
+	o := e.Order
+	event := &binance.WsExecutionReportEvent{
+		Event:                "executionReport",
+		Time:                 e.Time,
+		Symbol:               o.Symbol,
+		ClientOrderID:        o.ClientOrderID,
+		Side:                 binance.SideType(o.Side),
+		Type:                 binance.OrderType(o.Type),
+		TimeInForce:          binance.TimeInForceType(o.TimeInForce),
+		OriginalQty:          formatFloat(o.Quantity),
+		OriginalPrice:        formatFloat(o.Price),
+		StopPrice:            formatFloat(o.StopPrice),
+		IcebergQty:           "0",
+		OrderListID:          -1,
+		ExecutionType:        binance.OrderExecutionType(e.ExecutionType),
+		Status:               binance.OrderStatusType(o.Status),
+		RejectReason:         "NONE",
+		ID:                   o.ID,
+		LastFilledQty:        "0",
+		AccumulatedFilledQty: formatFloat(o.Executed),
+		LastFilledPrice:      "0",
+		Commission:           "0",
+		TransactionTime:      e.Time,
+		TradeID:              -1,
+		IsInOrderBook:        o.isOpen(),
+		CreateTime:           o.Time,
+		AccumulatedQuoteQty:  formatFloat(o.CumQuote),
+		LastQuoteQty:         "0",
+		QuoteOrderQty:        formatFloat(o.QuoteQuantity),
+	}
+	if e.Fill != nil {
+		event.LastFilledQty = formatFloat(e.Fill.Quantity)
+		event.LastFilledPrice = formatFloat(e.Fill.Price)
+		event.LastQuoteQty = formatFloat(e.Fill.Quantity * e.Fill.Price)
+		event.Commission = formatFloat(e.Fill.Commission)
+		event.CommissionAsset = e.Fill.Asset
+		event.TradeID = e.Fill.TradeID
+		event.IsMaker = e.Fill.Maker
+	}
+	return json.Marshal(event)
+}
@@ -0,0 +1,228 @@
+package paper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var errPassthrough = errors.New("request passed through")
+
+type spotExchangeTestSuite struct {
+	suite.Suite
+	client   *binance.Client
+	exchange *Exchange
+	events   []*binance.WsExecutionReportEvent
+}
+
+func TestSpotExchange(t *testing.T) {
+	suite.Run(t, new(spotExchangeTestSuite))
+}
+
+func (s *spotExchangeTestSuite) SetupTest() {
+	s.events = nil
+	s.exchange = NewSpotExchange(Config{
+		MakerFee: 0.001,
+		TakerFee: 0.002,
+		Now:      func() time.Time { return time.UnixMilli(1000) },
+		OnUserData: func(data []byte) {
+			e := new(binance.WsExecutionReportEvent)
+			s.Require().NoError(json.Unmarshal(data, e))
+			s.events = append(s.events, e)
+		},
+	})
+	s.client = binance.NewClient("", "", false)
+	s.client.UpdateDoFunc(s.exchange.DoFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errPassthrough
+	}))
+	s.exchange.UpdateBook("BTCUSDT",
+		[]Level{{Price: 99, Quantity: 1}, {Price: 98, Quantity: 2}},
+		[]Level{{Price: 102, Quantity: 2}, {Price: 101, Quantity: 1}},
+	)
+}
+
+func (s *spotExchangeTestSuite) executionTypes() (res []binance.OrderExecutionType) {
+	for _, e := range s.events {
+		res = append(res, e.ExecutionType)
+	}
+	return res
+}
+
+func (s *spotExchangeTestSuite) TestMarketOrderAcrossLevels() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1.5").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, res.Status)
+	s.Equal("1.5", res.ExecutedQuantity)
+	s.Equal("152", res.CummulativeQuoteQuantity)
+	s.Require().Len(res.Fills, 2)
+	s.Equal("101", res.Fills[0].Price)
+	s.Equal("0.202", res.Fills[0].Commission)
+	s.Equal("USDT", res.Fills[0].CommissionAsset)
+	s.Equal("0.5", res.Fills[1].Quantity)
+
+	s.Equal([]binance.OrderExecutionType{
+		binance.OrderExecutionTypeNew, binance.OrderExecutionTypeTrade, binance.OrderExecutionTypeTrade,
+	}, s.executionTypes())
+	last := s.events[2]
+	s.Equal(binance.OrderStatusTypeFilled, last.Status)
+	s.Equal("102", last.LastFilledPrice)
+	s.Equal("1.5", last.AccumulatedFilledQty)
+	s.False(last.IsMaker)
+
+	// the consumed liquidity is not available until the next book update.
+	res, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("2").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeExpired, res.Status)
+	s.Equal("1.5", res.ExecutedQuantity)
+}
+
+func (s *spotExchangeTestSuite) TestMarketOrderQuoteQuantity() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).QuoteOrderQty("148").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeFilled, res.Status)
+	s.Equal("1.5", res.ExecutedQuantity)
+	s.Equal("148", res.CummulativeQuoteQuantity)
+}
+
+func (s *spotExchangeTestSuite) TestRestingOrderFillsAsMaker() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+		Quantity("2").Price("100").NewClientOrderID("resting").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeNew, res.Status)
+
+	orders, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	s.Equal(res.OrderID, orders[0].OrderID)
+
+	s.exchange.UpdateBookTicker("BTCUSDT", Level{Price: 98, Quantity: 1}, Level{Price: 99.5, Quantity: 1.5})
+	order, err := s.client.NewGetOrderService().Symbol("BTCUSDT").OrigClientOrderID("resting").
+		Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypePartiallyFilled, order.Status)
+	s.Equal("1.5", order.ExecutedQuantity)
+	s.Equal("150", order.CummulativeQuoteQuantity)
+
+	last := s.events[len(s.events)-1]
+	s.True(last.IsMaker)
+	s.True(last.IsInOrderBook)
+	s.Equal("0.15", last.Commission)
+
+	cancel, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).
+		Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, cancel.Status)
+	s.Equal(binance.OrderExecutionTypeCanceled, s.events[len(s.events)-1].ExecutionType)
+
+	orders, err = s.client.NewListOpenOrdersService().Do(context.Background())
+	s.Require().NoError(err)
+	s.Empty(orders)
+	order, err = s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(res.OrderID).Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeCanceled, order.Status)
+}
+
+func (s *spotExchangeTestSuite) TestTimeInForce() {
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeIOC).
+		Quantity("2").Price("101").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeExpired, res.Status)
+	s.Equal("1", res.ExecutedQuantity)
+
+	res, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeFOK).
+		Quantity("4").Price("98").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeExpired, res.Status)
+	s.Equal("0", res.ExecutedQuantity)
+	s.Empty(res.Fills)
+}
+
+func (s *spotExchangeTestSuite) TestLimitMakerRejected() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimitMaker).Quantity("1").Price("101").Do(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-2010), err.(*common.APIError).Code)
+	s.Empty(s.events)
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimitMaker).Quantity("1").Price("100").Do(context.Background())
+	s.Require().NoError(err)
+	s.Equal(binance.OrderStatusTypeNew, res.Status)
+	s.Equal(binance.TimeInForceTypeGTC, res.TimeInForce)
+}
+
+func (s *spotExchangeTestSuite) TestErrors() {
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeStopLoss).Quantity("1").Do(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-1116), err.(*common.APIError).Code)
+
+	err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).Quantity("1").Test(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-1102), err.(*common.APIError).Code)
+
+	_, err = s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(42).Do(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-2013), err.(*common.APIError).Code)
+
+	_, err = s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(42).Do(context.Background())
+	s.Require().Error(err)
+	s.Equal(int64(-2011), err.(*common.APIError).Code)
+
+	_, err = s.client.NewDepthService().Symbol("BTCUSDT").Do(context.Background())
+	s.ErrorIs(err, errPassthrough)
+}
+
+func (s *spotExchangeTestSuite) TestCancelOpenOrders() {
+	for _, price := range []string{"97", "96"} {
+		_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+			Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).
+			Quantity("1").Price(price).Do(context.Background())
+		s.Require().NoError(err)
+	}
+	res, err := s.client.NewCancelOpenOrdersService().Symbol("BTCUSDT").Do(context.Background())
+	s.Require().NoError(err)
+	s.Len(res.Orders, 2)
+	s.Empty(res.OCOOrders)
+}
+
+func (s *spotExchangeTestSuite) TestFillRatioAndLatency() {
+	s.exchange.config.FillRatio = 0.5
+	s.exchange.config.Latency = 20 * time.Millisecond
+	from := time.Now()
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("3").Do(context.Background())
+	s.Require().NoError(err)
+	s.GreaterOrEqual(time.Since(from), 20*time.Millisecond)
+	s.Equal("1.5", res.ExecutedQuantity)
+	s.Equal(binance.OrderStatusTypeExpired, res.Status)
+}
+
+func (s *spotExchangeTestSuite) TestAttachKeepsClientDoFunc() {
+	client := binance.NewClient("", "", false)
+	client.UpdateDoFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errPassthrough
+	})
+	s.exchange.Attach(client)
+
+	_, err := client.NewDepthService().Symbol("BTCUSDT").Do(context.Background())
+	s.ErrorIs(err, errPassthrough)
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1").Do(context.Background())
+	s.NoError(err)
+}
@@ -0,0 +1,88 @@
+package portfoliomargin
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetAccountService get the portfolio margin account info
+type GetAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetAccountService) Do(ctx context.Context, opts ...common.RequestOption) (res *Account, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/account")
+
+	res = new(Account)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Account define portfolio margin account info, the equities and margins
+// are valued in USD
+type Account struct {
+	UniMMR                   string            `json:"uniMMR"`
+	AccountEquity            string            `json:"accountEquity"`
+	ActualEquity             string            `json:"actualEquity"`
+	AccountInitialMargin     string            `json:"accountInitialMargin"`
+	AccountMaintMargin       string            `json:"accountMaintMargin"`
+	AccountStatus            AccountStatusType `json:"accountStatus"`
+	VirtualMaxWithdrawAmount string            `json:"virtualMaxWithdrawAmount"`
+	TotalAvailableBalance    string            `json:"totalAvailableBalance"`
+	TotalMarginOpenLoss      string            `json:"totalMarginOpenLoss"`
+	UpdateTime               int64             `json:"updateTime"`
+}
+
+// GetBalanceService get the balances of the portfolio margin account
+type GetBalanceService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset, only its balance is returned when set
+func (s *GetBalanceService) Asset(asset string) *GetBalanceService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *GetBalanceService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Balance, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/balance")
+	if s.asset != nil {
+		// the balance of a single asset is returned as an object
+		r.SetQuery("asset", *s.asset)
+		balance := new(Balance)
+		if err = s.c.CallAPI(ctx, r, balance, opts...); err != nil {
+			return nil, err
+		}
+		return []*Balance{balance}, nil
+	}
+
+	res = make([]*Balance, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Balance define the balance of an asset across the margin, UM and CM
+// accounts
+type Balance struct {
+	Asset               string `json:"asset"`
+	TotalWalletBalance  string `json:"totalWalletBalance"`
+	CrossMarginAsset    string `json:"crossMarginAsset"`
+	CrossMarginBorrowed string `json:"crossMarginBorrowed"`
+	CrossMarginFree     string `json:"crossMarginFree"`
+	CrossMarginInterest string `json:"crossMarginInterest"`
+	CrossMarginLocked   string `json:"crossMarginLocked"`
+	UMWalletBalance     string `json:"umWalletBalance"`
+	UMUnrealizedPNL     string `json:"umUnrealizedPNL"`
+	CMWalletBalance     string `json:"cmWalletBalance"`
+	CMUnrealizedPNL     string `json:"cmUnrealizedPNL"`
+	NegativeBalance     string `json:"negativeBalance"`
+	UpdateTime          int64  `json:"updateTime"`
+}
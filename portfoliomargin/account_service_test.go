@@ -0,0 +1,120 @@
+package portfoliomargin
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestAccountService(t *testing.T) {
+	suite.Run(t, new(accountServiceTestSuite))
+}
+
+func (s *accountServiceTestSuite) TestGetAccount() {
+	data := []byte(`{
+		"uniMMR": "5167.92171923",
+		"accountEquity": "122607.35137903",
+		"actualEquity": "73.47428058",
+		"accountInitialMargin": "23.72469206",
+		"accountMaintMargin": "23.72469206",
+		"accountStatus": "NORMAL",
+		"virtualMaxWithdrawAmount": "1627523.32459208",
+		"totalAvailableBalance": "",
+		"totalMarginOpenLoss": "",
+		"updateTime": 1657707212154
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&Account{
+		UniMMR:                   "5167.92171923",
+		AccountEquity:            "122607.35137903",
+		ActualEquity:             "73.47428058",
+		AccountInitialMargin:     "23.72469206",
+		AccountMaintMargin:       "23.72469206",
+		AccountStatus:            AccountStatusTypeNormal,
+		VirtualMaxWithdrawAmount: "1627523.32459208",
+		UpdateTime:               1657707212154,
+	}, res)
+}
+
+func (s *accountServiceTestSuite) TestGetBalance() {
+	data := []byte(`[
+		{
+			"asset": "USDT",
+			"totalWalletBalance": "122607.35137903",
+			"crossMarginAsset": "92.27530794",
+			"crossMarginBorrowed": "10.00000000",
+			"crossMarginFree": "100.00000000",
+			"crossMarginInterest": "0.72469206",
+			"crossMarginLocked": "3.00000000",
+			"umWalletBalance": "0.00000000",
+			"umUnrealizedPNL": "23.72469206",
+			"cmWalletBalance": "23.72469206",
+			"cmUnrealizedPNL": "",
+			"updateTime": 1617939110373,
+			"negativeBalance": "0"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetBalanceService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Balance{{
+		Asset:               "USDT",
+		TotalWalletBalance:  "122607.35137903",
+		CrossMarginAsset:    "92.27530794",
+		CrossMarginBorrowed: "10.00000000",
+		CrossMarginFree:     "100.00000000",
+		CrossMarginInterest: "0.72469206",
+		CrossMarginLocked:   "3.00000000",
+		UMWalletBalance:     "0.00000000",
+		UMUnrealizedPNL:     "23.72469206",
+		CMWalletBalance:     "23.72469206",
+		NegativeBalance:     "0",
+		UpdateTime:          1617939110373,
+	}}, res)
+}
+
+func (s *accountServiceTestSuite) TestGetBalanceOfAsset() {
+	data := []byte(`{
+		"asset": "BNB",
+		"totalWalletBalance": "1.00000000",
+		"crossMarginFree": "1.00000000",
+		"updateTime": 1617939110373,
+		"negativeBalance": "0"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("asset", "BNB")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetBalanceService().Asset("BNB").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*Balance{{
+		Asset:              "BNB",
+		TotalWalletBalance: "1.00000000",
+		CrossMarginFree:    "1.00000000",
+		NegativeBalance:    "0",
+		UpdateTime:         1617939110373,
+	}}, res)
+}
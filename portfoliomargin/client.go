@@ -0,0 +1,317 @@
+package portfoliomargin
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/bitly/go-simplejson"
+)
+
+// SideType define side type of order
+type SideType string
+
+// PositionSideType define the side of a position
+type PositionSideType string
+
+// OrderType define order type
+type OrderType string
+
+// TimeInForceType define time in force type of order
+type TimeInForceType string
+
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// OrderExecutionType define order execution type
+type OrderExecutionType string
+
+// SideEffectType define the borrowing and repaying of a margin order
+type SideEffectType string
+
+// SelfTradePreventionMode define what happens when an order would match an
+// order of the same account
+type SelfTradePreventionMode string
+
+// AccountStatusType define the risk status of a portfolio margin account
+type AccountStatusType string
+
+// BusinessUnitType define the futures market of a user data event
+type BusinessUnitType string
+
+// UserDataEventType define user data event type
+type UserDataEventType string
+
+// RepayFromType define the account a bankruptcy loan is repaid from
+type RepayFromType string
+
+// Endpoints
+const (
+	baseApiMainUrl = "https://papi.binance.com"
+)
+
+// Global enums
+const (
+	SideTypeBuy  SideType = "BUY"
+	SideTypeSell SideType = "SELL"
+
+	PositionSideTypeBoth  PositionSideType = "BOTH"
+	PositionSideTypeLong  PositionSideType = "LONG"
+	PositionSideTypeShort PositionSideType = "SHORT"
+
+	OrderTypeLimit           OrderType = "LIMIT"
+	OrderTypeMarket          OrderType = "MARKET"
+	OrderTypeStopLoss        OrderType = "STOP_LOSS"
+	OrderTypeStopLossLimit   OrderType = "STOP_LOSS_LIMIT"
+	OrderTypeTakeProfit      OrderType = "TAKE_PROFIT"
+	OrderTypeTakeProfitLimit OrderType = "TAKE_PROFIT_LIMIT"
+	OrderTypeLimitMaker      OrderType = "LIMIT_MAKER"
+
+	TimeInForceTypeGTC TimeInForceType = "GTC" // Good Till Cancel
+	TimeInForceTypeIOC TimeInForceType = "IOC" // Immediate or Cancel
+	TimeInForceTypeFOK TimeInForceType = "FOK" // Fill or Kill
+	TimeInForceTypeGTX TimeInForceType = "GTX" // Good Till Crossing (Post Only)
+	TimeInForceTypeGTD TimeInForceType = "GTD" // Good Till Date
+
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+	NewOrderRespTypeFULL   NewOrderRespType = "FULL" // margin orders only
+
+	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	OrderExecutionTypeNew        OrderExecutionType = "NEW"
+	OrderExecutionTypeCanceled   OrderExecutionType = "CANCELED"
+	OrderExecutionTypeCalculated OrderExecutionType = "CALCULATED"
+	OrderExecutionTypeExpired    OrderExecutionType = "EXPIRED"
+	OrderExecutionTypeTrade      OrderExecutionType = "TRADE"
+	OrderExecutionTypeAmendment  OrderExecutionType = "AMENDMENT"
+	OrderExecutionTypeRejected   OrderExecutionType = "REJECTED"
+
+	SideEffectTypeNoSideEffect    SideEffectType = "NO_SIDE_EFFECT"
+	SideEffectTypeMarginBuy       SideEffectType = "MARGIN_BUY"
+	SideEffectTypeAutoRepay       SideEffectType = "AUTO_REPAY"
+	SideEffectTypeAutoBorrowRepay SideEffectType = "AUTO_BORROW_REPAY"
+
+	SelfTradePreventionModeNone        SelfTradePreventionMode = "NONE"
+	SelfTradePreventionModeExpireTaker SelfTradePreventionMode = "EXPIRE_TAKER"
+	SelfTradePreventionModeExpireMaker SelfTradePreventionMode = "EXPIRE_MAKER"
+	SelfTradePreventionModeExpireBoth  SelfTradePreventionMode = "EXPIRE_BOTH"
+
+	AccountStatusTypeNormal            AccountStatusType = "NORMAL"
+	AccountStatusTypeMarginCall        AccountStatusType = "MARGIN_CALL"
+	AccountStatusTypeSupplyMargin      AccountStatusType = "SUPPLY_MARGIN"
+	AccountStatusTypeReduceOnly        AccountStatusType = "REDUCE_ONLY"
+	AccountStatusTypeActiveLiquidation AccountStatusType = "ACTIVE_LIQUIDATION"
+	AccountStatusTypeForceLiquidation  AccountStatusType = "FORCE_LIQUIDATION"
+	AccountStatusTypeBankrupted        AccountStatusType = "BANKRUPTED"
+
+	BusinessUnitTypeUM BusinessUnitType = "UM"
+	BusinessUnitTypeCM BusinessUnitType = "CM"
+
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
+	UserDataEventTypeOrderTradeUpdate        UserDataEventType = "ORDER_TRADE_UPDATE"
+	UserDataEventTypeAccountUpdate           UserDataEventType = "ACCOUNT_UPDATE"
+	UserDataEventTypeAccountConfigUpdate     UserDataEventType = "ACCOUNT_CONFIG_UPDATE"
+	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
+	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
+	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
+	UserDataEventTypeLiabilityChange         UserDataEventType = "liabilityChange"
+	UserDataEventTypeOpenOrderLoss           UserDataEventType = "openOrderLoss"
+	UserDataEventTypeRiskLevelChange         UserDataEventType = "riskLevelChange"
+
+	RepayFromTypeSpot   RepayFromType = "SPOT"
+	RepayFromTypeMargin RepayFromType = "MARGIN"
+
+	timestampKey  = "timestamp"
+	signatureKey  = "signature"
+	recvWindowKey = "recvWindow"
+)
+
+// the futures markets of the UM and CM order services, they are the first
+// segment of the endpoints after /papi/v1
+const (
+	futuresMarketUM = "um"
+	futuresMarketCM = "cm"
+)
+
+func newJSON(data []byte) (j *simplejson.Json, err error) {
+	j, err = simplejson.NewJson(data)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// NewClient initialize an API client instance with API key and secret key.
+// You should always call this function before using this SDK.
+// Services will be created by the form client.NewXXXService().
+// The portfolio margin API has no testnet.
+func NewClient(apiKey, secretKey string) *Client {
+	logger := common.NewDefaultLogger(common.LogInfo, log.New(os.Stderr,
+		"Binance-golang-portfoliomargin ", log.LstdFlags))
+	return &Client{
+		Client: common.NewClient(apiKey, secretKey, baseApiMainUrl,
+			"Binance/golang-portfoliomargin", http.DefaultClient, logger),
+	}
+}
+
+// Client define API client
+type Client struct {
+	common.Client
+}
+
+// NewPingService init ping service
+func (c *Client) NewPingService() *PingService {
+	return &PingService{c: c}
+}
+
+// NewGetAccountService init get account service
+func (c *Client) NewGetAccountService() *GetAccountService {
+	return &GetAccountService{c: c}
+}
+
+// NewGetBalanceService init get balance service
+func (c *Client) NewGetBalanceService() *GetBalanceService {
+	return &GetBalanceService{c: c}
+}
+
+// NewCreateUMOrderService init creating UM order service
+func (c *Client) NewCreateUMOrderService() *CreateFuturesOrderService {
+	return &CreateFuturesOrderService{c: c, market: futuresMarketUM}
+}
+
+// NewGetUMOrderService init get UM order service
+func (c *Client) NewGetUMOrderService() *GetFuturesOrderService {
+	return &GetFuturesOrderService{c: c, market: futuresMarketUM}
+}
+
+// NewCancelUMOrderService init cancel UM order service
+func (c *Client) NewCancelUMOrderService() *CancelFuturesOrderService {
+	return &CancelFuturesOrderService{c: c, market: futuresMarketUM}
+}
+
+// NewCancelAllUMOpenOrdersService init cancel all UM open orders service
+func (c *Client) NewCancelAllUMOpenOrdersService() *CancelAllFuturesOpenOrdersService {
+	return &CancelAllFuturesOpenOrdersService{c: c, market: futuresMarketUM}
+}
+
+// NewListUMOpenOrdersService init list UM open orders service
+func (c *Client) NewListUMOpenOrdersService() *ListFuturesOpenOrdersService {
+	return &ListFuturesOpenOrdersService{c: c, market: futuresMarketUM}
+}
+
+// NewListUMOrdersService init listing UM orders service
+func (c *Client) NewListUMOrdersService() *ListFuturesOrdersService {
+	return &ListFuturesOrdersService{c: c, market: futuresMarketUM}
+}
+
+// NewCreateCMOrderService init creating CM order service
+func (c *Client) NewCreateCMOrderService() *CreateFuturesOrderService {
+	return &CreateFuturesOrderService{c: c, market: futuresMarketCM}
+}
+
+// NewGetCMOrderService init get CM order service
+func (c *Client) NewGetCMOrderService() *GetFuturesOrderService {
+	return &GetFuturesOrderService{c: c, market: futuresMarketCM}
+}
+
+// NewCancelCMOrderService init cancel CM order service
+func (c *Client) NewCancelCMOrderService() *CancelFuturesOrderService {
+	return &CancelFuturesOrderService{c: c, market: futuresMarketCM}
+}
+
+// NewCancelAllCMOpenOrdersService init cancel all CM open orders service
+func (c *Client) NewCancelAllCMOpenOrdersService() *CancelAllFuturesOpenOrdersService {
+	return &CancelAllFuturesOpenOrdersService{c: c, market: futuresMarketCM}
+}
+
+// NewListCMOpenOrdersService init list CM open orders service
+func (c *Client) NewListCMOpenOrdersService() *ListFuturesOpenOrdersService {
+	return &ListFuturesOpenOrdersService{c: c, market: futuresMarketCM}
+}
+
+// NewListCMOrdersService init listing CM orders service
+func (c *Client) NewListCMOrdersService() *ListFuturesOrdersService {
+	return &ListFuturesOrdersService{c: c, market: futuresMarketCM}
+}
+
+// NewCreateMarginOrderService init creating margin order service
+func (c *Client) NewCreateMarginOrderService() *CreateMarginOrderService {
+	return &CreateMarginOrderService{c: c}
+}
+
+// NewGetMarginOrderService init get margin order service
+func (c *Client) NewGetMarginOrderService() *GetMarginOrderService {
+	return &GetMarginOrderService{c: c}
+}
+
+// NewCancelMarginOrderService init cancel margin order service
+func (c *Client) NewCancelMarginOrderService() *CancelMarginOrderService {
+	return &CancelMarginOrderService{c: c}
+}
+
+// NewCancelAllMarginOpenOrdersService init cancel all margin open orders service
+func (c *Client) NewCancelAllMarginOpenOrdersService() *CancelAllMarginOpenOrdersService {
+	return &CancelAllMarginOpenOrdersService{c: c}
+}
+
+// NewListMarginOpenOrdersService init list margin open orders service
+func (c *Client) NewListMarginOpenOrdersService() *ListMarginOpenOrdersService {
+	return &ListMarginOpenOrdersService{c: c}
+}
+
+// NewListMarginOrdersService init listing margin orders service
+func (c *Client) NewListMarginOrdersService() *ListMarginOrdersService {
+	return &ListMarginOrdersService{c: c}
+}
+
+// NewGetUMPositionRiskService init getting UM position risk service
+func (c *Client) NewGetUMPositionRiskService() *GetUMPositionRiskService {
+	return &GetUMPositionRiskService{c: c}
+}
+
+// NewGetCMPositionRiskService init getting CM position risk service
+func (c *Client) NewGetCMPositionRiskService() *GetCMPositionRiskService {
+	return &GetCMPositionRiskService{c: c}
+}
+
+// NewFundAutoCollectionService init fund auto-collection service
+func (c *Client) NewFundAutoCollectionService() *FundAutoCollectionService {
+	return &FundAutoCollectionService{c: c}
+}
+
+// NewRepayFuturesNegativeBalanceService init repay futures negative balance service
+func (c *Client) NewRepayFuturesNegativeBalanceService() *RepayFuturesNegativeBalanceService {
+	return &RepayFuturesNegativeBalanceService{c: c}
+}
+
+// NewRepayBankruptcyLoanService init repay bankruptcy loan service
+func (c *Client) NewRepayBankruptcyLoanService() *RepayBankruptcyLoanService {
+	return &RepayBankruptcyLoanService{c: c}
+}
+
+// NewStartUserStreamService init starting user stream service
+func (c *Client) NewStartUserStreamService() *StartUserStreamService {
+	return &StartUserStreamService{c: c}
+}
+
+// NewKeepaliveUserStreamService init keep alive user stream service
+func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
+	return &KeepaliveUserStreamService{c: c}
+}
+
+// NewCloseUserStreamService init closing user stream service
+func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
+	return &CloseUserStreamService{c: c}
+}
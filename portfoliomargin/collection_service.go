@@ -0,0 +1,76 @@
+package portfoliomargin
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// FundAutoCollectionService transfer the free funds of the UM and CM
+// accounts back to the margin account
+type FundAutoCollectionService struct {
+	c     *Client
+	asset *string
+}
+
+// Asset set asset, only the funds of this asset are collected when set
+func (s *FundAutoCollectionService) Asset(asset string) *FundAutoCollectionService {
+	s.asset = &asset
+	return s
+}
+
+// Do send request
+func (s *FundAutoCollectionService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	var r *common.Request
+	if s.asset != nil {
+		r = common.NewPostRequestSigned("/papi/v1/asset-collection")
+		r.SetForm("asset", *s.asset)
+	} else {
+		r = common.NewPostRequestSigned("/papi/v1/auto-collection")
+	}
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
+
+// RepayFuturesNegativeBalanceService repay the negative UM and CM balances
+// left by a bankruptcy with the margin account
+type RepayFuturesNegativeBalanceService struct {
+	c *Client
+}
+
+// Do send request
+func (s *RepayFuturesNegativeBalanceService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	r := common.NewPostRequestSigned("/papi/v1/repay-futures-negative-balance")
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
+
+// RepayBankruptcyLoanService repay the bankruptcy loan of the portfolio margin
+// account
+type RepayBankruptcyLoanService struct {
+	c    *Client
+	from *RepayFromType
+}
+
+// From set the account the loan is repaid from, default:SPOT
+func (s *RepayBankruptcyLoanService) From(from RepayFromType) *RepayBankruptcyLoanService {
+	s.from = &from
+	return s
+}
+
+// Do send request
+func (s *RepayBankruptcyLoanService) Do(ctx context.Context, opts ...common.RequestOption) (res *RepayBankruptcyLoanResponse, err error) {
+	r := common.NewPostRequestSigned("/papi/v1/repayLoan")
+	if s.from != nil {
+		r.SetForm("from", *s.from)
+	}
+
+	res = new(RepayBankruptcyLoanResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RepayBankruptcyLoanResponse define bankruptcy loan repay response
+type RepayBankruptcyLoanResponse struct {
+	TranID int64 `json:"tranId"`
+}
@@ -0,0 +1,70 @@
+package portfoliomargin
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type collectionServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCollectionService(t *testing.T) {
+	suite.Run(t, new(collectionServiceTestSuite))
+}
+
+func (s *collectionServiceTestSuite) TestFundAutoCollection() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewFundAutoCollectionService().Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *collectionServiceTestSuite) TestFundAutoCollectionOfAsset() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("asset", "USDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewFundAutoCollectionService().Asset("USDT").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *collectionServiceTestSuite) TestRepayFuturesNegativeBalance() {
+	data := []byte(`{"msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewRepayFuturesNegativeBalanceService().Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *collectionServiceTestSuite) TestRepayBankruptcyLoan() {
+	data := []byte(`{"tranId": 58203331886213504}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("from", RepayFromTypeMargin)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRepayBankruptcyLoanService().From(RepayFromTypeMargin).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(58203331886213504), res.TranID)
+}
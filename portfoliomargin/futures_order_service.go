@@ -0,0 +1,393 @@
+package portfoliomargin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// CreateFuturesOrderService create an UM or CM order, build it with
+// NewCreateUMOrderService or NewCreateCMOrderService
+type CreateFuturesOrderService struct {
+	c                       *Client
+	market                  string
+	symbol                  string
+	side                    SideType
+	positionSide            *PositionSideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	reduceOnly              *bool
+	price                   *string
+	newClientOrderID        *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionMode
+	goodTillDate            *int64
+}
+
+// Symbol set symbol
+func (s *CreateFuturesOrderService) Symbol(symbol string) *CreateFuturesOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateFuturesOrderService) Side(side SideType) *CreateFuturesOrderService {
+	s.side = side
+	return s
+}
+
+// PositionSide set positionSide
+func (s *CreateFuturesOrderService) PositionSide(positionSide PositionSideType) *CreateFuturesOrderService {
+	s.positionSide = &positionSide
+	return s
+}
+
+// Type set type, only LIMIT and MARKET are allowed
+func (s *CreateFuturesOrderService) Type(orderType OrderType) *CreateFuturesOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateFuturesOrderService) TimeInForce(timeInForce TimeInForceType) *CreateFuturesOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity, CM quantities are in contracts
+func (s *CreateFuturesOrderService) Quantity(quantity string) *CreateFuturesOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *CreateFuturesOrderService) ReduceOnly(reduceOnly bool) *CreateFuturesOrderService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Price set price
+func (s *CreateFuturesOrderService) Price(price string) *CreateFuturesOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateFuturesOrderService) NewClientOrderID(newClientOrderID string) *CreateFuturesOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateFuturesOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateFuturesOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode, UM only
+func (s *CreateFuturesOrderService) SelfTradePreventionMode(mode SelfTradePreventionMode) *CreateFuturesOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// GoodTillDate set goodTillDate of a GTD order, UM only
+func (s *CreateFuturesOrderService) GoodTillDate(goodTillDate int64) *CreateFuturesOrderService {
+	s.goodTillDate = &goodTillDate
+	return s
+}
+
+// Do send request
+func (s *CreateFuturesOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *FuturesOrder, err error) {
+	r := common.NewPostRequestSigned(fmt.Sprintf("/papi/v1/%s/order", s.market))
+	m := common.Params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.positionSide != nil {
+		m["positionSide"] = *s.positionSide
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.reduceOnly != nil {
+		m["reduceOnly"] = *s.reduceOnly
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.goodTillDate != nil {
+		m["goodTillDate"] = *s.goodTillDate
+	}
+	r.SetFormParams(m)
+
+	res = new(FuturesOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// FuturesOrder define UM or CM order info, CumQuote is only set for UM
+// orders, Pair and CumBase only for CM orders
+type FuturesOrder struct {
+	OrderID                 int64                   `json:"orderId"`
+	Symbol                  string                  `json:"symbol"`
+	Pair                    string                  `json:"pair"`
+	ClientOrderID           string                  `json:"clientOrderId"`
+	Price                   string                  `json:"price"`
+	AvgPrice                string                  `json:"avgPrice"`
+	OrigQuantity            string                  `json:"origQty"`
+	ExecutedQuantity        string                  `json:"executedQty"`
+	CumQuantity             string                  `json:"cumQty"`
+	CumQuote                string                  `json:"cumQuote"`
+	CumBase                 string                  `json:"cumBase"`
+	Status                  OrderStatusType         `json:"status"`
+	TimeInForce             TimeInForceType         `json:"timeInForce"`
+	Type                    OrderType               `json:"type"`
+	OrigType                OrderType               `json:"origType"`
+	Side                    SideType                `json:"side"`
+	PositionSide            PositionSideType        `json:"positionSide"`
+	ReduceOnly              bool                    `json:"reduceOnly"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	GoodTillDate            int64                   `json:"goodTillDate"`
+	Time                    int64                   `json:"time"`
+	UpdateTime              int64                   `json:"updateTime"`
+}
+
+// GetFuturesOrderService get an UM or CM order
+type GetFuturesOrderService struct {
+	c                 *Client
+	market            string
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetFuturesOrderService) Symbol(symbol string) *GetFuturesOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetFuturesOrderService) OrderID(orderID int64) *GetFuturesOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetFuturesOrderService) OrigClientOrderID(origClientOrderID string) *GetFuturesOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetFuturesOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *FuturesOrder, err error) {
+	r := common.NewGetRequestSigned(fmt.Sprintf("/papi/v1/%s/order", s.market))
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetQuery("origClientOrderId", *s.origClientOrderID)
+	}
+
+	res = new(FuturesOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelFuturesOrderService cancel an UM or CM order
+type CancelFuturesOrderService struct {
+	c                 *Client
+	market            string
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *CancelFuturesOrderService) Symbol(symbol string) *CancelFuturesOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelFuturesOrderService) OrderID(orderID int64) *CancelFuturesOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelFuturesOrderService) OrigClientOrderID(origClientOrderID string) *CancelFuturesOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelFuturesOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *FuturesOrder, err error) {
+	r := common.NewDeleteRequestSigned(fmt.Sprintf("/papi/v1/%s/order", s.market))
+	r.SetForm("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetForm("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetForm("origClientOrderId", *s.origClientOrderID)
+	}
+
+	res = new(FuturesOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllFuturesOpenOrdersService cancel all UM or CM open orders of a symbol
+type CancelAllFuturesOpenOrdersService struct {
+	c      *Client
+	market string
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllFuturesOpenOrdersService) Symbol(symbol string) *CancelAllFuturesOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelAllFuturesOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	r := common.NewDeleteRequestSigned(fmt.Sprintf("/papi/v1/%s/allOpenOrders", s.market))
+	r.SetForm("symbol", s.symbol)
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
+
+// ListFuturesOpenOrdersService list UM or CM opened orders
+type ListFuturesOpenOrdersService struct {
+	c      *Client
+	market string
+	symbol *string
+	pair   *string
+}
+
+// Symbol set symbol
+func (s *ListFuturesOpenOrdersService) Symbol(symbol string) *ListFuturesOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair, CM only
+func (s *ListFuturesOpenOrdersService) Pair(pair string) *ListFuturesOpenOrdersService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *ListFuturesOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*FuturesOrder, err error) {
+	r := common.NewGetRequestSigned(fmt.Sprintf("/papi/v1/%s/openOrders", s.market))
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.SetQuery("pair", *s.pair)
+	}
+
+	res = make([]*FuturesOrder, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListFuturesOrdersService list all UM or CM orders, UM orders are listed by
+// symbol, CM orders by symbol or pair
+type ListFuturesOrdersService struct {
+	c         *Client
+	market    string
+	symbol    *string
+	pair      *string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListFuturesOrdersService) Symbol(symbol string) *ListFuturesOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair, CM only
+func (s *ListFuturesOrdersService) Pair(pair string) *ListFuturesOrdersService {
+	s.pair = &pair
+	return s
+}
+
+// OrderID set the order id to list from
+func (s *ListFuturesOrdersService) OrderID(orderID int64) *ListFuturesOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListFuturesOrdersService) StartTime(startTime int64) *ListFuturesOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListFuturesOrdersService) EndTime(endTime int64) *ListFuturesOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default:500 max:1000
+func (s *ListFuturesOrdersService) Limit(limit int) *ListFuturesOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListFuturesOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*FuturesOrder, err error) {
+	r := common.NewGetRequestSigned(fmt.Sprintf("/papi/v1/%s/allOrders", s.market))
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.SetQuery("pair", *s.pair)
+	}
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*FuturesOrder, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,334 @@
+package portfoliomargin
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type futuresOrderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestFuturesOrderService(t *testing.T) {
+	suite.Run(t, new(futuresOrderServiceTestSuite))
+}
+
+func (s *futuresOrderServiceTestSuite) TestCreateUMOrder() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQty": "0",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 22542179,
+		"avgPrice": "0.00000",
+		"origQty": "10",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "SHORT",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTD",
+		"type": "LIMIT",
+		"selfTradePreventionMode": "NONE",
+		"goodTillDate": 1693207680000,
+		"updateTime": 1566818724722
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeBuy,
+			"positionSide":            PositionSideTypeShort,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTD,
+			"quantity":                "10",
+			"reduceOnly":              false,
+			"price":                   "10000",
+			"newClientOrderId":        "testOrder",
+			"newOrderRespType":        NewOrderRespTypeRESULT,
+			"selfTradePreventionMode": SelfTradePreventionModeNone,
+			"goodTillDate":            1693207680000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateUMOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		PositionSide(PositionSideTypeShort).Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTD).
+		Quantity("10").ReduceOnly(false).Price("10000").NewClientOrderID("testOrder").
+		NewOrderRespType(NewOrderRespTypeRESULT).SelfTradePreventionMode(SelfTradePreventionModeNone).
+		GoodTillDate(1693207680000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&FuturesOrder{
+		OrderID:                 22542179,
+		Symbol:                  "BTCUSDT",
+		ClientOrderID:           "testOrder",
+		Price:                   "0",
+		AvgPrice:                "0.00000",
+		OrigQuantity:            "10",
+		ExecutedQuantity:        "0",
+		CumQuantity:             "0",
+		CumQuote:                "0",
+		Status:                  OrderStatusTypeNew,
+		TimeInForce:             TimeInForceTypeGTD,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeBuy,
+		PositionSide:            PositionSideTypeShort,
+		SelfTradePreventionMode: SelfTradePreventionModeNone,
+		GoodTillDate:            1693207680000,
+		UpdateTime:              1566818724722,
+	}, res)
+}
+
+func (s *futuresOrderServiceTestSuite) TestCreateCMOrder() {
+	data := []byte(`{
+		"clientOrderId": "testOrder",
+		"cumQty": "0",
+		"cumBase": "0",
+		"executedQty": "0",
+		"orderId": 22542179,
+		"avgPrice": "0.0",
+		"origQty": "10",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "BOTH",
+		"status": "NEW",
+		"symbol": "BTCUSD_200925",
+		"pair": "BTCUSD",
+		"timeInForce": "GTC",
+		"type": "MARKET",
+		"updateTime": 1566818724722
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":   "BTCUSD_200925",
+			"side":     SideTypeBuy,
+			"type":     OrderTypeMarket,
+			"quantity": "10",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateCMOrderService().Symbol("BTCUSD_200925").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("10").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&FuturesOrder{
+		OrderID:          22542179,
+		Symbol:           "BTCUSD_200925",
+		Pair:             "BTCUSD",
+		ClientOrderID:    "testOrder",
+		Price:            "0",
+		AvgPrice:         "0.0",
+		OrigQuantity:     "10",
+		ExecutedQuantity: "0",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		Status:           OrderStatusTypeNew,
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeMarket,
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeBoth,
+		UpdateTime:       1566818724722,
+	}, res)
+}
+
+func (s *futuresOrderServiceTestSuite) TestGetUMOrder() {
+	data := []byte(`{
+		"avgPrice": "0.00000",
+		"clientOrderId": "abc",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 1917641,
+		"origQty": "0.40",
+		"origType": "LIMIT",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "SHORT",
+		"status": "NEW",
+		"symbol": "BTCUSDT",
+		"time": 1579276756075,
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"updateTime": 1579276756075,
+		"selfTradePreventionMode": "NONE",
+		"goodTillDate": 0
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":            "BTCUSDT",
+			"orderId":           1917641,
+			"origClientOrderId": "abc",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetUMOrderService().Symbol("BTCUSDT").OrderID(1917641).
+		OrigClientOrderID("abc").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&FuturesOrder{
+		OrderID:                 1917641,
+		Symbol:                  "BTCUSDT",
+		ClientOrderID:           "abc",
+		Price:                   "0",
+		AvgPrice:                "0.00000",
+		OrigQuantity:            "0.40",
+		ExecutedQuantity:        "0",
+		CumQuote:                "0",
+		Status:                  OrderStatusTypeNew,
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		OrigType:                OrderTypeLimit,
+		Side:                    SideTypeBuy,
+		PositionSide:            PositionSideTypeShort,
+		SelfTradePreventionMode: SelfTradePreventionModeNone,
+		Time:                    1579276756075,
+		UpdateTime:              1579276756075,
+	}, res)
+}
+
+func (s *futuresOrderServiceTestSuite) TestCancelUMOrder() {
+	data := []byte(`{
+		"avgPrice": "0.00000",
+		"clientOrderId": "myOrder1",
+		"cumQty": "0",
+		"cumQuote": "0",
+		"executedQty": "0",
+		"orderId": 4611875134427365377,
+		"origQty": "0.40",
+		"price": "0",
+		"reduceOnly": false,
+		"side": "BUY",
+		"positionSide": "SHORT",
+		"status": "CANCELED",
+		"symbol": "BTCUSDT",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"updateTime": 1571110484038
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":            "BTCUSDT",
+			"orderId":           4611875134427365377,
+			"origClientOrderId": "myOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelUMOrderService().Symbol("BTCUSDT").OrderID(4611875134427365377).
+		OrigClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(OrderStatusTypeCanceled, res.Status)
+	s.r().Equal(int64(4611875134427365377), res.OrderID)
+	s.r().Equal("myOrder1", res.ClientOrderID)
+}
+
+func (s *futuresOrderServiceTestSuite) TestCancelAllUMOpenOrders() {
+	data := []byte(`{
+		"code": 200,
+		"msg": "The operation of cancel all open order is done."
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewCancelAllUMOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *futuresOrderServiceTestSuite) TestListCMOpenOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.0",
+			"clientOrderId": "abc",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 1917641,
+			"origQty": "0.40",
+			"origType": "LIMIT",
+			"price": "0",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "SHORT",
+			"status": "NEW",
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"time": 1579276756075,
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1579276756075
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("pair", "BTCUSD")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListCMOpenOrdersService().Pair("BTCUSD").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("BTCUSD_200925", res[0].Symbol)
+	s.r().Equal("BTCUSD", res[0].Pair)
+	s.r().Equal("0", res[0].CumBase)
+	s.r().Equal(OrderStatusTypeNew, res[0].Status)
+}
+
+func (s *futuresOrderServiceTestSuite) TestListUMOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.00000",
+			"clientOrderId": "abc",
+			"cumQuote": "0",
+			"executedQty": "0",
+			"orderId": 1917641,
+			"origQty": "0.40",
+			"origType": "LIMIT",
+			"price": "0",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "SHORT",
+			"status": "NEW",
+			"symbol": "BTCUSDT",
+			"time": 1579276756075,
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1579276756075
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":    "BTCUSDT",
+			"orderId":   1917600,
+			"startTime": 1579276756000,
+			"endTime":   1579276757000,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListUMOrdersService().Symbol("BTCUSDT").OrderID(1917600).
+		StartTime(1579276756000).EndTime(1579276757000).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(1917641), res[0].OrderID)
+	s.r().Equal("BTCUSDT", res[0].Symbol)
+	s.r().Equal(OrderStatusTypeNew, res[0].Status)
+}
@@ -0,0 +1,410 @@
+package portfoliomargin
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// CreateMarginOrderService create a cross margin order
+type CreateMarginOrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	stopPrice               *string
+	newClientOrderID        *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	sideEffectType          *SideEffectType
+	timeInForce             *TimeInForceType
+	selfTradePreventionMode *SelfTradePreventionMode
+	autoRepayAtCancel       *bool
+}
+
+// Symbol set symbol
+func (s *CreateMarginOrderService) Symbol(symbol string) *CreateMarginOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateMarginOrderService) Side(side SideType) *CreateMarginOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateMarginOrderService) Type(orderType OrderType) *CreateMarginOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateMarginOrderService) TimeInForce(timeInForce TimeInForceType) *CreateMarginOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateMarginOrderService) Quantity(quantity string) *CreateMarginOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CreateMarginOrderService) QuoteOrderQty(quoteOrderQty string) *CreateMarginOrderService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CreateMarginOrderService) Price(price string) *CreateMarginOrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateMarginOrderService) NewClientOrderID(newClientOrderID string) *CreateMarginOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CreateMarginOrderService) StopPrice(stopPrice string) *CreateMarginOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CreateMarginOrderService) IcebergQuantity(icebergQuantity string) *CreateMarginOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateMarginOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateMarginOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SideEffectType set sideEffectType
+func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType) *CreateMarginOrderService {
+	s.sideEffectType = &sideEffectType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateMarginOrderService) SelfTradePreventionMode(mode SelfTradePreventionMode) *CreateMarginOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// AutoRepayAtCancel set whether the borrowed amount is repaid when an
+// AUTO_BORROW_REPAY or MARGIN_BUY order is canceled, default:true
+func (s *CreateMarginOrderService) AutoRepayAtCancel(autoRepayAtCancel bool) *CreateMarginOrderService {
+	s.autoRepayAtCancel = &autoRepayAtCancel
+	return s
+}
+
+// Do send request
+func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginOrder, err error) {
+	r := common.NewPostRequestSigned("/papi/v1/margin/order")
+	m := common.Params{
+		"symbol": s.symbol,
+		"side":   s.side,
+		"type":   s.orderType,
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.sideEffectType != nil {
+		m["sideEffectType"] = *s.sideEffectType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.autoRepayAtCancel != nil {
+		m["autoRepayAtCancel"] = *s.autoRepayAtCancel
+	}
+	r.SetFormParams(m)
+
+	res = new(MarginOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginOrder define margin order info. TransactTime, MarginBuyBorrowAmount,
+// MarginBuyBorrowAsset and Fills are only set when the order is created,
+// OrigClientOrderID only when it is canceled.
+type MarginOrder struct {
+	Symbol                   string                  `json:"symbol"`
+	OrderID                  int64                   `json:"orderId"`
+	ClientOrderID            string                  `json:"clientOrderId"`
+	OrigClientOrderID        string                  `json:"origClientOrderId"`
+	Price                    string                  `json:"price"`
+	OrigQuantity             string                  `json:"origQty"`
+	ExecutedQuantity         string                  `json:"executedQty"`
+	CummulativeQuoteQuantity string                  `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType         `json:"status"`
+	TimeInForce              TimeInForceType         `json:"timeInForce"`
+	Type                     OrderType               `json:"type"`
+	Side                     SideType                `json:"side"`
+	StopPrice                string                  `json:"stopPrice"`
+	IcebergQuantity          string                  `json:"icebergQty"`
+	SelfTradePreventionMode  SelfTradePreventionMode `json:"selfTradePreventionMode"`
+	IsWorking                bool                    `json:"isWorking"`
+	Time                     int64                   `json:"time"`
+	UpdateTime               int64                   `json:"updateTime"`
+	TransactTime             int64                   `json:"transactTime"`
+	MarginBuyBorrowAmount    string                  `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset     string                  `json:"marginBuyBorrowAsset"`
+	Fills                    []*Fill                 `json:"fills"`
+}
+
+// Fill may be returned in an array of fills in a MarginOrder
+type Fill struct {
+	TradeID         int64  `json:"tradeId"`
+	Price           string `json:"price"`
+	Quantity        string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+}
+
+// GetMarginOrderService get a margin order
+type GetMarginOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+}
+
+// Symbol set symbol
+func (s *GetMarginOrderService) Symbol(symbol string) *GetMarginOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetMarginOrderService) OrderID(orderID int64) *GetMarginOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetMarginOrderService) OrigClientOrderID(origClientOrderID string) *GetMarginOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetMarginOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginOrder, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/margin/order")
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetQuery("origClientOrderId", *s.origClientOrderID)
+	}
+
+	res = new(MarginOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelMarginOrderService cancel a margin order
+type CancelMarginOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Symbol set symbol
+func (s *CancelMarginOrderService) Symbol(symbol string) *CancelMarginOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *CancelMarginOrderService) OrderID(orderID int64) *CancelMarginOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *CancelMarginOrderService) OrigClientOrderID(origClientOrderID string) *CancelMarginOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID, the client order id of the cancel
+func (s *CancelMarginOrderService) NewClientOrderID(newClientOrderID string) *CancelMarginOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelMarginOrderService) Do(ctx context.Context, opts ...common.RequestOption) (res *MarginOrder, err error) {
+	r := common.NewDeleteRequestSigned("/papi/v1/margin/order")
+	r.SetForm("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetForm("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.SetForm("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.SetForm("newClientOrderId", *s.newClientOrderID)
+	}
+
+	res = new(MarginOrder)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelAllMarginOpenOrdersService cancel all margin open orders of a symbol
+type CancelAllMarginOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelAllMarginOpenOrdersService) Symbol(symbol string) *CancelAllMarginOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request, the orders of order lists are returned one by one
+func (s *CancelAllMarginOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginOrder, err error) {
+	r := common.NewDeleteRequestSigned("/papi/v1/margin/allOpenOrders")
+	r.SetForm("symbol", s.symbol)
+
+	res = make([]*MarginOrder, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginOpenOrdersService list margin opened orders
+type ListMarginOpenOrdersService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *ListMarginOpenOrdersService) Symbol(symbol string) *ListMarginOpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *ListMarginOpenOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginOrder, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/margin/openOrders")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*MarginOrder, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListMarginOrdersService list all margin orders of a symbol
+type ListMarginOrdersService struct {
+	c         *Client
+	symbol    string
+	orderID   *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Symbol set symbol
+func (s *ListMarginOrdersService) Symbol(symbol string) *ListMarginOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set the order id to list from
+func (s *ListMarginOrdersService) OrderID(orderID int64) *ListMarginOrdersService {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListMarginOrdersService) StartTime(startTime int64) *ListMarginOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListMarginOrdersService) EndTime(endTime int64) *ListMarginOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default:500 max:500
+func (s *ListMarginOrdersService) Limit(limit int) *ListMarginOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListMarginOrdersService) Do(ctx context.Context, opts ...common.RequestOption) (res []*MarginOrder, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/margin/allOrders")
+	r.SetQuery("symbol", s.symbol)
+	if s.orderID != nil {
+		r.SetQuery("orderId", *s.orderID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = make([]*MarginOrder, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,299 @@
+package portfoliomargin
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marginOrderServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarginOrderService(t *testing.T) {
+	suite.Run(t, new(marginOrderServiceTestSuite))
+}
+
+func (s *marginOrderServiceTestSuite) TestCreateOrder() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 28,
+		"clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+		"transactTime": 1507725176595,
+		"price": "1.00000000",
+		"origQty": "10.00000000",
+		"executedQty": "10.00000000",
+		"cummulativeQuoteQty": "10.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "MARKET",
+		"side": "SELL",
+		"marginBuyBorrowAmount": "5",
+		"marginBuyBorrowAsset": "BTC",
+		"fills": [
+			{
+				"price": "4000.00000000",
+				"qty": "1.00000000",
+				"commission": "4.00000000",
+				"commissionAsset": "USDT"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeMarket,
+			"quantity":                "10",
+			"newClientOrderId":        "6gCrw2kRUAF9CvJDGP16IP",
+			"newOrderRespType":        NewOrderRespTypeFULL,
+			"sideEffectType":          SideEffectTypeAutoBorrowRepay,
+			"selfTradePreventionMode": SelfTradePreventionModeExpireTaker,
+			"autoRepayAtCancel":       false,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateMarginOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("10").NewClientOrderID("6gCrw2kRUAF9CvJDGP16IP").
+		NewOrderRespType(NewOrderRespTypeFULL).SideEffectType(SideEffectTypeAutoBorrowRepay).
+		SelfTradePreventionMode(SelfTradePreventionModeExpireTaker).AutoRepayAtCancel(false).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginOrder{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  28,
+		ClientOrderID:            "6gCrw2kRUAF9CvJDGP16IP",
+		TransactTime:             1507725176595,
+		Price:                    "1.00000000",
+		OrigQuantity:             "10.00000000",
+		ExecutedQuantity:         "10.00000000",
+		CummulativeQuoteQuantity: "10.00000000",
+		Status:                   OrderStatusTypeFilled,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeMarket,
+		Side:                     SideTypeSell,
+		MarginBuyBorrowAmount:    "5",
+		MarginBuyBorrowAsset:     "BTC",
+		Fills: []*Fill{{
+			Price:           "4000.00000000",
+			Quantity:        "1.00000000",
+			Commission:      "4.00000000",
+			CommissionAsset: "USDT",
+		}},
+	}, res)
+}
+
+func (s *marginOrderServiceTestSuite) TestGetOrder() {
+	data := []byte(`{
+		"clientOrderId": "ZwfQzuDIGpceVhKW5DvCmO",
+		"cummulativeQuoteQty": "0.00000000",
+		"executedQty": "0.00000000",
+		"icebergQty": "0.00000000",
+		"isWorking": true,
+		"orderId": 213205622,
+		"origQty": "0.30000000",
+		"price": "0.00493630",
+		"side": "SELL",
+		"status": "NEW",
+		"stopPrice": "0.00000000",
+		"symbol": "BNBBTC",
+		"time": 1562133008725,
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"updateTime": 1562133008725,
+		"selfTradePreventionMode": "NONE"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":            "BNBBTC",
+			"orderId":           213205622,
+			"origClientOrderId": "ZwfQzuDIGpceVhKW5DvCmO",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetMarginOrderService().Symbol("BNBBTC").OrderID(213205622).
+		OrigClientOrderID("ZwfQzuDIGpceVhKW5DvCmO").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginOrder{
+		Symbol:                   "BNBBTC",
+		OrderID:                  213205622,
+		ClientOrderID:            "ZwfQzuDIGpceVhKW5DvCmO",
+		Price:                    "0.00493630",
+		OrigQuantity:             "0.30000000",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeNew,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+		StopPrice:                "0.00000000",
+		IcebergQuantity:          "0.00000000",
+		SelfTradePreventionMode:  SelfTradePreventionModeNone,
+		IsWorking:                true,
+		Time:                     1562133008725,
+		UpdateTime:               1562133008725,
+	}, res)
+}
+
+func (s *marginOrderServiceTestSuite) TestCancelOrder() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 28,
+		"origClientOrderId": "myOrder1",
+		"clientOrderId": "cancelMyOrder1",
+		"price": "1.00000000",
+		"origQty": "10.00000000",
+		"executedQty": "8.00000000",
+		"cummulativeQuoteQty": "8.00000000",
+		"status": "CANCELED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "SELL"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"symbol":            "LTCBTC",
+			"orderId":           28,
+			"origClientOrderId": "myOrder1",
+			"newClientOrderId":  "cancelMyOrder1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMarginOrderService().Symbol("LTCBTC").OrderID(28).
+		OrigClientOrderID("myOrder1").NewClientOrderID("cancelMyOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MarginOrder{
+		Symbol:                   "LTCBTC",
+		OrderID:                  28,
+		ClientOrderID:            "cancelMyOrder1",
+		OrigClientOrderID:        "myOrder1",
+		Price:                    "1.00000000",
+		OrigQuantity:             "10.00000000",
+		ExecutedQuantity:         "8.00000000",
+		CummulativeQuoteQuantity: "8.00000000",
+		Status:                   OrderStatusTypeCanceled,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+	}, res)
+}
+
+func (s *marginOrderServiceTestSuite) TestCancelAllOpenOrders() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+			"orderId": 11,
+			"clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+			"price": "0.089853",
+			"origQty": "0.178622",
+			"executedQty": "0.000000",
+			"cummulativeQuoteQty": "0.000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelAllMarginOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(11), res[0].OrderID)
+	s.r().Equal("E6APeyTJvkMvLMYMqu1KQ4", res[0].OrigClientOrderID)
+	s.r().Equal(OrderStatusTypeCanceled, res[0].Status)
+}
+
+func (s *marginOrderServiceTestSuite) TestListOpenOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "qhcZw71gAkCCTv0t0k8LUK",
+			"cummulativeQuoteQty": "0.00000000",
+			"executedQty": "0.00000000",
+			"icebergQty": "0.00000000",
+			"isWorking": true,
+			"orderId": 211842552,
+			"origQty": "0.30000000",
+			"price": "0.00475010",
+			"side": "SELL",
+			"status": "NEW",
+			"stopPrice": "0.00000000",
+			"symbol": "BNBBTC",
+			"time": 1562040170089,
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1562040170089
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("symbol", "BNBBTC")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginOpenOrdersService().Symbol("BNBBTC").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(211842552), res[0].OrderID)
+	s.r().True(res[0].IsWorking)
+}
+
+func (s *marginOrderServiceTestSuite) TestListOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "D2KDy4DIeS56PvkM13f8cP",
+			"cummulativeQuoteQty": "0.00000000",
+			"executedQty": "0.00000000",
+			"icebergQty": "0.00000000",
+			"isWorking": false,
+			"orderId": 41295,
+			"origQty": "5.31000000",
+			"price": "0.22500000",
+			"side": "SELL",
+			"status": "CANCELED",
+			"stopPrice": "0.18000000",
+			"symbol": "BNBBTC",
+			"time": 1565769338806,
+			"timeInForce": "GTC",
+			"type": "TAKE_PROFIT_LIMIT",
+			"updateTime": 1565769342148
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"symbol":    "BNBBTC",
+			"orderId":   41000,
+			"startTime": 1565769338000,
+			"endTime":   1565769343000,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListMarginOrdersService().Symbol("BNBBTC").OrderID(41000).
+		StartTime(1565769338000).EndTime(1565769343000).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(OrderTypeTakeProfitLimit, res[0].Type)
+	s.r().Equal("0.18000000", res[0].StopPrice)
+}
@@ -0,0 +1,99 @@
+package portfoliomargin
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetUMPositionRiskService get the UM positions
+type GetUMPositionRiskService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetUMPositionRiskService) Symbol(symbol string) *GetUMPositionRiskService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetUMPositionRiskService) Do(ctx context.Context, opts ...common.RequestOption) (res []*UMPositionRisk, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/um/positionRisk")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*UMPositionRisk, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UMPositionRisk define UM position risk info
+type UMPositionRisk struct {
+	Symbol           string           `json:"symbol"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	PositionAmt      string           `json:"positionAmt"`
+	EntryPrice       string           `json:"entryPrice"`
+	MarkPrice        string           `json:"markPrice"`
+	UnRealizedProfit string           `json:"unRealizedProfit"`
+	LiquidationPrice string           `json:"liquidationPrice"`
+	Leverage         string           `json:"leverage"`
+	MaxNotionalValue string           `json:"maxNotionalValue"`
+	Notional         string           `json:"notional"`
+	UpdateTime       int64            `json:"updateTime"`
+}
+
+// GetCMPositionRiskService get the CM positions
+type GetCMPositionRiskService struct {
+	c           *Client
+	marginAsset *string
+	pair        *string
+}
+
+// MarginAsset set marginAsset
+func (s *GetCMPositionRiskService) MarginAsset(marginAsset string) *GetCMPositionRiskService {
+	s.marginAsset = &marginAsset
+	return s
+}
+
+// Pair set pair, e.g. BTCUSD
+func (s *GetCMPositionRiskService) Pair(pair string) *GetCMPositionRiskService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *GetCMPositionRiskService) Do(ctx context.Context, opts ...common.RequestOption) (res []*CMPositionRisk, err error) {
+	r := common.NewGetRequestSigned("/papi/v1/cm/positionRisk")
+	if s.marginAsset != nil {
+		r.SetQuery("marginAsset", *s.marginAsset)
+	}
+	if s.pair != nil {
+		r.SetQuery("pair", *s.pair)
+	}
+
+	res = make([]*CMPositionRisk, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CMPositionRisk define CM position risk info, PositionAmt is in contracts
+type CMPositionRisk struct {
+	Symbol           string           `json:"symbol"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	PositionAmt      string           `json:"positionAmt"`
+	EntryPrice       string           `json:"entryPrice"`
+	MarkPrice        string           `json:"markPrice"`
+	UnRealizedProfit string           `json:"unRealizedProfit"`
+	LiquidationPrice string           `json:"liquidationPrice"`
+	Leverage         string           `json:"leverage"`
+	MaxQty           string           `json:"maxQty"`
+	NotionalValue    string           `json:"notionalValue"`
+	UpdateTime       int64            `json:"updateTime"`
+}
@@ -0,0 +1,100 @@
+package portfoliomargin
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type positionServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPositionService(t *testing.T) {
+	suite.Run(t, new(positionServiceTestSuite))
+}
+
+func (s *positionServiceTestSuite) TestGetUMPositionRisk() {
+	data := []byte(`[
+		{
+			"entryPrice": "0.00000",
+			"leverage": "10",
+			"markPrice": "6679.50671178",
+			"maxNotionalValue": "20000000",
+			"positionAmt": "0.000",
+			"notional": "0",
+			"symbol": "BTCUSDT",
+			"unRealizedProfit": "0.00000000",
+			"liquidationPrice": "6170.20509059",
+			"positionSide": "BOTH",
+			"updateTime": 1625474304765
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("symbol", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetUMPositionRiskService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*UMPositionRisk{{
+		Symbol:           "BTCUSDT",
+		PositionSide:     PositionSideTypeBoth,
+		PositionAmt:      "0.000",
+		EntryPrice:       "0.00000",
+		MarkPrice:        "6679.50671178",
+		UnRealizedProfit: "0.00000000",
+		LiquidationPrice: "6170.20509059",
+		Leverage:         "10",
+		MaxNotionalValue: "20000000",
+		Notional:         "0",
+		UpdateTime:       1625474304765,
+	}}, res)
+}
+
+func (s *positionServiceTestSuite) TestGetCMPositionRisk() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_201225",
+			"positionAmt": "1",
+			"entryPrice": "0.00000",
+			"markPrice": "0.00000000",
+			"unRealizedProfit": "0.00000000",
+			"liquidationPrice": "0",
+			"leverage": "125",
+			"positionSide": "LONG",
+			"updateTime": 1627026881327,
+			"maxQty": "50",
+			"notionalValue": "0.00016312"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"marginAsset": "BTC",
+			"pair":        "BTCUSD",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetCMPositionRiskService().MarginAsset("BTC").Pair("BTCUSD").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*CMPositionRisk{{
+		Symbol:           "BTCUSD_201225",
+		PositionSide:     PositionSideTypeLong,
+		PositionAmt:      "1",
+		EntryPrice:       "0.00000",
+		MarkPrice:        "0.00000000",
+		UnRealizedProfit: "0.00000000",
+		LiquidationPrice: "0",
+		Leverage:         "125",
+		MaxQty:           "50",
+		NotionalValue:    "0.00016312",
+		UpdateTime:       1627026881327,
+	}}, res)
+}
@@ -0,0 +1,18 @@
+package portfoliomargin
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// PingService ping server
+type PingService struct {
+	c *Client
+}
+
+// Do send request
+func (s *PingService) Do(ctx context.Context, opts ...common.RequestOption) (err error) {
+	r := common.NewGetRequestPublic("/papi/v1/ping")
+	return s.c.CallAPI(ctx, r, nil, opts...)
+}
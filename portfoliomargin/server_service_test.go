@@ -0,0 +1,61 @@
+package portfoliomargin
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type serverServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestServerService(t *testing.T) {
+	suite.Run(t, new(serverServiceTestSuite))
+}
+
+func (s *serverServiceTestSuite) TestPing() {
+	data := []byte(`{}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *common.Request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewPingService().Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *serverServiceTestSuite) TestPingError() {
+	s.mockDo([]byte("{}"), fmt.Errorf("dummy error"), http.StatusInternalServerError)
+	defer s.assertDo()
+
+	s.assertReq(func(r *common.Request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewPingService().Do(newContext())
+	s.r().Error(err)
+	s.r().Contains(err.Error(), "dummy error")
+}
+
+func (s *serverServiceTestSuite) TestPingBadRequest() {
+	s.mockDo([]byte(`{
+        "code": -1000,
+        "msg": "An unknown error occurred while processing the request."
+    }`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	s.assertReq(func(r *common.Request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewPingService().Do(newContext())
+	s.r().Error(err)
+	s.r().True(common.IsAPIError(err))
+}
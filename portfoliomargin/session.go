@@ -0,0 +1,70 @@
+package portfoliomargin
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// Session define a websocket session of the portfolio margin user data stream
+type Session struct {
+	common.WebsocketSession
+	handler SessionHandler
+}
+
+// SessionHandler handle the events of a Session
+type SessionHandler interface {
+	common.WebsocketSessionHandler
+	OnUserData(*WsUserDataEvent)
+}
+
+func (s *Session) registerHandler(handler SessionHandler) {
+	for _, event := range []UserDataEventType{
+		UserDataEventTypeListenKeyExpired,
+		UserDataEventTypeOrderTradeUpdate,
+		UserDataEventTypeAccountUpdate,
+		UserDataEventTypeAccountConfigUpdate,
+		UserDataEventTypeExecutionReport,
+		UserDataEventTypeOutboundAccountPosition,
+		UserDataEventTypeBalanceUpdate,
+		UserDataEventTypeLiabilityChange,
+		UserDataEventTypeOpenOrderLoss,
+		UserDataEventTypeRiskLevelChange,
+	} {
+		s.RegisterMessageHandler(
+			common.WebsocketSessionMessageFactoryBuild[WsUserDataEvent](),
+			common.WebsocketSessionMessageHandlerBuild(handler.OnUserData),
+			s.RequireMapKeyValue("e", string(event)),
+		)
+	}
+}
+
+func newMockSession(handler SessionHandler) (*Session, common.MockWebsocketSession) {
+	wss := common.NewMockWebsocketSession(handler)
+	session := new(Session)
+	session.WebsocketSession = wss
+	session.handler = handler
+	session.registerHandler(handler)
+	return session, wss
+}
+
+// NewSession connect a session of the user data stream of listenKey, get it
+// with NewStartUserStreamService
+func NewSession(ctx context.Context, listenKey string, proxyURL *url.URL,
+	handler SessionHandler,
+) (session *Session, err error) {
+	address := fmt.Sprintf("%s/%s", baseWsMainUrl, listenKey)
+
+	cli, err := common.DefaultWebsocketProvider(ctx, address, proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	session = new(Session)
+	session.WebsocketSession = common.NewWebsocketSession(cli, handler)
+	session.handler = handler
+	session.registerHandler(handler)
+	return session, nil
+}
@@ -0,0 +1,151 @@
+package portfoliomargin
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+type testSessionHandler struct {
+	*testing.T
+	done chan struct{}
+
+	events map[UserDataEventType]*WsUserDataEvent
+}
+
+func newTestSessionHandler(t *testing.T) *testSessionHandler {
+	return &testSessionHandler{
+		T:      t,
+		done:   make(chan struct{}),
+		events: make(map[UserDataEventType]*WsUserDataEvent),
+	}
+}
+
+func (t *testSessionHandler) OnUnknownMessage(bytes []byte, i interface{}) error {
+	t.Logf("got unknown message: %v\n", i)
+	return nil
+}
+
+func (t *testSessionHandler) OnClose(err error) {
+	t.Logf("got on close err: %v\n", err)
+}
+
+func (t *testSessionHandler) OnUserData(event *WsUserDataEvent) {
+	t.Logf("user data event: %#v\n", event)
+	t.events[event.Event] = event
+	if event.Event == UserDataEventTypeExecutionReport && t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+}
+
+func TestMockSession(t *testing.T) {
+	handler := newTestSessionHandler(t)
+	session, wss := newMockSession(handler)
+
+	messages := []string{
+		`{"e":"listenKeyExpired","E":1576653824250}`,
+		`{"e":"ORDER_TRADE_UPDATE","fs":"UM","E":1568879465651,"T":1568879465650,"i":"SfsR","o":{"s":"BTCUSDT","c":"TEST","S":"SELL","o":"MARKET","f":"GTC","q":"0.001","p":"0","ap":"0","sp":"0","x":"NEW","X":"NEW","i":8886774,"l":"0","z":"0","L":"0","N":"USDT","n":"0","T":1568879465650,"t":0,"b":"0","a":"9.91","m":false,"R":false,"ps":"LONG","rp":"0","st":"C_TAKE_PROFIT","si":12893,"V":"EXPIRE_TAKER","gtd":0}}`,
+		`{"e":"ACCOUNT_UPDATE","fs":"CM","E":1564745798939,"T":1564745798938,"i":"SfsR","a":{"m":"ORDER","B":[{"a":"BTC","wb":"122624.12345678","cw":"100.12345678","bc":"50.12345678"}],"P":[{"s":"BTCUSD_PERP","pa":"20","ep":"6563.6","cr":"0","up":"2850.21200","ps":"LONG","bep":"6563.6"}]}}`,
+		`{"e":"ACCOUNT_CONFIG_UPDATE","fs":"UM","E":1611646737479,"T":1611646737476,"ac":{"s":"BTCUSDT","l":25}}`,
+		`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","d":4,"F":"0.00000000","g":-1,"C":"","x":"NEW","X":"NEW","r":"NONE","i":4293153,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1,"v":3,"I":8641984,"w":true,"m":false,"M":false,"O":1499405658657,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000","W":1499405658657,"V":"NONE"}`,
+		`{"e":"outboundAccountPosition","E":1564034571105,"u":1564034571073,"U":1404,"B":[{"a":"ETH","f":"10000.000000","l":"0.000000"}]}`,
+		`{"e":"balanceUpdate","E":1573200697110,"a":"ABC","d":"100.00000000","U":1027053479517,"T":1573200697068}`,
+		`{"e":"liabilityChange","E":1573200697110,"a":"BTC","t":"BORROW","T":1352286576452864727,"p":"1.03453430","l":"1.03476851","i":"0.00023421"}`,
+		`{"e":"openOrderLoss","E":1678710578788,"O":[{"a":"BUSD","o":"-0.1232313"},{"a":"BNB","o":"-12.1232313"}]}`,
+		`{"e":"riskLevelChange","E":1587727187525,"u":"1.99999999","s":"MARGIN_CALL","eq":"30.23416728","ae":"30.23416728","m":"15.11708371"}`,
+	}
+
+	for _, msg := range messages {
+		if err := wss.MockProcessMessage([]byte(msg)); err != nil {
+			t.Fatal(err, msg)
+		}
+	}
+	t.Log(session)
+	if len(handler.events) != len(messages) {
+		t.Fatalf("handler got %d kinds of user events, want %d", len(handler.events), len(messages))
+	}
+
+	order := handler.events[UserDataEventTypeOrderTradeUpdate].OrderTradeUpdate
+	if order == nil || order.BusinessUnit != BusinessUnitTypeUM || order.Order.ID != 8886774 ||
+		order.Order.SelfTradePreventionMode != SelfTradePreventionModeExpireTaker {
+		t.Fatalf("bad order trade update: %#v", order)
+	}
+	account := handler.events[UserDataEventTypeAccountUpdate].AccountUpdate
+	if account == nil || account.BusinessUnit != BusinessUnitTypeCM || len(account.Update.Positions) != 1 ||
+		account.Update.Positions[0].BreakEvenPrice != "6563.6" {
+		t.Fatalf("bad account update: %#v", account)
+	}
+	config := handler.events[UserDataEventTypeAccountConfigUpdate].AccountConfigUpdate
+	if config == nil || config.AccountConfigUpdate.Leverage != 25 {
+		t.Fatalf("bad account config update: %#v", config)
+	}
+	report := handler.events[UserDataEventTypeExecutionReport].ExecutionReport
+	if report == nil || report.ID != 4293153 || report.PreventedMatchID != 3 ||
+		report.SelfTradePreventionMode != SelfTradePreventionModeNone || !report.IsInOrderBook {
+		t.Fatalf("bad execution report: %#v", report)
+	}
+	position := handler.events[UserDataEventTypeOutboundAccountPosition].AccountPosition
+	if position == nil || position.LastUpdateTime != 1564034571073 || position.UpdateID != 1404 {
+		t.Fatalf("bad outbound account position: %#v", position)
+	}
+	balance := handler.events[UserDataEventTypeBalanceUpdate].BalanceUpdate
+	if balance == nil || balance.Delta != "100.00000000" {
+		t.Fatalf("bad balance update: %#v", balance)
+	}
+	liability := handler.events[UserDataEventTypeLiabilityChange].LiabilityChange
+	if liability == nil || liability.Type != "BORROW" || liability.TransactionID != 1352286576452864727 {
+		t.Fatalf("bad liability change: %#v", liability)
+	}
+	loss := handler.events[UserDataEventTypeOpenOrderLoss].OpenOrderLoss
+	if loss == nil || len(loss.Losses) != 2 || loss.Losses[1].Amount != "-12.1232313" {
+		t.Fatalf("bad open order loss: %#v", loss)
+	}
+	risk := handler.events[UserDataEventTypeRiskLevelChange].RiskLevelChange
+	if risk == nil || risk.Status != AccountStatusTypeMarginCall || risk.UniMMR != "1.99999999" {
+		t.Fatalf("bad risk level change: %#v", risk)
+	}
+}
+
+func TestUserData(t *testing.T) {
+	key, secret := os.Getenv("TEST_SESSION_KEY"), os.Getenv("TEST_SESSION_SECRET")
+	if key == "" || secret == "" {
+		t.Skip("skip test user data")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	handler := newTestSessionHandler(t)
+
+	c := NewClient(key, secret)
+	listenKey, err := c.NewStartUserStreamService().Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := NewSession(ctx, listenKey, nil, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loopC := session.RunLoop()
+
+	// a far away limit order, canceled at once
+	reply, err := c.NewCreateMarginOrderService().Symbol("BNBUSDT").Side(SideTypeBuy).Price("10").
+		Quantity("1").Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(reply.OrderID)
+
+	if _, err = c.NewCancelMarginOrderService().Symbol("BNBUSDT").OrderID(reply.OrderID).Do(ctx); err != nil {
+		t.Log(err)
+	}
+
+	if handler.done != nil {
+		<-handler.done
+	}
+	cancel()
+	<-loopC
+}
@@ -0,0 +1,270 @@
+package portfoliomargin
+
+import (
+	"encoding/json"
+)
+
+// Endpoints
+const (
+	baseWsMainUrl = "wss://fstream.binance.com/pm/ws"
+)
+
+// WsUserDataEvent define user data stream event of the margin, UM and CM
+// accounts, only the field matching Event is set
+type WsUserDataEvent struct {
+	Event               UserDataEventType
+	Time                int64
+	OrderTradeUpdate    *WsOrderTradeUpdateEvent
+	AccountUpdate       *WsAccountUpdateEvent
+	AccountConfigUpdate *WsAccountConfigUpdateEvent
+	ExecutionReport     *WsExecutionReportEvent
+	AccountPosition     *WsOutboundAccountPositionEvent
+	BalanceUpdate       *WsBalanceUpdateEvent
+	LiabilityChange     *WsLiabilityChangeEvent
+	OpenOrderLoss       *WsOpenOrderLossEvent
+	RiskLevelChange     *WsRiskLevelChangeEvent
+}
+
+// UnmarshalJSON decodes the event matching the "e" field, other events only set Event and Time
+func (e *WsUserDataEvent) UnmarshalJSON(data []byte) error {
+	var header struct {
+		Event UserDataEventType `json:"e"`
+		Time  int64             `json:"E"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	*e = WsUserDataEvent{Event: header.Event, Time: header.Time}
+	switch header.Event {
+	case UserDataEventTypeOrderTradeUpdate:
+		e.OrderTradeUpdate = new(WsOrderTradeUpdateEvent)
+		return json.Unmarshal(data, e.OrderTradeUpdate)
+	case UserDataEventTypeAccountUpdate:
+		e.AccountUpdate = new(WsAccountUpdateEvent)
+		return json.Unmarshal(data, e.AccountUpdate)
+	case UserDataEventTypeAccountConfigUpdate:
+		e.AccountConfigUpdate = new(WsAccountConfigUpdateEvent)
+		return json.Unmarshal(data, e.AccountConfigUpdate)
+	case UserDataEventTypeExecutionReport:
+		e.ExecutionReport = new(WsExecutionReportEvent)
+		return json.Unmarshal(data, e.ExecutionReport)
+	case UserDataEventTypeOutboundAccountPosition:
+		e.AccountPosition = new(WsOutboundAccountPositionEvent)
+		return json.Unmarshal(data, e.AccountPosition)
+	case UserDataEventTypeBalanceUpdate:
+		e.BalanceUpdate = new(WsBalanceUpdateEvent)
+		return json.Unmarshal(data, e.BalanceUpdate)
+	case UserDataEventTypeLiabilityChange:
+		e.LiabilityChange = new(WsLiabilityChangeEvent)
+		return json.Unmarshal(data, e.LiabilityChange)
+	case UserDataEventTypeOpenOrderLoss:
+		e.OpenOrderLoss = new(WsOpenOrderLossEvent)
+		return json.Unmarshal(data, e.OpenOrderLoss)
+	case UserDataEventTypeRiskLevelChange:
+		e.RiskLevelChange = new(WsRiskLevelChangeEvent)
+		return json.Unmarshal(data, e.RiskLevelChange)
+	}
+	return nil
+}
+
+// WsOrderTradeUpdateEvent define ORDER_TRADE_UPDATE event of an UM or CM order
+type WsOrderTradeUpdateEvent struct {
+	Event           string             `json:"e"`
+	Time            int64              `json:"E"`
+	TransactionTime int64              `json:"T"`
+	BusinessUnit    BusinessUnitType   `json:"fs"`
+	AccountAlias    string             `json:"i"`
+	Order           WsOrderTradeUpdate `json:"o"`
+}
+
+// WsOrderTradeUpdate define order trade update
+type WsOrderTradeUpdate struct {
+	Symbol                  string                  `json:"s"`
+	ClientOrderID           string                  `json:"c"`
+	Side                    SideType                `json:"S"`
+	Type                    OrderType               `json:"o"`
+	TimeInForce             TimeInForceType         `json:"f"`
+	OriginalQty             string                  `json:"q"`
+	OriginalPrice           string                  `json:"p"`
+	AveragePrice            string                  `json:"ap"`
+	StopPrice               string                  `json:"sp"`
+	ExecutionType           OrderExecutionType      `json:"x"`
+	Status                  OrderStatusType         `json:"X"`
+	ID                      int64                   `json:"i"`
+	LastFilledQty           string                  `json:"l"`
+	AccumulatedFilledQty    string                  `json:"z"`
+	LastFilledPrice         string                  `json:"L"`
+	CommissionAsset         string                  `json:"N"`
+	Commission              string                  `json:"n"`
+	TradeTime               int64                   `json:"T"`
+	TradeID                 int64                   `json:"t"`
+	BidsNotional            string                  `json:"b"`
+	AsksNotional            string                  `json:"a"`
+	IsMaker                 bool                    `json:"m"`
+	IsReduceOnly            bool                    `json:"R"`
+	PositionSide            PositionSideType        `json:"ps"`
+	RealizedPnL             string                  `json:"rp"`
+	StrategyType            string                  `json:"st"`
+	StrategyID              int64                   `json:"si"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"V"`
+	GoodTillDate            int64                   `json:"gtd"`
+}
+
+// WsAccountUpdateEvent define ACCOUNT_UPDATE event of the UM or CM account
+type WsAccountUpdateEvent struct {
+	Event           string           `json:"e"`
+	Time            int64            `json:"E"`
+	TransactionTime int64            `json:"T"`
+	BusinessUnit    BusinessUnitType `json:"fs"`
+	AccountAlias    string           `json:"i"`
+	Update          WsAccountUpdate  `json:"a"`
+}
+
+// WsAccountUpdate define account update
+type WsAccountUpdate struct {
+	Reason    string       `json:"m"`
+	Balances  []WsBalance  `json:"B"`
+	Positions []WsPosition `json:"P"`
+}
+
+// WsBalance define balance
+type WsBalance struct {
+	Asset              string `json:"a"`
+	Balance            string `json:"wb"`
+	CrossWalletBalance string `json:"cw"`
+	BalanceChange      string `json:"bc"`
+}
+
+// WsPosition define position
+type WsPosition struct {
+	Symbol              string           `json:"s"`
+	Side                PositionSideType `json:"ps"`
+	Amount              string           `json:"pa"`
+	EntryPrice          string           `json:"ep"`
+	BreakEvenPrice      string           `json:"bep"`
+	UnrealizedPnL       string           `json:"up"`
+	AccumulatedRealized string           `json:"cr"`
+}
+
+// WsAccountConfigUpdateEvent define ACCOUNT_CONFIG_UPDATE event, pushed when
+// the leverage of an UM or CM symbol changes
+type WsAccountConfigUpdateEvent struct {
+	Event               string                `json:"e"`
+	Time                int64                 `json:"E"`
+	TransactionTime     int64                 `json:"T"`
+	BusinessUnit        BusinessUnitType      `json:"fs"`
+	AccountConfigUpdate WsAccountConfigUpdate `json:"ac"`
+}
+
+// WsAccountConfigUpdate define account config update
+type WsAccountConfigUpdate struct {
+	Symbol   string `json:"s"`
+	Leverage int64  `json:"l"`
+}
+
+// WsExecutionReportEvent define executionReport event, pushed on every margin
+// order update
+type WsExecutionReportEvent struct {
+	Event                   string                  `json:"e"`
+	Time                    int64                   `json:"E"`
+	Symbol                  string                  `json:"s"`
+	ClientOrderID           string                  `json:"c"`
+	Side                    SideType                `json:"S"`
+	Type                    OrderType               `json:"o"`
+	TimeInForce             TimeInForceType         `json:"f"`
+	OriginalQty             string                  `json:"q"`
+	OriginalPrice           string                  `json:"p"`
+	StopPrice               string                  `json:"P"`
+	IcebergQty              string                  `json:"F"`
+	OrderListID             int64                   `json:"g"`
+	OrigClientOrderID       string                  `json:"C"`
+	ExecutionType           OrderExecutionType      `json:"x"`
+	Status                  OrderStatusType         `json:"X"`
+	RejectReason            string                  `json:"r"`
+	ID                      int64                   `json:"i"`
+	LastFilledQty           string                  `json:"l"`
+	AccumulatedFilledQty    string                  `json:"z"`
+	LastFilledPrice         string                  `json:"L"`
+	Commission              string                  `json:"n"`
+	CommissionAsset         string                  `json:"N"`
+	TransactionTime         int64                   `json:"T"`
+	TradeID                 int64                   `json:"t"`
+	PreventedMatchID        int64                   `json:"v"`
+	Ignore                  int64                   `json:"I"` // add this field to avoid case insensitive unmarshaling
+	IsInOrderBook           bool                    `json:"w"`
+	IsMaker                 bool                    `json:"m"`
+	Placeholder             bool                    `json:"M"` // add this field to avoid case insensitive unmarshaling
+	CreateTime              int64                   `json:"O"`
+	AccumulatedQuoteQty     string                  `json:"Z"`
+	LastQuoteQty            string                  `json:"Y"`
+	QuoteOrderQty           string                  `json:"Q"`
+	WorkingTime             int64                   `json:"W"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"V"`
+}
+
+// WsOutboundAccountPositionEvent define outboundAccountPosition event, pushed
+// with the margin balances changed by an account update
+type WsOutboundAccountPositionEvent struct {
+	Event          string             `json:"e"`
+	Time           int64              `json:"E"`
+	LastUpdateTime int64              `json:"u"`
+	UpdateID       int64              `json:"U"`
+	Balances       []WsAccountBalance `json:"B"`
+}
+
+// WsAccountBalance define balance of outboundAccountPosition event
+type WsAccountBalance struct {
+	Asset  string `json:"a"`
+	Free   string `json:"f"`
+	Locked string `json:"l"`
+}
+
+// WsBalanceUpdateEvent define balanceUpdate event, pushed on the deposits,
+// withdrawals and transfers of the margin account
+type WsBalanceUpdateEvent struct {
+	Event     string `json:"e"`
+	Time      int64  `json:"E"`
+	Asset     string `json:"a"`
+	Delta     string `json:"d"`
+	UpdateID  int64  `json:"U"`
+	ClearTime int64  `json:"T"`
+}
+
+// WsLiabilityChangeEvent define liabilityChange event, pushed when a margin
+// liability is borrowed or repaid, or accrues interest
+type WsLiabilityChangeEvent struct {
+	Event         string `json:"e"`
+	Time          int64  `json:"E"`
+	Asset         string `json:"a"`
+	Type          string `json:"t"`
+	TransactionID int64  `json:"T"`
+	Principal     string `json:"p"`
+	Liability     string `json:"l"`
+	Interest      string `json:"i"`
+}
+
+// WsOpenOrderLossEvent define openOrderLoss event, pushed with the margin
+// losses of the open orders whenever they change
+type WsOpenOrderLossEvent struct {
+	Event  string            `json:"e"`
+	Time   int64             `json:"E"`
+	Losses []WsOpenOrderLoss `json:"O"`
+}
+
+// WsOpenOrderLoss define the open order loss of an asset
+type WsOpenOrderLoss struct {
+	Asset  string `json:"a"`
+	Amount string `json:"o"`
+}
+
+// WsRiskLevelChangeEvent define riskLevelChange event, pushed when the uniMMR
+// of the account crosses a risk level
+type WsRiskLevelChangeEvent struct {
+	Event         string            `json:"e"`
+	Time          int64             `json:"E"`
+	UniMMR        string            `json:"u"`
+	Status        AccountStatusType `json:"s"`
+	AccountEquity string            `json:"eq"`
+	ActualEquity  string            `json:"ae"`
+	MaintMargin   string            `json:"m"`
+}
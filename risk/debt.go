@@ -0,0 +1,515 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+)
+
+const (
+	defaultRepayInterval = time.Hour
+	defaultHistoryWindow = 30 * 24 * time.Hour
+	historyPageSize      = 100
+)
+
+// ErrNoAssets is returned by Run when no asset is configured.
+var ErrNoAssets = errors.New("risk: no assets")
+
+// DebtEntry is the ledger of one margin asset. The totals come from the loan,
+// repayment and interest histories, the outstanding debt and the free balance
+// from the last account snapshot.
+type DebtEntry struct {
+	Asset string
+	// Loaned is the principal of the confirmed loans.
+	Loaned float64
+	// RepaidPrincipal and RepaidInterest split the confirmed repayments.
+	RepaidPrincipal float64
+	RepaidInterest  float64
+	// InterestCharged is the interest accrued on the loans.
+	InterestCharged float64
+	// Principal and Interest are the outstanding debt.
+	Principal float64
+	Interest  float64
+	Free      float64
+	// UpdateTime is the local time of the last snapshot in milliseconds.
+	UpdateTime int64
+
+	// chargedPrincipal is the principal the rated interests were charged on.
+	chargedPrincipal float64
+	ratedInterest    float64
+}
+
+// Debt returns the outstanding principal and interest.
+func (e *DebtEntry) Debt() float64 {
+	return e.Principal + e.Interest
+}
+
+// Idle returns the free amount which can repay the debt.
+func (e *DebtEntry) Idle() float64 {
+	return math.Max(0, math.Min(e.Free, e.Debt()))
+}
+
+// EffectiveRate returns the interest charged per unit of principal and per
+// charge, which is hourly. Charges converted to another asset are left out.
+func (e *DebtEntry) EffectiveRate() float64 {
+	if e.chargedPrincipal == 0 {
+		return 0
+	}
+	return e.ratedInterest / e.chargedPrincipal
+}
+
+// Repayment is a repayment of a RepayReport, Err is the error of the request,
+// always nil for a dry run.
+type Repayment struct {
+	Asset  string
+	Amount string
+	Debt   float64
+	Free   float64
+	Err    error
+}
+
+// RepayReport lists the repayments done, or only planned by a dry run.
+type RepayReport struct {
+	DryRun     bool
+	Time       int64
+	Repayments []Repayment
+}
+
+// DebtLedgerConfig define the debt ledger options.
+type DebtLedgerConfig struct {
+	// Assets are the assets whose histories are synced.
+	Assets []string
+	// IsolatedSymbol selects an isolated margin account, the cross margin
+	// account is used when empty.
+	IsolatedSymbol string
+	// StartTime is the time in milliseconds the histories are synced from,
+	// 30 days before the first sync by default.
+	StartTime int64
+	// Reserve is the free amount of an asset which is never repaid.
+	Reserve map[string]float64
+	// MinRepay is the idle amount of an asset under which it is not repaid.
+	MinRepay map[string]float64
+	// DryRun makes Run report the repayments without making them.
+	DryRun bool
+	// RepayInterval is the period of the syncs and repayments done by Run,
+	// one hour by default.
+	RepayInterval time.Duration
+	// OnReport receives the repayment reports of Run.
+	OnReport func(r RepayReport)
+	// OnError receives the sync and repayment errors of Run.
+	OnError func(err error)
+	// Now returns the current time, it dates the snapshots and the reports.
+	Now func() time.Time
+}
+
+// historyCursor tracks the records of one history already applied. since is
+// the start time of the next request: the oldest pending record is read again
+// until it is settled.
+type historyCursor struct {
+	since int64
+	seen  map[int64]bool
+	// applied tells if the current sync applied a new settled record.
+	applied bool
+}
+
+func (c *historyCursor) apply(txID, timestamp int64, settled bool, pending *int64) bool {
+	if c.seen[txID] {
+		return false
+	}
+	if !settled {
+		if *pending == 0 || timestamp < *pending {
+			*pending = timestamp
+		}
+		return false
+	}
+	c.seen[txID] = true
+	c.applied = true
+	if timestamp > c.since {
+		c.since = timestamp
+	}
+	return true
+}
+
+func (c *historyCursor) hold(pending int64) {
+	if pending != 0 && pending < c.since {
+		c.since = pending
+	}
+}
+
+// skip ends a sync. It moves since to the end of the window served from it
+// when that window is over and neither applied a new settled record nor held
+// a pending one: the history endpoints only serve 30 days from the start time
+// and return the records at since again, so it would never move otherwise.
+func (c *historyCursor) skip(pending, now int64) {
+	if end := c.since + defaultHistoryWindow.Milliseconds(); !c.applied && pending == 0 && end <= now {
+		c.since = end
+	}
+	c.applied = false
+}
+
+type assetHistory struct {
+	loans, repays, interests historyCursor
+}
+
+// DebtLedger keeps per asset the loans, repayments and interests of one
+// margin account and repays the debts with the idle balances. Sync it, or
+// call Run, which also repays every RepayInterval and whenever Update is fed
+// a balance change from the user data stream of the account.
+type DebtLedger struct {
+	api     binance.MarginAPI
+	config  DebtLedgerConfig
+	refresh chan struct{}
+
+	lock      sync.Mutex
+	entries   map[string]*DebtEntry
+	histories map[string]*assetHistory
+}
+
+// NewDebtLedger creates a debt ledger, api is usually a *binance.Client.
+func NewDebtLedger(api binance.MarginAPI, config DebtLedgerConfig) *DebtLedger {
+	if config.RepayInterval <= 0 {
+		config.RepayInterval = defaultRepayInterval
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &DebtLedger{
+		api:       api,
+		config:    config,
+		refresh:   make(chan struct{}, 1),
+		entries:   make(map[string]*DebtEntry),
+		histories: make(map[string]*assetHistory),
+	}
+}
+
+func (l *DebtLedger) now() int64 {
+	return l.config.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func (l *DebtLedger) entry(asset string) *DebtEntry {
+	e, ok := l.entries[asset]
+	if !ok {
+		e = &DebtEntry{Asset: asset}
+		l.entries[asset] = e
+	}
+	return e
+}
+
+func (l *DebtLedger) history(asset string) *assetHistory {
+	h, ok := l.histories[asset]
+	if !ok {
+		since := l.config.StartTime
+		if since == 0 {
+			since = l.now() - defaultHistoryWindow.Milliseconds()
+		}
+		h = &assetHistory{
+			loans:     historyCursor{since: since, seen: make(map[int64]bool)},
+			repays:    historyCursor{since: since, seen: make(map[int64]bool)},
+			interests: historyCursor{since: since, seen: make(map[int64]bool)},
+		}
+		l.histories[asset] = h
+	}
+	return h
+}
+
+// paginate calls fetch with the pages from 1 until the last one.
+func paginate(fetch func(current int64) (rows int, total int64, err error)) error {
+	for current := int64(1); ; current++ {
+		rows, total, err := fetch(current)
+		if err != nil {
+			return err
+		}
+		if rows < historyPageSize || current*historyPageSize >= total {
+			return nil
+		}
+	}
+}
+
+func (l *DebtLedger) params(asset string, since int64) binance.MarginHistoryParams {
+	return binance.MarginHistoryParams{
+		Asset:          asset,
+		IsolatedSymbol: l.config.IsolatedSymbol,
+		StartTime:      since,
+		Size:           historyPageSize,
+	}
+}
+
+// Sync reads the new records of the histories of the assets, then a
+// snapshot of the account. The first error stops it.
+func (l *DebtLedger) Sync(ctx context.Context) error {
+	if err := l.syncHistories(ctx); err != nil {
+		return err
+	}
+	return l.Snapshot(ctx)
+}
+
+func (l *DebtLedger) syncHistories(ctx context.Context) error {
+	for _, asset := range l.config.Assets {
+		if err := l.syncLoans(ctx, asset); err != nil {
+			return err
+		}
+		if err := l.syncRepays(ctx, asset); err != nil {
+			return err
+		}
+		if err := l.syncInterests(ctx, asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *DebtLedger) syncLoans(ctx context.Context, asset string) error {
+	l.lock.Lock()
+	params := l.params(asset, l.history(asset).loans.since)
+	l.lock.Unlock()
+	var rows []binance.MarginLoan
+	err := paginate(func(current int64) (int, int64, error) {
+		params.Current = current
+		res, err := l.api.ListMarginLoans(ctx, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		rows = append(rows, res.Rows...)
+		return len(res.Rows), res.Total, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e, cursor, pending := l.entry(asset), &l.history(asset).loans, int64(0)
+	for _, r := range rows {
+		settled := r.Status != binance.MarginLoanStatusTypePending
+		if cursor.apply(r.TxID, r.Timestamp, settled, &pending) && r.Status == binance.MarginLoanStatusTypeConfirmed {
+			e.Loaned += parseFloat(r.Principal)
+		}
+	}
+	cursor.hold(pending)
+	cursor.skip(pending, l.now())
+	return nil
+}
+
+func (l *DebtLedger) syncRepays(ctx context.Context, asset string) error {
+	l.lock.Lock()
+	params := l.params(asset, l.history(asset).repays.since)
+	l.lock.Unlock()
+	var rows []binance.MarginRepay
+	err := paginate(func(current int64) (int, int64, error) {
+		params.Current = current
+		res, err := l.api.ListMarginRepays(ctx, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		rows = append(rows, res.Rows...)
+		return len(res.Rows), res.Total, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e, cursor, pending := l.entry(asset), &l.history(asset).repays, int64(0)
+	for _, r := range rows {
+		settled := r.Status != binance.MarginRepayStatusTypePending
+		if cursor.apply(r.TxID, r.Timestamp, settled, &pending) && r.Status == binance.MarginRepayStatusTypeConfirmed {
+			e.RepaidPrincipal += parseFloat(r.Principal)
+			e.RepaidInterest += parseFloat(r.Interest)
+		}
+	}
+	cursor.hold(pending)
+	cursor.skip(pending, l.now())
+	return nil
+}
+
+func (l *DebtLedger) syncInterests(ctx context.Context, asset string) error {
+	l.lock.Lock()
+	params := l.params(asset, l.history(asset).interests.since)
+	l.lock.Unlock()
+	var rows []binance.MarginInterest
+	err := paginate(func(current int64) (int, int64, error) {
+		params.Current = current
+		res, err := l.api.ListMarginInterestHistory(ctx, params)
+		if err != nil {
+			return 0, 0, err
+		}
+		rows = append(rows, res.Rows...)
+		return len(res.Rows), res.Total, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e, cursor, pending := l.entry(asset), &l.history(asset).interests, int64(0)
+	for _, r := range rows {
+		if !cursor.apply(r.TxID, r.InterestAccuredTime, true, &pending) {
+			continue
+		}
+		interest := parseFloat(r.Interest)
+		e.InterestCharged += interest
+		if !strings.HasSuffix(r.Type, "_CONVERTED") {
+			e.ratedInterest += interest
+			e.chargedPrincipal += parseFloat(r.Principal)
+		}
+	}
+	cursor.skip(pending, l.now())
+	return nil
+}
+
+// Snapshot reads the outstanding debts and the free balances of the account.
+// Assets which are neither configured nor borrowed are left out.
+func (l *DebtLedger) Snapshot(ctx context.Context) error {
+	account, err := readAccount(ctx, l.api, l.config.IsolatedSymbol)
+	if err != nil {
+		return err
+	}
+
+	now := l.now()
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	configured := make(map[string]bool, len(l.config.Assets))
+	for _, asset := range l.config.Assets {
+		configured[asset] = true
+	}
+	listed := make(map[string]bool, len(account.assets))
+	for _, a := range account.assets {
+		if !configured[a.asset] && a.debt() == 0 {
+			continue
+		}
+		listed[a.asset] = true
+		e := l.entry(a.asset)
+		e.Principal, e.Interest, e.Free = a.borrowed, a.interest, a.free
+		e.UpdateTime = now
+	}
+	for asset, e := range l.entries {
+		if !listed[asset] {
+			e.Principal, e.Interest, e.Free = 0, 0, 0
+			e.UpdateTime = now
+		}
+	}
+	return nil
+}
+
+// Repay takes a snapshot of the account and repays, for every asset, the
+// idle amount above its Reserve when it reaches MinRepay. A dry run only
+// reports the repayments. Errors of the repayments are in the report.
+func (l *DebtLedger) Repay(ctx context.Context, dryRun bool) (*RepayReport, error) {
+	if err := l.Snapshot(ctx); err != nil {
+		return nil, err
+	}
+	report := &RepayReport{DryRun: dryRun, Time: l.now()}
+	for _, e := range l.Entries() {
+		idle := math.Min(e.Free-l.config.Reserve[e.Asset], e.Debt())
+		if idle <= 0 || idle < l.config.MinRepay[e.Asset] {
+			continue
+		}
+		repayment := Repayment{
+			Asset:  e.Asset,
+			Amount: formatAmount(idle),
+			Debt:   e.Debt(),
+			Free:   e.Free,
+		}
+		if idle = parseFloat(repayment.Amount); idle <= 0 {
+			continue
+		}
+		if !dryRun {
+			_, repayment.Err = l.api.MarginRepay(ctx, binance.MarginRepayParams{
+				Asset:          e.Asset,
+				Amount:         repayment.Amount,
+				IsolatedSymbol: l.config.IsolatedSymbol,
+			})
+			if repayment.Err == nil {
+				l.repaid(e.Asset, idle)
+			}
+		}
+		report.Repayments = append(report.Repayments, repayment)
+	}
+	return report, nil
+}
+
+// repaid applies a repayment to the snapshot, the interest is repaid first.
+// The history totals are updated by the next sync.
+func (l *DebtLedger) repaid(asset string, amount float64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e := l.entry(asset)
+	e.Free -= amount
+	interest := math.Min(amount, e.Interest)
+	e.Interest -= interest
+	e.Principal -= amount - interest
+}
+
+// Update schedules a sync and a repayment of Run on an outboundAccountPosition
+// or balanceUpdate event, other events are ignored. It returns if they were
+// scheduled.
+func (l *DebtLedger) Update(e *binance.WsUserDataEvent) bool {
+	if e.AccountPosition == nil && e.BalanceUpdate == nil {
+		return false
+	}
+	select {
+	case l.refresh <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Run syncs and repays, with the DryRun option, once, then every
+// RepayInterval and whenever Update schedules it, until ctx is done. Reports
+// are passed to OnReport and errors to OnError.
+func (l *DebtLedger) Run(ctx context.Context) error {
+	if len(l.config.Assets) == 0 {
+		return ErrNoAssets
+	}
+	ticker := time.NewTicker(l.config.RepayInterval)
+	defer ticker.Stop()
+	for {
+		// Repay takes the snapshot.
+		err := l.syncHistories(ctx)
+		if err == nil {
+			var report *RepayReport
+			if report, err = l.Repay(ctx, l.config.DryRun); err == nil && l.config.OnReport != nil {
+				l.config.OnReport(*report)
+			}
+		}
+		if err != nil && l.config.OnError != nil && ctx.Err() == nil {
+			l.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-l.refresh:
+		}
+	}
+}
+
+// Entry returns the ledger of an asset.
+func (l *DebtLedger) Entry(asset string) (DebtEntry, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e, ok := l.entries[asset]
+	if !ok {
+		return DebtEntry{}, false
+	}
+	return *e, true
+}
+
+// Entries returns the ledgers sorted by asset.
+func (l *DebtLedger) Entries() (res []DebtEntry) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, e := range l.entries {
+		res = append(res, *e)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
@@ -0,0 +1,268 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type debtLedgerTestSuite struct {
+	suite.Suite
+	api *mocks.Client
+}
+
+func TestDebtLedger(t *testing.T) {
+	suite.Run(t, new(debtLedgerTestSuite))
+}
+
+func (s *debtLedgerTestSuite) SetupTest() {
+	s.api = new(mocks.Client)
+}
+
+func (s *debtLedgerTestSuite) newLedger(config DebtLedgerConfig) *DebtLedger {
+	config.Now = func() time.Time { return time.Unix(100, 0) }
+	return NewDebtLedger(s.api, config)
+}
+
+func historyParams(asset, isolatedSymbol string, since int64) binance.MarginHistoryParams {
+	return binance.MarginHistoryParams{
+		Asset:          asset,
+		IsolatedSymbol: isolatedSymbol,
+		StartTime:      since,
+		Current:        1,
+		Size:           historyPageSize,
+	}
+}
+
+func (s *debtLedgerTestSuite) TestSync() {
+	ledger := s.newLedger(DebtLedgerConfig{Assets: []string{"BTC"}, StartTime: 1000})
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginLoanResponse{Rows: []binance.MarginLoan{
+			{TxID: 1, Asset: "BTC", Principal: "0.5", Timestamp: 2000, Status: binance.MarginLoanStatusTypeConfirmed},
+			{TxID: 2, Asset: "BTC", Principal: "0.3", Timestamp: 3000, Status: binance.MarginLoanStatusTypePending},
+			{TxID: 3, Asset: "BTC", Principal: "9", Timestamp: 2500, Status: binance.MarginLoanStatusTypeFailed},
+		}, Total: 3}, nil).Once()
+	s.api.On("ListMarginRepays", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginRepayResponse{Rows: []binance.MarginRepay{
+			{TxID: 10, Asset: "BTC", Amount: "0.21", Principal: "0.2", Interest: "0.01", Timestamp: 4000,
+				Status: binance.MarginRepayStatusTypeConfirmed},
+		}, Total: 1}, nil).Once()
+	s.api.On("ListMarginInterestHistory", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginInterestHistory{Rows: []binance.MarginInterest{
+			{TxID: 20, InterestAccuredTime: 2000, Asset: "BTC", Principal: "0.5", Interest: "0.001", Type: "ON_BORROW"},
+			{TxID: 21, InterestAccuredTime: 5000, Asset: "BTC", Principal: "0.3", Interest: "0.0006", Type: "PERIODIC"},
+			{TxID: 22, InterestAccuredTime: 5000, Asset: "BNB", RawAsset: "BTC", Principal: "0.3", Interest: "0.0002",
+				Type: "PERIODIC_CONVERTED"},
+		}, Total: 3}, nil).Once()
+	s.api.On("GetMarginAccount", mock.Anything).Return(&binance.MarginAccount{UserAssets: []binance.UserAsset{
+		{Asset: "BTC", Free: "0.1", Borrowed: "0.6", Interest: "0.0016"},
+		{Asset: "ETH", Free: "1"},
+		{Asset: "USDT", Borrowed: "10"},
+	}}, nil).Twice()
+
+	// the pending loan is read again, the settled records are not counted twice.
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 2500)).
+		Return(&binance.MarginLoanResponse{Rows: []binance.MarginLoan{
+			{TxID: 3, Asset: "BTC", Principal: "9", Timestamp: 2500, Status: binance.MarginLoanStatusTypeFailed},
+			{TxID: 2, Asset: "BTC", Principal: "0.3", Timestamp: 3000, Status: binance.MarginLoanStatusTypeConfirmed},
+		}, Total: 2}, nil).Once()
+	s.api.On("ListMarginRepays", mock.Anything, historyParams("BTC", "", 4000)).
+		Return(&binance.MarginRepayResponse{Rows: []binance.MarginRepay{
+			{TxID: 10, Asset: "BTC", Principal: "0.2", Interest: "0.01", Timestamp: 4000,
+				Status: binance.MarginRepayStatusTypeConfirmed},
+		}, Total: 1}, nil).Once()
+	s.api.On("ListMarginInterestHistory", mock.Anything, historyParams("BTC", "", 5000)).
+		Return(&binance.MarginInterestHistory{}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	s.Require().NoError(ledger.Sync(context.Background()))
+	e, ok := ledger.Entry("BTC")
+	s.Require().True(ok)
+	s.InDelta(0.5, e.Loaned, 1e-9)
+	s.InDelta(0.2, e.RepaidPrincipal, 1e-9)
+	s.InDelta(0.01, e.RepaidInterest, 1e-9)
+	s.InDelta(0.0018, e.InterestCharged, 1e-9)
+	s.InDelta(0.002, e.EffectiveRate(), 1e-9)
+	s.InDelta(0.6016, e.Debt(), 1e-9)
+	s.InDelta(0.1, e.Idle(), 1e-9)
+	s.Equal(int64(100000), e.UpdateTime)
+
+	s.Require().NoError(ledger.Sync(context.Background()))
+	e, _ = ledger.Entry("BTC")
+	s.InDelta(0.8, e.Loaned, 1e-9)
+	s.InDelta(0.2, e.RepaidPrincipal, 1e-9)
+	entries := ledger.Entries()
+	s.Require().Len(entries, 2)
+	s.Equal("USDT", entries[1].Asset)
+	s.InDelta(10, entries[1].Principal, 1e-9)
+}
+
+func (s *debtLedgerTestSuite) TestRepay() {
+	ledger := s.newLedger(DebtLedgerConfig{
+		Assets:   []string{"BTC", "ETH", "USDT"},
+		Reserve:  map[string]float64{"USDT": 5},
+		MinRepay: map[string]float64{"BTC": 0.01},
+	})
+	s.api.On("GetMarginAccount", mock.Anything).Return(&binance.MarginAccount{UserAssets: []binance.UserAsset{
+		{Asset: "BTC", Free: "0.005", Borrowed: "0.1"},
+		{Asset: "ETH", Free: "1", Borrowed: "2"},
+		{Asset: "USDT", Free: "30", Borrowed: "20", Interest: "0.5"},
+	}}, nil).Twice()
+	s.api.On("MarginRepay", mock.Anything, binance.MarginRepayParams{Asset: "ETH", Amount: "1.00000000"}).
+		Return(nil, errors.New("boom")).Once()
+	s.api.On("MarginRepay", mock.Anything, binance.MarginRepayParams{Asset: "USDT", Amount: "20.50000000"}).
+		Return(&binance.TransactionResponse{TranID: 1}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	planned := []Repayment{
+		{Asset: "ETH", Amount: "1.00000000", Debt: 2, Free: 1},
+		{Asset: "USDT", Amount: "20.50000000", Debt: 20.5, Free: 30},
+	}
+	report, err := ledger.Repay(context.Background(), true)
+	s.Require().NoError(err)
+	s.Equal(&RepayReport{DryRun: true, Time: 100000, Repayments: planned}, report)
+
+	report, err = ledger.Repay(context.Background(), false)
+	s.Require().NoError(err)
+	planned[0].Err = errors.New("boom")
+	s.Equal(&RepayReport{Time: 100000, Repayments: planned}, report)
+	e, _ := ledger.Entry("USDT")
+	s.InDelta(9.5, e.Free, 1e-9)
+	s.InDelta(0, e.Debt(), 1e-9)
+	e, _ = ledger.Entry("ETH")
+	s.InDelta(2, e.Principal, 1e-9)
+}
+
+func (s *debtLedgerTestSuite) TestRepayTruncates() {
+	ledger := s.newLedger(DebtLedgerConfig{Assets: []string{"BTC"}})
+	s.api.On("GetMarginAccount", mock.Anything).Return(&binance.MarginAccount{UserAssets: []binance.UserAsset{
+		{Asset: "BTC", Free: "0.123456789", Borrowed: "1"},
+	}}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	// rounding would repay 0.12345679, more than the free balance.
+	report, err := ledger.Repay(context.Background(), true)
+	s.Require().NoError(err)
+	s.Require().Len(report.Repayments, 1)
+	s.Equal("0.12345678", report.Repayments[0].Amount)
+	s.Equal("0.29000000", formatAmount(0.29))
+	s.Equal("3.00000000", formatAmount(3))
+}
+
+func (s *debtLedgerTestSuite) TestSyncEmptyWindow() {
+	window := defaultHistoryWindow.Milliseconds()
+	ledger := s.newLedger(DebtLedgerConfig{Assets: []string{"BTC"}, StartTime: 1000})
+	ledger.config.Now = func() time.Time { return time.UnixMilli(1000 + window + window/2) }
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginLoanResponse{}, nil).Once()
+	s.api.On("ListMarginRepays", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginRepayResponse{}, nil).Once()
+	s.api.On("ListMarginInterestHistory", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginInterestHistory{}, nil).Once()
+	// the next window is not over, it is read again until records show up.
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 1000+window)).
+		Return(&binance.MarginLoanResponse{}, nil).Twice()
+	s.api.On("ListMarginRepays", mock.Anything, historyParams("BTC", "", 1000+window)).
+		Return(&binance.MarginRepayResponse{}, nil).Twice()
+	s.api.On("ListMarginInterestHistory", mock.Anything, historyParams("BTC", "", 1000+window)).
+		Return(&binance.MarginInterestHistory{}, nil).Twice()
+	defer s.api.AssertExpectations(s.T())
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(ledger.syncHistories(context.Background()))
+	}
+}
+
+func (s *debtLedgerTestSuite) TestSyncGapAfterRecord() {
+	window := defaultHistoryWindow.Milliseconds()
+	ledger := s.newLedger(DebtLedgerConfig{Assets: []string{"BTC"}, StartTime: 1000})
+	ledger.config.Now = func() time.Time { return time.UnixMilli(1000 + 3*window) }
+	loan := binance.MarginLoan{TxID: 1, Asset: "BTC", Principal: "0.5", Timestamp: 2000,
+		Status: binance.MarginLoanStatusTypeConfirmed}
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 1000)).
+		Return(&binance.MarginLoanResponse{Rows: []binance.MarginLoan{loan}, Total: 1}, nil).Once()
+	// the window starting at the loan only returns it again, it is skipped.
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 2000)).
+		Return(&binance.MarginLoanResponse{Rows: []binance.MarginLoan{loan}, Total: 1}, nil).Once()
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "", 2000+window)).
+		Return(&binance.MarginLoanResponse{Rows: []binance.MarginLoan{
+			{TxID: 2, Asset: "BTC", Principal: "0.3", Timestamp: 2000 + window + 5,
+				Status: binance.MarginLoanStatusTypeConfirmed},
+		}, Total: 1}, nil).Once()
+	s.api.On("ListMarginRepays", mock.Anything, mock.Anything).
+		Return(&binance.MarginRepayResponse{}, nil).Times(3)
+	s.api.On("ListMarginInterestHistory", mock.Anything, mock.Anything).
+		Return(&binance.MarginInterestHistory{}, nil).Times(3)
+	defer s.api.AssertExpectations(s.T())
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(ledger.syncHistories(context.Background()))
+	}
+	e, _ := ledger.Entry("BTC")
+	s.InDelta(0.8, e.Loaned, 1e-9)
+}
+
+func (s *debtLedgerTestSuite) TestRun() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var reports []RepayReport
+	ledger := s.newLedger(DebtLedgerConfig{
+		Assets:         []string{"BTC"},
+		IsolatedSymbol: "BTCUSDT",
+		StartTime:      1000,
+		DryRun:         true,
+		OnReport: func(r RepayReport) {
+			reports = append(reports, r)
+			cancel()
+		},
+	})
+	s.api.On("ListMarginLoans", mock.Anything, historyParams("BTC", "BTCUSDT", 1000)).
+		Return(&binance.MarginLoanResponse{}, nil).Once()
+	s.api.On("ListMarginRepays", mock.Anything, historyParams("BTC", "BTCUSDT", 1000)).
+		Return(&binance.MarginRepayResponse{}, nil).Once()
+	s.api.On("ListMarginInterestHistory", mock.Anything, historyParams("BTC", "BTCUSDT", 1000)).
+		Return(&binance.MarginInterestHistory{}, nil).Once()
+	s.api.On("GetIsolatedMarginAccount", mock.Anything, []string{"BTCUSDT"}).
+		Return(&binance.IsolatedMarginAccount{Assets: []binance.IsolatedMarginAsset{{
+			Symbol:     "BTCUSDT",
+			BaseAsset:  binance.IsolatedUserAsset{Asset: "BTC", Free: "1", Borrowed: "0.5"},
+			QuoteAsset: binance.IsolatedUserAsset{Asset: "USDT", Free: "100"},
+		}}}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	s.ErrorIs(ledger.Run(ctx), context.Canceled)
+	s.Equal([]RepayReport{{
+		DryRun:     true,
+		Time:       100000,
+		Repayments: []Repayment{{Asset: "BTC", Amount: "0.50000000", Debt: 0.5, Free: 1}},
+	}}, reports)
+	s.False(ledger.Update(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport}))
+	s.True(ledger.Update(&binance.WsUserDataEvent{BalanceUpdate: &binance.WsBalanceUpdateEvent{Asset: "BTC"}}))
+}
+
+func (s *debtLedgerTestSuite) TestPaginate() {
+	var pages []int64
+	err := paginate(func(current int64) (int, int64, error) {
+		pages = append(pages, current)
+		if current < 3 {
+			return historyPageSize, 250, nil
+		}
+		return 50, 250, nil
+	})
+	s.Require().NoError(err)
+	s.Equal([]int64{1, 2, 3}, pages)
+	s.EqualError(paginate(func(int64) (int, int64, error) {
+		return 0, 0, errors.New("boom")
+	}), "boom")
+}
+
+func (s *debtLedgerTestSuite) TestNoAssets() {
+	s.ErrorIs(s.newLedger(DebtLedgerConfig{}).Run(context.Background()), ErrNoAssets)
+}
@@ -0,0 +1,446 @@
+// Package risk watches the margin level of the cross and isolated margin
+// accounts, alerts when it falls through configured thresholds and can run a
+// reduction playbook before the account gets liquidated. It also keeps a
+// ledger of the margin debts and repays them with the idle balances.
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+)
+
+const (
+	defaultPollInterval = 30 * time.Second
+	// isolatedSymbolsLimit is the maximum number of symbols of one isolated
+	// margin account request.
+	isolatedSymbolsLimit = 5
+	// amountPrecision is the number of decimals of the repaid amounts, the
+	// precision of the margin balances.
+	amountPrecision = 8
+)
+
+// ErrNoAccounts is returned by Run when neither the cross margin account nor
+// an isolated symbol is configured.
+var ErrNoAccounts = errors.New("risk: no accounts")
+
+// ActionType define the steps of a playbook.
+type ActionType string
+
+// Action types.
+const (
+	ActionTypeCancelOpenOrders ActionType = "CANCEL_OPEN_ORDERS"
+	ActionTypeTransfer         ActionType = "TRANSFER"
+	ActionTypeRepay            ActionType = "REPAY"
+)
+
+// Level is the margin level of one account. Symbol is empty for the cross
+// margin account. Time is the local time of the check in milliseconds.
+type Level struct {
+	Symbol      string
+	MarginLevel float64
+	Time        int64
+}
+
+// Alert is fired when the margin level of an account falls to or below a
+// threshold it was above at the previous check.
+type Alert struct {
+	Level
+	Threshold float64
+}
+
+// Collateral is an amount of an asset moved from the spot account to the
+// margin account by a playbook.
+type Collateral struct {
+	Asset  string
+	Amount string
+}
+
+// Playbook define the steps run on an account whose margin level falls to or
+// below Trigger. They run in the order of the fields, once, until the level
+// recovers above Trigger.
+type Playbook struct {
+	Trigger float64
+	// CancelOpenOrders cancels the open orders of the account, which unlocks
+	// the balances for the repayments. The orders of the CancelSymbols are
+	// cancelled in the cross margin account, the orders of the symbol in an
+	// isolated margin account.
+	CancelOpenOrders bool
+	CancelSymbols    []string
+	// Collateral is transferred from the spot account.
+	Collateral []Collateral
+	// Repay repays the debts, borrowed and interest, with the free balance of
+	// the same asset. The balances are read again after the previous steps.
+	Repay bool
+}
+
+// Action reports a step of a playbook. Symbol is the account, Target the
+// symbol whose orders are cancelled, Asset and Amount are set for transfers
+// and repayments.
+type Action struct {
+	Symbol string
+	Type   ActionType
+	Target string
+	Asset  string
+	Amount string
+	Err    error
+}
+
+// MarginMonitorConfig define the margin monitor options.
+type MarginMonitorConfig struct {
+	// Cross monitors the cross margin account.
+	Cross bool
+	// IsolatedSymbols are the isolated margin accounts monitored.
+	IsolatedSymbols []string
+	// Thresholds are the margin levels which fire OnAlert, in any order.
+	Thresholds []float64
+	// Playbook is run on the accounts at or below its trigger when set.
+	Playbook *Playbook
+	// PollInterval is the period of the checks done by Run, 30 seconds by
+	// default.
+	PollInterval time.Duration
+	// OnLevel is called with the margin level of every account checked.
+	OnLevel func(l Level)
+	// OnAlert is called once per check and account, with the lowest threshold
+	// crossed.
+	OnAlert func(a Alert)
+	// OnAction is called after every step of the playbook.
+	OnAction func(a Action)
+	// OnError receives the check errors of Run.
+	OnError func(err error)
+	// Now returns the current time, it dates the levels.
+	Now func() time.Time
+}
+
+type accountState struct {
+	level    Level
+	breached int
+	acted    bool
+}
+
+// margin is the part of a margin account snapshot the monitor uses. level is
+// the margin level as reported, parsed by the checks only.
+type margin struct {
+	symbol string
+	level  string
+	assets []marginAsset
+}
+
+type marginAsset struct {
+	asset                    string
+	free, borrowed, interest float64
+}
+
+func newMarginAsset(asset, free, borrowed, interest string) marginAsset {
+	return marginAsset{
+		asset:    asset,
+		free:     parseFloat(free),
+		borrowed: parseFloat(borrowed),
+		interest: parseFloat(interest),
+	}
+}
+
+func (a *marginAsset) debt() float64 {
+	return a.borrowed + a.interest
+}
+
+// MarginMonitor checks the margin level of the configured accounts with the
+// REST API. Feed it with Update from the user data streams of the accounts to
+// check again as soon as a balance changes.
+type MarginMonitor struct {
+	api     binance.MarginAPI
+	config  MarginMonitorConfig
+	refresh chan struct{}
+
+	lock     sync.Mutex
+	accounts map[string]*accountState
+}
+
+// NewMarginMonitor creates a margin monitor, api is usually a *binance.Client.
+func NewMarginMonitor(api binance.MarginAPI, config MarginMonitorConfig) *MarginMonitor {
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	thresholds := append([]float64(nil), config.Thresholds...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(thresholds)))
+	config.Thresholds = thresholds
+	return &MarginMonitor{
+		api:      api,
+		config:   config,
+		refresh:  make(chan struct{}, 1),
+		accounts: make(map[string]*accountState),
+	}
+}
+
+// Update schedules a check of Run on an outboundAccountPosition or
+// balanceUpdate event, other events are ignored. It returns if a check was
+// scheduled; checks scheduled before Run picks them up are merged.
+func (m *MarginMonitor) Update(e *binance.WsUserDataEvent) bool {
+	if e.AccountPosition == nil && e.BalanceUpdate == nil {
+		return false
+	}
+	select {
+	case m.refresh <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// Run checks once, then every PollInterval and whenever Update schedules it,
+// until ctx is done. Errors are passed to OnError.
+func (m *MarginMonitor) Run(ctx context.Context) error {
+	if !m.config.Cross && len(m.config.IsolatedSymbols) == 0 {
+		return ErrNoAccounts
+	}
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.Check(ctx); err != nil && m.config.OnError != nil && ctx.Err() == nil {
+			m.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-m.refresh:
+		}
+	}
+}
+
+// Check reads the margin level of every account, fires the alerts and runs
+// the playbook where needed. Accounts which cannot be read, or whose margin
+// level is not a number, are skipped, the last error is returned.
+func (m *MarginMonitor) Check(ctx context.Context) (last error) {
+	var accounts []margin
+	if m.config.Cross {
+		account, err := readCross(ctx, m.api)
+		if err != nil {
+			last = err
+		} else {
+			accounts = append(accounts, account)
+		}
+	}
+	symbols := m.config.IsolatedSymbols
+	for len(symbols) > 0 {
+		n := len(symbols)
+		if n > isolatedSymbolsLimit {
+			n = isolatedSymbolsLimit
+		}
+		isolated, err := readIsolated(ctx, m.api, symbols[:n])
+		if err != nil {
+			last = err
+		}
+		accounts = append(accounts, isolated...)
+		symbols = symbols[n:]
+	}
+
+	now := m.config.Now().UnixNano() / int64(time.Millisecond)
+	for _, account := range accounts {
+		level, err := strconv.ParseFloat(account.level, 64)
+		if err != nil {
+			// an unknown level must not pass for 0 and run the playbook.
+			last = fmt.Errorf("risk: invalid margin level %q of account %q: %w", account.level,
+				account.symbol, err)
+			continue
+		}
+		m.evaluate(ctx, Level{Symbol: account.symbol, MarginLevel: level, Time: now})
+	}
+	return last
+}
+
+func (m *MarginMonitor) evaluate(ctx context.Context, level Level) {
+	m.lock.Lock()
+	state, ok := m.accounts[level.Symbol]
+	if !ok {
+		state = new(accountState)
+		m.accounts[level.Symbol] = state
+	}
+	state.level = level
+	// the thresholds are sorted downwards, the breached ones are a prefix.
+	breached := sort.Search(len(m.config.Thresholds), func(i int) bool {
+		return level.MarginLevel > m.config.Thresholds[i]
+	})
+	alert := breached > state.breached
+	state.breached = breached
+	act := false
+	if p := m.config.Playbook; p != nil {
+		triggered := level.MarginLevel <= p.Trigger
+		act = triggered && !state.acted
+		state.acted = triggered
+	}
+	m.lock.Unlock()
+
+	if m.config.OnLevel != nil {
+		m.config.OnLevel(level)
+	}
+	if alert && m.config.OnAlert != nil {
+		m.config.OnAlert(Alert{Level: level, Threshold: m.config.Thresholds[breached-1]})
+	}
+	if act {
+		m.play(ctx, level.Symbol)
+	}
+}
+
+func (m *MarginMonitor) play(ctx context.Context, symbol string) {
+	p := m.config.Playbook
+	if p.CancelOpenOrders {
+		targets := p.CancelSymbols
+		if symbol != "" {
+			targets = []string{symbol}
+		}
+		for _, target := range targets {
+			_, err := m.api.CancelMarginOpenOrders(ctx, target, symbol != "")
+			m.report(Action{Symbol: symbol, Type: ActionTypeCancelOpenOrders, Target: target, Err: err})
+		}
+	}
+	for _, c := range p.Collateral {
+		var err error
+		if symbol == "" {
+			_, err = m.api.MarginTransfer(ctx, binance.MarginTransferParams{
+				Asset:  c.Asset,
+				Amount: c.Amount,
+				Type:   binance.MarginTransferTypeToMargin,
+			})
+		} else {
+			_, err = m.api.IsolatedMarginTransfer(ctx, binance.IsolatedMarginTransferParams{
+				Asset:     c.Asset,
+				Symbol:    symbol,
+				TransFrom: binance.IsolatedMarginAccountTypeSpot,
+				TransTo:   binance.IsolatedMarginAccountTypeIsolatedMargin,
+				Amount:    c.Amount,
+			})
+		}
+		m.report(Action{Symbol: symbol, Type: ActionTypeTransfer, Asset: c.Asset, Amount: c.Amount, Err: err})
+	}
+	if p.Repay {
+		m.repay(ctx, symbol)
+	}
+}
+
+func (m *MarginMonitor) repay(ctx context.Context, symbol string) {
+	account, err := readAccount(ctx, m.api, symbol)
+	if err != nil {
+		m.report(Action{Symbol: symbol, Type: ActionTypeRepay, Err: err})
+		return
+	}
+	for _, a := range account.assets {
+		amount := a.debt()
+		if a.free < amount {
+			amount = a.free
+		}
+		params := binance.MarginRepayParams{
+			Asset:          a.asset,
+			Amount:         formatAmount(amount),
+			IsolatedSymbol: symbol,
+		}
+		if parseFloat(params.Amount) <= 0 {
+			continue
+		}
+		_, err := m.api.MarginRepay(ctx, params)
+		m.report(Action{Symbol: symbol, Type: ActionTypeRepay, Asset: params.Asset, Amount: params.Amount, Err: err})
+	}
+}
+
+func (m *MarginMonitor) report(a Action) {
+	if m.config.OnAction != nil {
+		m.config.OnAction(a)
+	}
+}
+
+// readCross reads the cross margin account.
+func readCross(ctx context.Context, api binance.MarginAPI) (margin, error) {
+	account, err := api.GetMarginAccount(ctx)
+	if err != nil {
+		return margin{}, err
+	}
+	res := margin{level: account.MarginLevel}
+	for _, a := range account.UserAssets {
+		res.assets = append(res.assets, newMarginAsset(a.Asset, a.Free, a.Borrowed, a.Interest))
+	}
+	return res, nil
+}
+
+// readIsolated reads the isolated margin accounts of symbols.
+func readIsolated(ctx context.Context, api binance.MarginAPI, symbols []string) (res []margin, err error) {
+	account, err := api.GetIsolatedMarginAccount(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range account.Assets {
+		isolated := margin{symbol: pair.Symbol, level: pair.MarginLevel}
+		for _, a := range []binance.IsolatedUserAsset{pair.BaseAsset, pair.QuoteAsset} {
+			isolated.assets = append(isolated.assets, newMarginAsset(a.Asset, a.Free, a.Borrowed, a.Interest))
+		}
+		res = append(res, isolated)
+	}
+	return res, nil
+}
+
+// readAccount reads the cross margin account when symbol is empty, else the
+// isolated margin account of symbol.
+func readAccount(ctx context.Context, api binance.MarginAPI, symbol string) (margin, error) {
+	if symbol == "" {
+		return readCross(ctx, api)
+	}
+	accounts, err := readIsolated(ctx, api, []string{symbol})
+	if err != nil {
+		return margin{}, err
+	}
+	for _, account := range accounts {
+		if account.symbol == symbol {
+			return account, nil
+		}
+	}
+	return margin{}, errors.New("risk: isolated margin account not found: " + symbol)
+}
+
+// Level returns the last margin level of an account, symbol is empty for the
+// cross margin account.
+func (m *MarginMonitor) Level(symbol string) (Level, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	state, ok := m.accounts[symbol]
+	if !ok {
+		return Level{}, false
+	}
+	return state.level, true
+}
+
+// Levels returns the last margin levels sorted by symbol, the cross margin
+// account first.
+func (m *MarginMonitor) Levels() (res []Level) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, state := range m.accounts {
+		res = append(res, state.level)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Symbol < res[j].Symbol })
+	return res
+}
+
+// formatAmount formats amount with amountPrecision decimals, truncated rather
+// than rounded so that it never exceeds the balance it was computed from.
+func formatAmount(amount float64) string {
+	// the shortest representation is the decimal amount the float stands for.
+	s := strconv.FormatFloat(amount, 'f', -1, 64)
+	integer, decimals, _ := strings.Cut(s, ".")
+	if len(decimals) > amountPrecision {
+		decimals = decimals[:amountPrecision]
+	}
+	return integer + "." + decimals + strings.Repeat("0", amountPrecision-len(decimals))
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
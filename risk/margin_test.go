@@ -0,0 +1,194 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type marginMonitorTestSuite struct {
+	suite.Suite
+	api     *mocks.Client
+	alerts  []Alert
+	actions []Action
+}
+
+func TestMarginMonitor(t *testing.T) {
+	suite.Run(t, new(marginMonitorTestSuite))
+}
+
+func (s *marginMonitorTestSuite) SetupTest() {
+	s.alerts, s.actions = nil, nil
+	s.api = new(mocks.Client)
+}
+
+func (s *marginMonitorTestSuite) newMonitor(config MarginMonitorConfig) *MarginMonitor {
+	config.OnAlert = func(a Alert) {
+		s.alerts = append(s.alerts, a)
+	}
+	config.OnAction = func(a Action) {
+		s.actions = append(s.actions, a)
+	}
+	config.Now = func() time.Time { return time.Unix(100, 0) }
+	return NewMarginMonitor(s.api, config)
+}
+
+func crossAccount(level string, assets ...binance.UserAsset) *binance.MarginAccount {
+	return &binance.MarginAccount{MarginLevel: level, UserAssets: assets}
+}
+
+func (s *marginMonitorTestSuite) TestAlerts() {
+	monitor := s.newMonitor(MarginMonitorConfig{Cross: true, Thresholds: []float64{1.3, 2, 1.5}})
+	for _, level := range []string{"3", "1.8", "1.4", "1.45", "2.5", "1.2"} {
+		s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount(level), nil).Once()
+	}
+	defer s.api.AssertExpectations(s.T())
+
+	var thresholds []float64
+	for i := 0; i < 6; i++ {
+		s.Require().NoError(monitor.Check(context.Background()))
+		for _, a := range s.alerts {
+			thresholds = append(thresholds, a.Threshold)
+		}
+		s.alerts = nil
+	}
+	// 1.45 stays below 1.5 and does not alert again, 2.5 rearms them all.
+	s.Equal([]float64{2, 1.5, 1.3}, thresholds)
+	level, ok := monitor.Level("")
+	s.Require().True(ok)
+	s.Equal(Level{MarginLevel: 1.2, Time: 100000}, level)
+	s.Empty(s.actions)
+}
+
+func (s *marginMonitorTestSuite) TestCrossPlaybook() {
+	monitor := s.newMonitor(MarginMonitorConfig{
+		Cross: true,
+		Playbook: &Playbook{
+			Trigger:          1.2,
+			CancelOpenOrders: true,
+			CancelSymbols:    []string{"BTCUSDT"},
+			Collateral:       []Collateral{{Asset: "USDT", Amount: "100"}},
+			Repay:            true,
+		},
+	})
+	s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount("1.15"), nil).Once()
+	s.api.On("CancelMarginOpenOrders", mock.Anything, "BTCUSDT", false).
+		Return(&binance.CancelOpenOrdersResponse{}, nil).Once()
+	s.api.On("MarginTransfer", mock.Anything, binance.MarginTransferParams{
+		Asset: "USDT", Amount: "100", Type: binance.MarginTransferTypeToMargin,
+	}).Return(&binance.TransactionResponse{TranID: 1}, nil).Once()
+	s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount("1.18",
+		binance.UserAsset{Asset: "BTC", Free: "0.1", Borrowed: "0.5", Interest: "0.01"},
+		binance.UserAsset{Asset: "USDT", Free: "300", Borrowed: "200", Interest: "1.5"},
+		binance.UserAsset{Asset: "ETH", Free: "2"},
+	), nil).Once()
+	s.api.On("MarginRepay", mock.Anything, binance.MarginRepayParams{Asset: "BTC", Amount: "0.10000000"}).
+		Return(&binance.TransactionResponse{TranID: 2}, nil).Once()
+	s.api.On("MarginRepay", mock.Anything, binance.MarginRepayParams{Asset: "USDT", Amount: "201.50000000"}).
+		Return(nil, errors.New("boom")).Once()
+	// the playbook runs once until the level recovers.
+	s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount("1.1"), nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	s.Require().NoError(monitor.Check(context.Background()))
+	s.Require().NoError(monitor.Check(context.Background()))
+	s.Equal([]Action{
+		{Type: ActionTypeCancelOpenOrders, Target: "BTCUSDT"},
+		{Type: ActionTypeTransfer, Asset: "USDT", Amount: "100"},
+		{Type: ActionTypeRepay, Asset: "BTC", Amount: "0.10000000"},
+		{Type: ActionTypeRepay, Asset: "USDT", Amount: "201.50000000", Err: errors.New("boom")},
+	}, s.actions)
+}
+
+func (s *marginMonitorTestSuite) TestIsolatedPlaybook() {
+	monitor := s.newMonitor(MarginMonitorConfig{
+		IsolatedSymbols: []string{"BTCUSDT", "ETHUSDT"},
+		Thresholds:      []float64{1.5},
+		Playbook: &Playbook{
+			Trigger:          1.2,
+			CancelOpenOrders: true,
+			CancelSymbols:    []string{"BNBUSDT"},
+			Collateral:       []Collateral{{Asset: "USDT", Amount: "50"}},
+		},
+	})
+	s.api.On("GetIsolatedMarginAccount", mock.Anything, []string{"BTCUSDT", "ETHUSDT"}).
+		Return(&binance.IsolatedMarginAccount{Assets: []binance.IsolatedMarginAsset{
+			{Symbol: "BTCUSDT", MarginLevel: "1.1"},
+			{Symbol: "ETHUSDT", MarginLevel: "999"},
+		}}, nil).Once()
+	s.api.On("CancelMarginOpenOrders", mock.Anything, "BTCUSDT", true).
+		Return(nil, errors.New("no open orders")).Once()
+	s.api.On("IsolatedMarginTransfer", mock.Anything, binance.IsolatedMarginTransferParams{
+		Asset:     "USDT",
+		Symbol:    "BTCUSDT",
+		TransFrom: binance.IsolatedMarginAccountTypeSpot,
+		TransTo:   binance.IsolatedMarginAccountTypeIsolatedMargin,
+		Amount:    "50",
+	}).Return(&binance.TransactionResponse{TranID: 1}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	s.Require().NoError(monitor.Check(context.Background()))
+	s.Require().Len(s.alerts, 1)
+	s.Equal("BTCUSDT", s.alerts[0].Symbol)
+	s.Equal(1.5, s.alerts[0].Threshold)
+	s.Equal([]Action{
+		{Symbol: "BTCUSDT", Type: ActionTypeCancelOpenOrders, Target: "BTCUSDT", Err: errors.New("no open orders")},
+		{Symbol: "BTCUSDT", Type: ActionTypeTransfer, Asset: "USDT", Amount: "50"},
+	}, s.actions)
+	levels := monitor.Levels()
+	s.Require().Len(levels, 2)
+	s.Equal("ETHUSDT", levels[1].Symbol)
+	s.Equal(999.0, levels[1].MarginLevel)
+}
+
+func (s *marginMonitorTestSuite) TestInvalidLevel() {
+	monitor := s.newMonitor(MarginMonitorConfig{
+		IsolatedSymbols: []string{"BTCUSDT", "ETHUSDT"},
+		Thresholds:      []float64{1.5},
+		Playbook:        &Playbook{Trigger: 1.2, CancelOpenOrders: true},
+	})
+	s.api.On("GetIsolatedMarginAccount", mock.Anything, []string{"BTCUSDT", "ETHUSDT"}).
+		Return(&binance.IsolatedMarginAccount{Assets: []binance.IsolatedMarginAsset{
+			{Symbol: "BTCUSDT", MarginLevel: ""},
+			{Symbol: "ETHUSDT", MarginLevel: "2"},
+		}}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+
+	s.EqualError(monitor.Check(context.Background()),
+		`risk: invalid margin level "" of account "BTCUSDT": strconv.ParseFloat: parsing "": invalid syntax`)
+	s.Empty(s.alerts)
+	s.Empty(s.actions)
+	_, ok := monitor.Level("BTCUSDT")
+	s.False(ok)
+	_, ok = monitor.Level("ETHUSDT")
+	s.True(ok)
+}
+
+func (s *marginMonitorTestSuite) TestRun() {
+	monitor := s.newMonitor(MarginMonitorConfig{Cross: true, PollInterval: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount("3"), nil).Once()
+	s.api.On("GetMarginAccount", mock.Anything).Return(crossAccount("2"), nil).Once().
+		Run(func(mock.Arguments) { cancel() })
+	defer s.api.AssertExpectations(s.T())
+
+	s.False(monitor.Update(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport}))
+	// both updates are merged in the second check.
+	s.True(monitor.Update(&binance.WsUserDataEvent{BalanceUpdate: &binance.WsBalanceUpdateEvent{Asset: "BTC"}}))
+	s.True(monitor.Update(&binance.WsUserDataEvent{AccountPosition: &binance.WsOutboundAccountPositionEvent{}}))
+	s.ErrorIs(monitor.Run(ctx), context.Canceled)
+	level, _ := monitor.Level("")
+	s.Equal(2.0, level.MarginLevel)
+}
+
+func (s *marginMonitorTestSuite) TestNoAccounts() {
+	s.ErrorIs(s.newMonitor(MarginMonitorConfig{}).Run(context.Background()), ErrNoAccounts)
+}
@@ -0,0 +1,484 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ListSimpleEarnFlexibleProductsService list the simple earn flexible products
+type ListSimpleEarnFlexibleProductsService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleProductsService) Asset(asset string) *ListSimpleEarnFlexibleProductsService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnFlexibleProductsService) Current(current int64) *ListSimpleEarnFlexibleProductsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnFlexibleProductsService) Size(size int64) *ListSimpleEarnFlexibleProductsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnFlexibleProductsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnFlexibleProductList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/flexible/list")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnFlexibleProductList)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleProductList define a page of simple earn flexible products
+type SimpleEarnFlexibleProductList struct {
+	Rows  []*SimpleEarnFlexibleProduct `json:"rows"`
+	Total int64                        `json:"total"`
+}
+
+// SimpleEarnFlexibleProduct define simple earn flexible product info
+type SimpleEarnFlexibleProduct struct {
+	Asset                      string             `json:"asset"`
+	LatestAnnualPercentageRate string             `json:"latestAnnualPercentageRate"`
+	TierAnnualPercentageRate   map[string]float64 `json:"tierAnnualPercentageRate"`
+	AirDropPercentageRate      string             `json:"airDropPercentageRate"`
+	CanPurchase                bool               `json:"canPurchase"`
+	CanRedeem                  bool               `json:"canRedeem"`
+	IsSoldOut                  bool               `json:"isSoldOut"`
+	Hot                        bool               `json:"hot"`
+	MinPurchaseAmount          string             `json:"minPurchaseAmount"`
+	ProductID                  string             `json:"productId"`
+	SubscriptionStartTime      int64              `json:"subscriptionStartTime"`
+	Status                     string             `json:"status"`
+}
+
+// SubscribeSimpleEarnFlexibleService subscribe a simple earn flexible product
+type SubscribeSimpleEarnFlexibleService struct {
+	c             *Client
+	productID     string
+	amount        string
+	autoSubscribe *bool
+	sourceAccount *SimpleEarnAccountType
+}
+
+// ProductID set productID
+func (s *SubscribeSimpleEarnFlexibleService) ProductID(productID string) *SubscribeSimpleEarnFlexibleService {
+	s.productID = productID
+	return s
+}
+
+// Amount set the amount to subscribe
+func (s *SubscribeSimpleEarnFlexibleService) Amount(amount string) *SubscribeSimpleEarnFlexibleService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set whether the rewards are subscribed again, default true
+func (s *SubscribeSimpleEarnFlexibleService) AutoSubscribe(autoSubscribe bool) *SubscribeSimpleEarnFlexibleService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set the account the amount is taken from, default SPOT
+func (s *SubscribeSimpleEarnFlexibleService) SourceAccount(sourceAccount SimpleEarnAccountType) *SubscribeSimpleEarnFlexibleService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// Do send Request
+func (s *SubscribeSimpleEarnFlexibleService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/simple-earn/flexible/subscribe")
+	r.SetFormParams(common.Params{
+		"productId": s.productID,
+		"amount":    s.amount,
+	})
+	if s.autoSubscribe != nil {
+		r.SetForm("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.SetForm("sourceAccount", *s.sourceAccount)
+	}
+
+	res = new(SimpleEarnSubscribeResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnSubscribeResponse define simple earn subscribe response, PositionID
+// is only set for locked products
+type SimpleEarnSubscribeResponse struct {
+	PurchaseID int64  `json:"purchaseId"`
+	PositionID string `json:"positionId"`
+	Success    bool   `json:"success"`
+}
+
+// RedeemSimpleEarnFlexibleService redeem a simple earn flexible product
+type RedeemSimpleEarnFlexibleService struct {
+	c           *Client
+	productID   string
+	redeemAll   *bool
+	amount      *string
+	destAccount *SimpleEarnAccountType
+}
+
+// ProductID set productID
+func (s *RedeemSimpleEarnFlexibleService) ProductID(productID string) *RedeemSimpleEarnFlexibleService {
+	s.productID = productID
+	return s
+}
+
+// RedeemAll set whether the whole position is redeemed, Amount is then ignored
+func (s *RedeemSimpleEarnFlexibleService) RedeemAll(redeemAll bool) *RedeemSimpleEarnFlexibleService {
+	s.redeemAll = &redeemAll
+	return s
+}
+
+// Amount set the amount to redeem
+func (s *RedeemSimpleEarnFlexibleService) Amount(amount string) *RedeemSimpleEarnFlexibleService {
+	s.amount = &amount
+	return s
+}
+
+// DestAccount set the account the amount is redeemed to, default SPOT
+func (s *RedeemSimpleEarnFlexibleService) DestAccount(destAccount SimpleEarnAccountType) *RedeemSimpleEarnFlexibleService {
+	s.destAccount = &destAccount
+	return s
+}
+
+// Do send Request
+func (s *RedeemSimpleEarnFlexibleService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/simple-earn/flexible/redeem")
+	r.SetForm("productId", s.productID)
+	if s.redeemAll != nil {
+		r.SetForm("redeemAll", *s.redeemAll)
+	}
+	if s.amount != nil {
+		r.SetForm("amount", *s.amount)
+	}
+	if s.destAccount != nil {
+		r.SetForm("destAccount", *s.destAccount)
+	}
+
+	res = new(SimpleEarnRedeemResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnRedeemResponse define simple earn redeem response
+type SimpleEarnRedeemResponse struct {
+	RedeemID int64 `json:"redeemId"`
+	Success  bool  `json:"success"`
+}
+
+// ListSimpleEarnFlexiblePositionsService list the simple earn flexible positions
+type ListSimpleEarnFlexiblePositionsService struct {
+	c         *Client
+	asset     *string
+	productID *string
+	current   *int64
+	size      *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexiblePositionsService) Asset(asset string) *ListSimpleEarnFlexiblePositionsService {
+	s.asset = &asset
+	return s
+}
+
+// ProductID set productID
+func (s *ListSimpleEarnFlexiblePositionsService) ProductID(productID string) *ListSimpleEarnFlexiblePositionsService {
+	s.productID = &productID
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnFlexiblePositionsService) Current(current int64) *ListSimpleEarnFlexiblePositionsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnFlexiblePositionsService) Size(size int64) *ListSimpleEarnFlexiblePositionsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnFlexiblePositionsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnFlexiblePositionList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/flexible/position")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.productID != nil {
+		r.SetQuery("productId", *s.productID)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnFlexiblePositionList)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexiblePositionList define a page of simple earn flexible positions
+type SimpleEarnFlexiblePositionList struct {
+	Rows  []*SimpleEarnFlexiblePosition `json:"rows"`
+	Total int64                         `json:"total"`
+}
+
+// SimpleEarnFlexiblePosition define simple earn flexible position info
+type SimpleEarnFlexiblePosition struct {
+	TotalAmount                    string             `json:"totalAmount"`
+	TierAnnualPercentageRate       map[string]float64 `json:"tierAnnualPercentageRate"`
+	LatestAnnualPercentageRate     string             `json:"latestAnnualPercentageRate"`
+	YesterdayAirdropPercentageRate string             `json:"yesterdayAirdropPercentageRate"`
+	Asset                          string             `json:"asset"`
+	AirDropAsset                   string             `json:"airDropAsset"`
+	CanRedeem                      bool               `json:"canRedeem"`
+	CollateralAmount               string             `json:"collateralAmount"`
+	ProductID                      string             `json:"productId"`
+	YesterdayRealTimeRewards       string             `json:"yesterdayRealTimeRewards"`
+	CumulativeBonusRewards         string             `json:"cumulativeBonusRewards"`
+	CumulativeRealTimeRewards      string             `json:"cumulativeRealTimeRewards"`
+	CumulativeTotalRewards         string             `json:"cumulativeTotalRewards"`
+	AutoSubscribe                  bool               `json:"autoSubscribe"`
+}
+
+// ListSimpleEarnFlexibleRewardsService list the rewards of the simple earn
+// flexible products
+type ListSimpleEarnFlexibleRewardsService struct {
+	c          *Client
+	productID  *string
+	asset      *string
+	rewardType SimpleEarnRewardType
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// ProductID set productID
+func (s *ListSimpleEarnFlexibleRewardsService) ProductID(productID string) *ListSimpleEarnFlexibleRewardsService {
+	s.productID = &productID
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleRewardsService) Asset(asset string) *ListSimpleEarnFlexibleRewardsService {
+	s.asset = &asset
+	return s
+}
+
+// Type set the reward type
+func (s *ListSimpleEarnFlexibleRewardsService) Type(rewardType SimpleEarnRewardType) *ListSimpleEarnFlexibleRewardsService {
+	s.rewardType = rewardType
+	return s
+}
+
+// StartTime set start time
+func (s *ListSimpleEarnFlexibleRewardsService) StartTime(startTime int64) *ListSimpleEarnFlexibleRewardsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSimpleEarnFlexibleRewardsService) EndTime(endTime int64) *ListSimpleEarnFlexibleRewardsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnFlexibleRewardsService) Current(current int64) *ListSimpleEarnFlexibleRewardsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnFlexibleRewardsService) Size(size int64) *ListSimpleEarnFlexibleRewardsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnFlexibleRewardsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnFlexibleRewardHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/flexible/history/rewardsRecord")
+	r.SetQuery("type", s.rewardType)
+	if s.productID != nil {
+		r.SetQuery("productId", *s.productID)
+	}
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnFlexibleRewardHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleRewardHistory define simple earn flexible reward history
+type SimpleEarnFlexibleRewardHistory struct {
+	Rows  []*SimpleEarnFlexibleReward `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+// SimpleEarnFlexibleReward define simple earn flexible reward history item
+type SimpleEarnFlexibleReward struct {
+	Asset     string               `json:"asset"`
+	Rewards   string               `json:"rewards"`
+	ProjectID string               `json:"projectId"`
+	Type      SimpleEarnRewardType `json:"type"`
+	Time      int64                `json:"time"`
+}
+
+// ListSimpleEarnFlexibleSubscriptionsService list the subscriptions of the
+// simple earn flexible products
+type ListSimpleEarnFlexibleSubscriptionsService struct {
+	c          *Client
+	productID  *string
+	purchaseID *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// ProductID set productID
+func (s *ListSimpleEarnFlexibleSubscriptionsService) ProductID(productID string) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.productID = &productID
+	return s
+}
+
+// PurchaseID set purchaseID
+func (s *ListSimpleEarnFlexibleSubscriptionsService) PurchaseID(purchaseID int64) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.purchaseID = &purchaseID
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnFlexibleSubscriptionsService) Asset(asset string) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set start time
+func (s *ListSimpleEarnFlexibleSubscriptionsService) StartTime(startTime int64) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSimpleEarnFlexibleSubscriptionsService) EndTime(endTime int64) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnFlexibleSubscriptionsService) Current(current int64) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnFlexibleSubscriptionsService) Size(size int64) *ListSimpleEarnFlexibleSubscriptionsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnFlexibleSubscriptionsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnFlexibleSubscriptionHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/flexible/history/subscriptionRecord")
+	if s.productID != nil {
+		r.SetQuery("productId", *s.productID)
+	}
+	if s.purchaseID != nil {
+		r.SetQuery("purchaseId", *s.purchaseID)
+	}
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnFlexibleSubscriptionHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnFlexibleSubscriptionHistory define simple earn flexible subscription history
+type SimpleEarnFlexibleSubscriptionHistory struct {
+	Rows  []*SimpleEarnFlexibleSubscription `json:"rows"`
+	Total int64                             `json:"total"`
+}
+
+// SimpleEarnFlexibleSubscription define simple earn flexible subscription history item
+type SimpleEarnFlexibleSubscription struct {
+	Amount         string                `json:"amount"`
+	Asset          string                `json:"asset"`
+	Time           int64                 `json:"time"`
+	PurchaseID     int64                 `json:"purchaseId"`
+	ProductID      string                `json:"productId"`
+	Type           string                `json:"type"`
+	SourceAccount  SimpleEarnAccountType `json:"sourceAccount"`
+	AmtFromSpot    string                `json:"amtFromSpot"`
+	AmtFromFunding string                `json:"amtFromFunding"`
+	Status         string                `json:"status"`
+}
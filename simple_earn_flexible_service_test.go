@@ -0,0 +1,226 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type simpleEarnFlexibleServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSimpleEarnFlexibleService(t *testing.T) {
+	suite.Run(t, new(simpleEarnFlexibleServiceTestSuite))
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestListProducts() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "BTC",
+				"latestAnnualPercentageRate": "0.05000000",
+				"tierAnnualPercentageRate": {"0-5BTC": 0.05, "5-10BTC": 0.03},
+				"airDropPercentageRate": "0.05000000",
+				"canPurchase": true,
+				"canRedeem": true,
+				"isSoldOut": true,
+				"hot": true,
+				"minPurchaseAmount": "0.01000000",
+				"productId": "BTC001",
+				"subscriptionStartTime": 1646182276000,
+				"status": "PURCHASING"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":   "BTC",
+			"current": 1,
+			"size":    10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnFlexibleProductsService().Asset("BTC").Current(1).Size(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.Total)
+	s.r().Len(res.Rows, 1)
+	s.r().Equal("BTC001", res.Rows[0].ProductID)
+	s.r().Equal("0.01000000", res.Rows[0].MinPurchaseAmount)
+	s.r().True(res.Rows[0].CanPurchase)
+	s.r().Equal(map[string]float64{"0-5BTC": 0.05, "5-10BTC": 0.03}, res.Rows[0].TierAnnualPercentageRate)
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestSubscribe() {
+	data := []byte(`{"purchaseId": 40607, "success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"productId":     "USDT001",
+			"amount":        "100",
+			"autoSubscribe": false,
+			"sourceAccount": SimpleEarnAccountTypeFund,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSubscribeSimpleEarnFlexibleService().ProductID("USDT001").Amount("100").
+		AutoSubscribe(false).SourceAccount(SimpleEarnAccountTypeFund).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnSubscribeResponse{PurchaseID: 40607, Success: true}, res)
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestRedeem() {
+	data := []byte(`{"redeemId": 40607, "success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"productId":   "USDT001",
+			"redeemAll":   true,
+			"destAccount": SimpleEarnAccountTypeSpot,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRedeemSimpleEarnFlexibleService().ProductID("USDT001").RedeemAll(true).
+		DestAccount(SimpleEarnAccountTypeSpot).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnRedeemResponse{RedeemID: 40607, Success: true}, res)
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestListPositions() {
+	data := []byte(`{
+		"rows": [
+			{
+				"totalAmount": "75.46000000",
+				"tierAnnualPercentageRate": {"0-5BTC": 0.05, "5-10BTC": 0.03},
+				"latestAnnualPercentageRate": "0.02599895",
+				"yesterdayAirdropPercentageRate": "0.02599895",
+				"asset": "USDT",
+				"airDropAsset": "BETH",
+				"canRedeem": true,
+				"collateralAmount": "232.23123213",
+				"productId": "USDT001",
+				"yesterdayRealTimeRewards": "0.10293829",
+				"cumulativeBonusRewards": "0.22759183",
+				"cumulativeRealTimeRewards": "0.22759183",
+				"cumulativeTotalRewards": "0.45459183",
+				"autoSubscribe": true
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":     "USDT",
+			"productId": "USDT001",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnFlexiblePositionsService().Asset("USDT").ProductID("USDT001").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Rows, 1)
+	s.r().Equal("75.46000000", res.Rows[0].TotalAmount)
+	s.r().Equal("0.45459183", res.Rows[0].CumulativeTotalRewards)
+	s.r().True(res.Rows[0].AutoSubscribe)
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestListRewards() {
+	data := []byte(`{
+		"rows": [
+			{
+				"asset": "BUSD",
+				"rewards": "0.00006408",
+				"projectId": "USDT001",
+				"type": "REALTIME",
+				"time": 1577233578000
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"type":      SimpleEarnRewardTypeRealTime,
+			"asset":     "BUSD",
+			"startTime": 1577233578000,
+			"endTime":   1577233579000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnFlexibleRewardsService().Type(SimpleEarnRewardTypeRealTime).Asset("BUSD").
+		StartTime(1577233578000).EndTime(1577233579000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnFlexibleRewardHistory{
+		Rows: []*SimpleEarnFlexibleReward{{
+			Asset:     "BUSD",
+			Rewards:   "0.00006408",
+			ProjectID: "USDT001",
+			Type:      SimpleEarnRewardTypeRealTime,
+			Time:      1577233578000,
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *simpleEarnFlexibleServiceTestSuite) TestListSubscriptions() {
+	data := []byte(`{
+		"rows": [
+			{
+				"amount": "100.00000000",
+				"asset": "USDT",
+				"time": 1575018453000,
+				"purchaseId": 26055,
+				"productId": "USDT001",
+				"type": "AUTO",
+				"sourceAccount": "SPOT",
+				"amtFromSpot": "30",
+				"amtFromFunding": "70",
+				"status": "SUCCESS"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"purchaseId": 26055,
+			"current":    1,
+			"size":       100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnFlexibleSubscriptionsService().PurchaseID(26055).
+		Current(1).Size(100).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnFlexibleSubscriptionHistory{
+		Rows: []*SimpleEarnFlexibleSubscription{{
+			Amount:         "100.00000000",
+			Asset:          "USDT",
+			Time:           1575018453000,
+			PurchaseID:     26055,
+			ProductID:      "USDT001",
+			Type:           "AUTO",
+			SourceAccount:  SimpleEarnAccountTypeSpot,
+			AmtFromSpot:    "30",
+			AmtFromFunding: "70",
+			Status:         "SUCCESS",
+		}},
+		Total: 1,
+	}, res)
+}
@@ -0,0 +1,462 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ListSimpleEarnLockedProductsService list the simple earn locked products
+type ListSimpleEarnLockedProductsService struct {
+	c       *Client
+	asset   *string
+	current *int64
+	size    *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedProductsService) Asset(asset string) *ListSimpleEarnLockedProductsService {
+	s.asset = &asset
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnLockedProductsService) Current(current int64) *ListSimpleEarnLockedProductsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnLockedProductsService) Size(size int64) *ListSimpleEarnLockedProductsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnLockedProductsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnLockedProductList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/locked/list")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnLockedProductList)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedProductList define a page of simple earn locked products
+type SimpleEarnLockedProductList struct {
+	Rows  []*SimpleEarnLockedProduct `json:"rows"`
+	Total int64                      `json:"total"`
+}
+
+// SimpleEarnLockedProduct define simple earn locked product info
+type SimpleEarnLockedProduct struct {
+	ProjectID string                        `json:"projectId"`
+	Detail    SimpleEarnLockedProductDetail `json:"detail"`
+	Quota     SimpleEarnLockedProductQuota  `json:"quota"`
+}
+
+// SimpleEarnLockedProductDetail define the terms of a simple earn locked product
+type SimpleEarnLockedProductDetail struct {
+	Asset                 string `json:"asset"`
+	RewardAsset           string `json:"rewardAsset"`
+	Duration              int    `json:"duration"`
+	Renewable             bool   `json:"renewable"`
+	IsSoldOut             bool   `json:"isSoldOut"`
+	APR                   string `json:"apr"`
+	Status                string `json:"status"`
+	SubscriptionStartTime int64  `json:"subscriptionStartTime"`
+	ExtraRewardAsset      string `json:"extraRewardAsset"`
+	ExtraRewardAPR        string `json:"extraRewardAPR"`
+}
+
+// SimpleEarnLockedProductQuota define the subscription quota of a simple earn
+// locked product
+type SimpleEarnLockedProductQuota struct {
+	TotalPersonalQuota string `json:"totalPersonalQuota"`
+	Minimum            string `json:"minimum"`
+}
+
+// SubscribeSimpleEarnLockedService subscribe a simple earn locked product
+type SubscribeSimpleEarnLockedService struct {
+	c             *Client
+	projectID     string
+	amount        string
+	autoSubscribe *bool
+	sourceAccount *SimpleEarnAccountType
+}
+
+// ProjectID set projectID
+func (s *SubscribeSimpleEarnLockedService) ProjectID(projectID string) *SubscribeSimpleEarnLockedService {
+	s.projectID = projectID
+	return s
+}
+
+// Amount set the amount to subscribe
+func (s *SubscribeSimpleEarnLockedService) Amount(amount string) *SubscribeSimpleEarnLockedService {
+	s.amount = amount
+	return s
+}
+
+// AutoSubscribe set whether the position is subscribed again when it ends, default true
+func (s *SubscribeSimpleEarnLockedService) AutoSubscribe(autoSubscribe bool) *SubscribeSimpleEarnLockedService {
+	s.autoSubscribe = &autoSubscribe
+	return s
+}
+
+// SourceAccount set the account the amount is taken from, default SPOT
+func (s *SubscribeSimpleEarnLockedService) SourceAccount(sourceAccount SimpleEarnAccountType) *SubscribeSimpleEarnLockedService {
+	s.sourceAccount = &sourceAccount
+	return s
+}
+
+// Do send Request
+func (s *SubscribeSimpleEarnLockedService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnSubscribeResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/simple-earn/locked/subscribe")
+	r.SetFormParams(common.Params{
+		"projectId": s.projectID,
+		"amount":    s.amount,
+	})
+	if s.autoSubscribe != nil {
+		r.SetForm("autoSubscribe", *s.autoSubscribe)
+	}
+	if s.sourceAccount != nil {
+		r.SetForm("sourceAccount", *s.sourceAccount)
+	}
+
+	res = new(SimpleEarnSubscribeResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RedeemSimpleEarnLockedService redeem a simple earn locked position early
+type RedeemSimpleEarnLockedService struct {
+	c          *Client
+	positionID string
+}
+
+// PositionID set positionID
+func (s *RedeemSimpleEarnLockedService) PositionID(positionID string) *RedeemSimpleEarnLockedService {
+	s.positionID = positionID
+	return s
+}
+
+// Do send Request
+func (s *RedeemSimpleEarnLockedService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnRedeemResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/simple-earn/locked/redeem")
+	r.SetForm("positionId", s.positionID)
+
+	res = new(SimpleEarnRedeemResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSimpleEarnLockedPositionsService list the simple earn locked positions
+type ListSimpleEarnLockedPositionsService struct {
+	c          *Client
+	asset      *string
+	positionID *string
+	projectID  *string
+	current    *int64
+	size       *int64
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedPositionsService) Asset(asset string) *ListSimpleEarnLockedPositionsService {
+	s.asset = &asset
+	return s
+}
+
+// PositionID set positionID
+func (s *ListSimpleEarnLockedPositionsService) PositionID(positionID string) *ListSimpleEarnLockedPositionsService {
+	s.positionID = &positionID
+	return s
+}
+
+// ProjectID set projectID
+func (s *ListSimpleEarnLockedPositionsService) ProjectID(projectID string) *ListSimpleEarnLockedPositionsService {
+	s.projectID = &projectID
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnLockedPositionsService) Current(current int64) *ListSimpleEarnLockedPositionsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnLockedPositionsService) Size(size int64) *ListSimpleEarnLockedPositionsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnLockedPositionsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnLockedPositionList, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/locked/position")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.positionID != nil {
+		r.SetQuery("positionId", *s.positionID)
+	}
+	if s.projectID != nil {
+		r.SetQuery("projectId", *s.projectID)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnLockedPositionList)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedPositionList define a page of simple earn locked positions
+type SimpleEarnLockedPositionList struct {
+	Rows  []*SimpleEarnLockedPosition `json:"rows"`
+	Total int64                       `json:"total"`
+}
+
+// SimpleEarnLockedPosition define simple earn locked position info
+type SimpleEarnLockedPosition struct {
+	PositionID            int64  `json:"positionId"`
+	ProjectID             string `json:"projectId"`
+	Asset                 string `json:"asset"`
+	Amount                string `json:"amount"`
+	PurchaseTime          int64  `json:"purchaseTime"`
+	Duration              string `json:"duration"`
+	AccrualDays           string `json:"accrualDays"`
+	RewardAsset           string `json:"rewardAsset"`
+	APY                   string `json:"APY"`
+	RewardAmt             string `json:"rewardAmt"`
+	ExtraRewardAsset      string `json:"extraRewardAsset"`
+	ExtraRewardAPR        string `json:"extraRewardAPR"`
+	EstExtraRewardAmt     string `json:"estExtraRewardAmt"`
+	NextPay               string `json:"nextPay"`
+	NextPayDate           int64  `json:"nextPayDate"`
+	PayPeriod             int    `json:"payPeriod"`
+	RedeemAmountEarly     string `json:"redeemAmountEarly"`
+	RewardsEndDate        int64  `json:"rewardsEndDate"`
+	DeliverDate           int64  `json:"deliverDate"`
+	RedeemPeriod          int    `json:"redeemPeriod"`
+	RedeemingAmt          string `json:"redeemingAmt"`
+	RedeemTo              string `json:"redeemTo"`
+	PartialAmtDeliverDate int64  `json:"partialAmtDeliverDate"`
+	CanRedeemEarly        bool   `json:"canRedeemEarly"`
+	CanFastRedemption     bool   `json:"canFastRedemption"`
+	AutoSubscribe         bool   `json:"autoSubscribe"`
+	Type                  string `json:"type"`
+	Status                string `json:"status"`
+	CanReStake            bool   `json:"canReStake"`
+}
+
+// ListSimpleEarnLockedRewardsService list the rewards of the simple earn
+// locked positions
+type ListSimpleEarnLockedRewardsService struct {
+	c          *Client
+	positionID *string
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// PositionID set positionID
+func (s *ListSimpleEarnLockedRewardsService) PositionID(positionID string) *ListSimpleEarnLockedRewardsService {
+	s.positionID = &positionID
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedRewardsService) Asset(asset string) *ListSimpleEarnLockedRewardsService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set start time
+func (s *ListSimpleEarnLockedRewardsService) StartTime(startTime int64) *ListSimpleEarnLockedRewardsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSimpleEarnLockedRewardsService) EndTime(endTime int64) *ListSimpleEarnLockedRewardsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnLockedRewardsService) Current(current int64) *ListSimpleEarnLockedRewardsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnLockedRewardsService) Size(size int64) *ListSimpleEarnLockedRewardsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnLockedRewardsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnLockedRewardHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/locked/history/rewardsRecord")
+	if s.positionID != nil {
+		r.SetQuery("positionId", *s.positionID)
+	}
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnLockedRewardHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedRewardHistory define simple earn locked reward history
+type SimpleEarnLockedRewardHistory struct {
+	Rows  []*SimpleEarnLockedReward `json:"rows"`
+	Total int64                     `json:"total"`
+}
+
+// SimpleEarnLockedReward define simple earn locked reward history item
+type SimpleEarnLockedReward struct {
+	PositionID string `json:"positionId"`
+	Time       int64  `json:"time"`
+	Asset      string `json:"asset"`
+	LockPeriod string `json:"lockPeriod"`
+	Amount     string `json:"amount"`
+	Type       string `json:"type"`
+}
+
+// ListSimpleEarnLockedSubscriptionsService list the subscriptions of the
+// simple earn locked products
+type ListSimpleEarnLockedSubscriptionsService struct {
+	c          *Client
+	purchaseID *int64
+	asset      *string
+	startTime  *int64
+	endTime    *int64
+	current    *int64
+	size       *int64
+}
+
+// PurchaseID set purchaseID
+func (s *ListSimpleEarnLockedSubscriptionsService) PurchaseID(purchaseID int64) *ListSimpleEarnLockedSubscriptionsService {
+	s.purchaseID = &purchaseID
+	return s
+}
+
+// Asset set asset
+func (s *ListSimpleEarnLockedSubscriptionsService) Asset(asset string) *ListSimpleEarnLockedSubscriptionsService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime set start time
+func (s *ListSimpleEarnLockedSubscriptionsService) StartTime(startTime int64) *ListSimpleEarnLockedSubscriptionsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSimpleEarnLockedSubscriptionsService) EndTime(endTime int64) *ListSimpleEarnLockedSubscriptionsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current set current page, start from 1
+func (s *ListSimpleEarnLockedSubscriptionsService) Current(current int64) *ListSimpleEarnLockedSubscriptionsService {
+	s.current = &current
+	return s
+}
+
+// Size set page size, default:10 max:100
+func (s *ListSimpleEarnLockedSubscriptionsService) Size(size int64) *ListSimpleEarnLockedSubscriptionsService {
+	s.size = &size
+	return s
+}
+
+// Do send Request
+func (s *ListSimpleEarnLockedSubscriptionsService) Do(ctx context.Context, opts ...common.RequestOption) (res *SimpleEarnLockedSubscriptionHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/simple-earn/locked/history/subscriptionRecord")
+	if s.purchaseID != nil {
+		r.SetQuery("purchaseId", *s.purchaseID)
+	}
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+
+	res = new(SimpleEarnLockedSubscriptionHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SimpleEarnLockedSubscriptionHistory define simple earn locked subscription history
+type SimpleEarnLockedSubscriptionHistory struct {
+	Rows  []*SimpleEarnLockedSubscription `json:"rows"`
+	Total int64                           `json:"total"`
+}
+
+// SimpleEarnLockedSubscription define simple earn locked subscription history item
+type SimpleEarnLockedSubscription struct {
+	PositionID     int64                 `json:"positionId"`
+	PurchaseID     int64                 `json:"purchaseId"`
+	ProjectID      string                `json:"projectId"`
+	Time           int64                 `json:"time"`
+	Asset          string                `json:"asset"`
+	Amount         string                `json:"amount"`
+	LockPeriod     string                `json:"lockPeriod"`
+	Type           string                `json:"type"`
+	SourceAccount  SimpleEarnAccountType `json:"sourceAccount"`
+	AmtFromSpot    string                `json:"amtFromSpot"`
+	AmtFromFunding string                `json:"amtFromFunding"`
+	Status         string                `json:"status"`
+}
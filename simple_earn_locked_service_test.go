@@ -0,0 +1,251 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type simpleEarnLockedServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSimpleEarnLockedService(t *testing.T) {
+	suite.Run(t, new(simpleEarnLockedServiceTestSuite))
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestListProducts() {
+	data := []byte(`{
+		"rows": [
+			{
+				"projectId": "Axs*90",
+				"detail": {
+					"asset": "AXS",
+					"rewardAsset": "AXS",
+					"duration": 90,
+					"renewable": true,
+					"isSoldOut": true,
+					"apr": "1.2069",
+					"status": "CREATED",
+					"subscriptionStartTime": 1646182276000,
+					"extraRewardAsset": "BNB",
+					"extraRewardAPR": "0.23"
+				},
+				"quota": {
+					"totalPersonalQuota": "2",
+					"minimum": "0.001"
+				}
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("asset", "AXS")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnLockedProductsService().Asset("AXS").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnLockedProductList{
+		Rows: []*SimpleEarnLockedProduct{{
+			ProjectID: "Axs*90",
+			Detail: SimpleEarnLockedProductDetail{
+				Asset:                 "AXS",
+				RewardAsset:           "AXS",
+				Duration:              90,
+				Renewable:             true,
+				IsSoldOut:             true,
+				APR:                   "1.2069",
+				Status:                "CREATED",
+				SubscriptionStartTime: 1646182276000,
+				ExtraRewardAsset:      "BNB",
+				ExtraRewardAPR:        "0.23",
+			},
+			Quota: SimpleEarnLockedProductQuota{TotalPersonalQuota: "2", Minimum: "0.001"},
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestSubscribe() {
+	data := []byte(`{"purchaseId": 40607, "positionId": "12345", "success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"projectId":     "Axs*90",
+			"amount":        "1.5",
+			"autoSubscribe": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSubscribeSimpleEarnLockedService().ProjectID("Axs*90").Amount("1.5").
+		AutoSubscribe(true).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnSubscribeResponse{PurchaseID: 40607, PositionID: "12345", Success: true}, res)
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestRedeem() {
+	data := []byte(`{"redeemId": 40607, "success": true}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetForm("positionId", "12345")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewRedeemSimpleEarnLockedService().PositionID("12345").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnRedeemResponse{RedeemID: 40607, Success: true}, res)
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestListPositions() {
+	data := []byte(`{
+		"rows": [
+			{
+				"positionId": 123123,
+				"projectId": "Axs*90",
+				"asset": "AXS",
+				"amount": "122.09202928",
+				"purchaseTime": 1646182276000,
+				"duration": "60",
+				"accrualDays": "4",
+				"rewardAsset": "AXS",
+				"APY": "0.2032",
+				"rewardAmt": "5.17181528",
+				"nextPay": "1.29295383",
+				"nextPayDate": 1646697600000,
+				"payPeriod": 1,
+				"redeemAmountEarly": "2802.24068892",
+				"rewardsEndDate": 1651449600000,
+				"deliverDate": 1651536000000,
+				"redeemPeriod": 1,
+				"redeemingAmt": "232.2323",
+				"redeemTo": "FLEXIBLE",
+				"partialAmtDeliverDate": 1651536000000,
+				"canRedeemEarly": true,
+				"canFastRedemption": true,
+				"autoSubscribe": true,
+				"type": "AUTO",
+				"status": "HOLDING",
+				"canReStake": true
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":     "AXS",
+			"projectId": "Axs*90",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnLockedPositionsService().Asset("AXS").ProjectID("Axs*90").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res.Rows, 1)
+	s.r().Equal(int64(123123), res.Rows[0].PositionID)
+	s.r().Equal("0.2032", res.Rows[0].APY)
+	s.r().Equal(int64(1651536000000), res.Rows[0].DeliverDate)
+	s.r().True(res.Rows[0].CanRedeemEarly)
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestListRewards() {
+	data := []byte(`{
+		"rows": [
+			{
+				"positionId": "123123",
+				"time": 1575018453000,
+				"asset": "BNB",
+				"lockPeriod": "30",
+				"amount": "21312.23223",
+				"type": "Locked Rewards"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"positionId": "123123",
+			"current":    2,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnLockedRewardsService().PositionID("123123").Current(2).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnLockedRewardHistory{
+		Rows: []*SimpleEarnLockedReward{{
+			PositionID: "123123",
+			Time:       1575018453000,
+			Asset:      "BNB",
+			LockPeriod: "30",
+			Amount:     "21312.23223",
+			Type:       "Locked Rewards",
+		}},
+		Total: 1,
+	}, res)
+}
+
+func (s *simpleEarnLockedServiceTestSuite) TestListSubscriptions() {
+	data := []byte(`{
+		"rows": [
+			{
+				"positionId": 123123,
+				"purchaseId": 26055,
+				"projectId": "Axs*90",
+				"time": 1575018453000,
+				"asset": "BNB",
+				"amount": "21312.23223",
+				"lockPeriod": "30",
+				"type": "NORMAL",
+				"sourceAccount": "SPOT",
+				"amtFromSpot": "30",
+				"amtFromFunding": "70",
+				"status": "SUCCESS"
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":     "BNB",
+			"startTime": 1575018453000,
+			"endTime":   1575018454000,
+			"size":      100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSimpleEarnLockedSubscriptionsService().Asset("BNB").
+		StartTime(1575018453000).EndTime(1575018454000).Size(100).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SimpleEarnLockedSubscriptionHistory{
+		Rows: []*SimpleEarnLockedSubscription{{
+			PositionID:     123123,
+			PurchaseID:     26055,
+			ProjectID:      "Axs*90",
+			Time:           1575018453000,
+			Asset:          "BNB",
+			Amount:         "21312.23223",
+			LockPeriod:     "30",
+			Type:           "NORMAL",
+			SourceAccount:  SimpleEarnAccountTypeSpot,
+			AmtFromSpot:    "30",
+			AmtFromFunding: "70",
+			Status:         "SUCCESS",
+		}},
+		Total: 1,
+	}, res)
+}
@@ -0,0 +1,325 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// ListSubAccountsService list the sub-accounts of the master account
+type ListSubAccountsService struct {
+	c        *Client
+	email    *string
+	isFreeze *bool
+	page     *int
+	limit    *int
+}
+
+// Email set email
+func (s *ListSubAccountsService) Email(email string) *ListSubAccountsService {
+	s.email = &email
+	return s
+}
+
+// IsFreeze set isFreeze
+func (s *ListSubAccountsService) IsFreeze(isFreeze bool) *ListSubAccountsService {
+	s.isFreeze = &isFreeze
+	return s
+}
+
+// Page set page, start from 1
+func (s *ListSubAccountsService) Page(page int) *ListSubAccountsService {
+	s.page = &page
+	return s
+}
+
+// Limit set limit, default:1 max:200
+func (s *ListSubAccountsService) Limit(limit int) *ListSubAccountsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *ListSubAccountsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*SubAccount, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/sub-account/list")
+	if s.email != nil {
+		r.SetQuery("email", *s.email)
+	}
+	if s.isFreeze != nil {
+		r.SetQuery("isFreeze", *s.isFreeze)
+	}
+	if s.page != nil {
+		r.SetQuery("page", *s.page)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	data := new(struct {
+		SubAccounts []*SubAccount `json:"subAccounts"`
+	})
+	if err = s.c.CallAPI(ctx, r, data, opts...); err != nil {
+		return nil, err
+	}
+	return data.SubAccounts, nil
+}
+
+// SubAccount define sub-account info
+type SubAccount struct {
+	Email                       string `json:"email"`
+	IsFreeze                    bool   `json:"isFreeze"`
+	CreateTime                  int64  `json:"createTime"`
+	IsManagedSubAccount         bool   `json:"isManagedSubAccount"`
+	IsAssetManagementSubAccount bool   `json:"isAssetManagementSubAccount"`
+}
+
+// CreateVirtualSubAccountService create a sub-account with a virtual email
+type CreateVirtualSubAccountService struct {
+	c                *Client
+	subAccountString string
+}
+
+// SubAccountString set the string the virtual email is made of
+func (s *CreateVirtualSubAccountService) SubAccountString(subAccountString string) *CreateVirtualSubAccountService {
+	s.subAccountString = subAccountString
+	return s
+}
+
+// Do send Request
+func (s *CreateVirtualSubAccountService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountEmail, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/sub-account/virtualSubAccount")
+	r.SetForm("subAccountString", s.subAccountString)
+
+	res = new(SubAccountEmail)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountEmail define the email of a created sub-account
+type SubAccountEmail struct {
+	Email string `json:"email"`
+}
+
+// GetSubAccountAssetsService get the spot assets of a sub-account
+type GetSubAccountAssetsService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *GetSubAccountAssetsService) Email(email string) *GetSubAccountAssetsService {
+	s.email = email
+	return s
+}
+
+// Do send Request
+func (s *GetSubAccountAssetsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*SubAccountBalance, err error) {
+	r := common.NewGetRequestSigned("/sapi/v3/sub-account/assets")
+	r.SetQuery("email", s.email)
+
+	data := new(struct {
+		Balances []*SubAccountBalance `json:"balances"`
+	})
+	if err = s.c.CallAPI(ctx, r, data, opts...); err != nil {
+		return nil, err
+	}
+	return data.Balances, nil
+}
+
+// SubAccountBalance define the balance of an asset of a sub-account
+type SubAccountBalance struct {
+	Asset  string `json:"asset"`
+	Free   string `json:"free"`
+	Locked string `json:"locked"`
+}
+
+// GetSubAccountFuturesSummaryService get the summary of the futures accounts
+// of the sub-accounts
+type GetSubAccountFuturesSummaryService struct {
+	c           *Client
+	futuresType SubAccountFuturesType
+	page        *int
+	limit       *int
+}
+
+// FuturesType set the futures type, USDⓈ-M or COIN-M
+func (s *GetSubAccountFuturesSummaryService) FuturesType(futuresType SubAccountFuturesType) *GetSubAccountFuturesSummaryService {
+	s.futuresType = futuresType
+	return s
+}
+
+// Page set page, start from 1
+func (s *GetSubAccountFuturesSummaryService) Page(page int) *GetSubAccountFuturesSummaryService {
+	s.page = &page
+	return s
+}
+
+// Limit set limit, default:10 max:20
+func (s *GetSubAccountFuturesSummaryService) Limit(limit int) *GetSubAccountFuturesSummaryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *GetSubAccountFuturesSummaryService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountFuturesSummary, err error) {
+	r := common.NewGetRequestSigned("/sapi/v2/sub-account/futures/accountSummary")
+	r.SetQuery("futuresType", s.futuresType)
+	if s.page != nil {
+		r.SetQuery("page", *s.page)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = new(SubAccountFuturesSummary)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesSummary define the futures summary of the sub-accounts,
+// only the summary of the requested futures type is set
+type SubAccountFuturesSummary struct {
+	FutureAccountSummary   *SubAccountUSDFuturesSummary  `json:"futureAccountSummaryResp"`
+	DeliveryAccountSummary *SubAccountCoinFuturesSummary `json:"deliveryAccountSummaryResp"`
+}
+
+// SubAccountUSDFuturesSummary define the USDⓈ-M futures summary of the sub-accounts
+type SubAccountUSDFuturesSummary struct {
+	TotalInitialMargin          string                         `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string                         `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string                         `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string                         `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string                         `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string                         `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string                         `json:"totalWalletBalance"`
+	Asset                       string                         `json:"asset"`
+	SubAccountList              []*SubAccountUSDFuturesAccount `json:"subAccountList"`
+}
+
+// SubAccountUSDFuturesAccount define the USDⓈ-M futures summary of a sub-account
+type SubAccountUSDFuturesAccount struct {
+	Email                       string `json:"email"`
+	TotalInitialMargin          string `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      string `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          string `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin string `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  string `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          string `json:"totalWalletBalance"`
+	Asset                       string `json:"asset"`
+}
+
+// SubAccountCoinFuturesSummary define the COIN-M futures summary of the sub-accounts
+type SubAccountCoinFuturesSummary struct {
+	TotalMarginBalanceOfBTC    string                          `json:"totalMarginBalanceOfBTC"`
+	TotalUnrealizedProfitOfBTC string                          `json:"totalUnrealizedProfitOfBTC"`
+	TotalWalletBalanceOfBTC    string                          `json:"totalWalletBalanceOfBTC"`
+	Asset                      string                          `json:"asset"`
+	SubAccountList             []*SubAccountCoinFuturesAccount `json:"subAccountList"`
+}
+
+// SubAccountCoinFuturesAccount define the COIN-M futures summary of a sub-account
+type SubAccountCoinFuturesAccount struct {
+	Email                 string `json:"email"`
+	TotalMarginBalance    string `json:"totalMarginBalance"`
+	TotalUnrealizedProfit string `json:"totalUnrealizedProfit"`
+	TotalWalletBalance    string `json:"totalWalletBalance"`
+	Asset                 string `json:"asset"`
+}
+
+// GetSubAccountMarginSummaryService get the summary of the margin accounts of
+// the sub-accounts
+type GetSubAccountMarginSummaryService struct {
+	c *Client
+}
+
+// Do send Request
+func (s *GetSubAccountMarginSummaryService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountMarginSummary, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/sub-account/margin/accountSummary")
+
+	res = new(SubAccountMarginSummary)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountMarginSummary define the margin summary of the sub-accounts
+type SubAccountMarginSummary struct {
+	TotalAssetOfBTC     string                     `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBTC string                     `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBTC  string                     `json:"totalNetAssetOfBtc"`
+	SubAccountList      []*SubAccountMarginAccount `json:"subAccountList"`
+}
+
+// SubAccountMarginAccount define the margin summary of a sub-account
+type SubAccountMarginAccount struct {
+	Email               string `json:"email"`
+	TotalAssetOfBTC     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBTC string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBTC  string `json:"totalNetAssetOfBtc"`
+}
+
+// EnableSubAccountFuturesService enable futures for a sub-account
+type EnableSubAccountFuturesService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *EnableSubAccountFuturesService) Email(email string) *EnableSubAccountFuturesService {
+	s.email = email
+	return s
+}
+
+// Do send Request
+func (s *EnableSubAccountFuturesService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountFuturesStatus, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/sub-account/futures/enable")
+	r.SetForm("email", s.email)
+
+	res = new(SubAccountFuturesStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountFuturesStatus define the futures status of a sub-account
+type SubAccountFuturesStatus struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+// EnableSubAccountMarginService enable margin for a sub-account
+type EnableSubAccountMarginService struct {
+	c     *Client
+	email string
+}
+
+// Email set email
+func (s *EnableSubAccountMarginService) Email(email string) *EnableSubAccountMarginService {
+	s.email = email
+	return s
+}
+
+// Do send Request
+func (s *EnableSubAccountMarginService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountMarginStatus, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/sub-account/margin/enable")
+	r.SetForm("email", s.email)
+
+	res = new(SubAccountMarginStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountMarginStatus define the margin status of a sub-account
+type SubAccountMarginStatus struct {
+	Email           string `json:"email"`
+	IsMarginEnabled bool   `json:"isMarginEnabled"`
+}
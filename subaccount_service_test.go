@@ -0,0 +1,200 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type subAccountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSubAccountService(t *testing.T) {
+	suite.Run(t, new(subAccountServiceTestSuite))
+}
+
+func (s *subAccountServiceTestSuite) TestListSubAccounts() {
+	data := []byte(`{
+		"subAccounts": [
+			{
+				"email": "testsub@gmail.com",
+				"isFreeze": false,
+				"createTime": 1544433328000,
+				"isManagedSubAccount": false,
+				"isAssetManagementSubAccount": false
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"isFreeze": false,
+			"page":     1,
+			"limit":    10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSubAccountsService().IsFreeze(false).Page(1).Limit(10).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*SubAccount{{Email: "testsub@gmail.com", CreateTime: 1544433328000}}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestCreateVirtualSubAccount() {
+	data := []byte(`{
+		"email": "addsdd_virtual@aasaixwqnoemail.com"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"subAccountString": "addsdd"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateVirtualSubAccountService().SubAccountString("addsdd").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountEmail{Email: "addsdd_virtual@aasaixwqnoemail.com"}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestGetSubAccountAssets() {
+	data := []byte(`{
+		"balances": [
+			{"asset": "ADA", "free": "10000", "locked": "0"},
+			{"asset": "BNB", "free": "10003", "locked": "1"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{"email": "testsub@gmail.com"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSubAccountAssetsService().Email("testsub@gmail.com").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*SubAccountBalance{
+		{Asset: "ADA", Free: "10000", Locked: "0"},
+		{Asset: "BNB", Free: "10003", Locked: "1"},
+	}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestGetSubAccountFuturesSummary() {
+	data := []byte(`{
+		"deliveryAccountSummaryResp": {
+			"totalMarginBalanceOfBTC": "25.03221121",
+			"totalUnrealizedProfitOfBTC": "0.12233410",
+			"totalWalletBalanceOfBTC": "22.15879444",
+			"asset": "BTC",
+			"subAccountList": [
+				{
+					"email": "123@test.com",
+					"totalMarginBalance": "22.12659734",
+					"totalUnrealizedProfit": "0.00000000",
+					"totalWalletBalance": "22.12659734",
+					"asset": "BTC"
+				}
+			]
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"futuresType": SubAccountFuturesTypeCoin,
+			"limit":       20,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSubAccountFuturesSummaryService().FuturesType(SubAccountFuturesTypeCoin).Limit(20).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Nil(res.FutureAccountSummary)
+	s.r().Equal(&SubAccountCoinFuturesSummary{
+		TotalMarginBalanceOfBTC:    "25.03221121",
+		TotalUnrealizedProfitOfBTC: "0.12233410",
+		TotalWalletBalanceOfBTC:    "22.15879444",
+		Asset:                      "BTC",
+		SubAccountList: []*SubAccountCoinFuturesAccount{{
+			Email:                 "123@test.com",
+			TotalMarginBalance:    "22.12659734",
+			TotalUnrealizedProfit: "0.00000000",
+			TotalWalletBalance:    "22.12659734",
+			Asset:                 "BTC",
+		}},
+	}, res.DeliveryAccountSummary)
+}
+
+func (s *subAccountServiceTestSuite) TestGetSubAccountMarginSummary() {
+	data := []byte(`{
+		"totalAssetOfBtc": "4.33333333",
+		"totalLiabilityOfBtc": "2.11111112",
+		"totalNetAssetOfBtc": "2.22222221",
+		"subAccountList": [
+			{
+				"email": "123@test.com",
+				"totalAssetOfBtc": "2.11111111",
+				"totalLiabilityOfBtc": "1.11111111",
+				"totalNetAssetOfBtc": "1.00000000"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSubAccountMarginSummaryService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountMarginSummary{
+		TotalAssetOfBTC:     "4.33333333",
+		TotalLiabilityOfBTC: "2.11111112",
+		TotalNetAssetOfBTC:  "2.22222221",
+		SubAccountList: []*SubAccountMarginAccount{{
+			Email:               "123@test.com",
+			TotalAssetOfBTC:     "2.11111111",
+			TotalLiabilityOfBTC: "1.11111111",
+			TotalNetAssetOfBTC:  "1.00000000",
+		}},
+	}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestEnableSubAccountFutures() {
+	data := []byte(`{
+		"email": "123@test.com",
+		"isFuturesEnabled": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"email": "123@test.com"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewEnableSubAccountFuturesService().Email("123@test.com").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountFuturesStatus{Email: "123@test.com", IsFuturesEnabled: true}, res)
+}
+
+func (s *subAccountServiceTestSuite) TestEnableSubAccountMargin() {
+	data := []byte(`{
+		"email": "123@test.com",
+		"isMarginEnabled": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{"email": "123@test.com"})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewEnableSubAccountMarginService().Email("123@test.com").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountMarginStatus{Email: "123@test.com", IsMarginEnabled: true}, res)
+}
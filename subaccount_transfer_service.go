@@ -0,0 +1,338 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// SubAccountTransferService transfer asset between the accounts of the master
+// account and of its sub-accounts, an empty email stands for the master account
+type SubAccountTransferService struct {
+	c               *Client
+	fromEmail       *string
+	toEmail         *string
+	fromAccountType SubAccountAccountType
+	toAccountType   SubAccountAccountType
+	clientTranID    *string
+	symbol          *string
+	asset           string
+	amount          string
+}
+
+// FromEmail set the email of the sub-account transferred from
+func (s *SubAccountTransferService) FromEmail(fromEmail string) *SubAccountTransferService {
+	s.fromEmail = &fromEmail
+	return s
+}
+
+// ToEmail set the email of the sub-account transferred to
+func (s *SubAccountTransferService) ToEmail(toEmail string) *SubAccountTransferService {
+	s.toEmail = &toEmail
+	return s
+}
+
+// FromAccountType set the account type transferred from
+func (s *SubAccountTransferService) FromAccountType(fromAccountType SubAccountAccountType) *SubAccountTransferService {
+	s.fromAccountType = fromAccountType
+	return s
+}
+
+// ToAccountType set the account type transferred to
+func (s *SubAccountTransferService) ToAccountType(toAccountType SubAccountAccountType) *SubAccountTransferService {
+	s.toAccountType = toAccountType
+	return s
+}
+
+// ClientTranID set a unique id of the transfer
+func (s *SubAccountTransferService) ClientTranID(clientTranID string) *SubAccountTransferService {
+	s.clientTranID = &clientTranID
+	return s
+}
+
+// Symbol set the symbol of an isolated margin account
+func (s *SubAccountTransferService) Symbol(symbol string) *SubAccountTransferService {
+	s.symbol = &symbol
+	return s
+}
+
+// Asset set asset being transferred, e.g., BTC
+func (s *SubAccountTransferService) Asset(asset string) *SubAccountTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount the amount to be transferred
+func (s *SubAccountTransferService) Amount(amount string) *SubAccountTransferService {
+	s.amount = amount
+	return s
+}
+
+// Do send Request
+func (s *SubAccountTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountTransferResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/sub-account/universalTransfer")
+	m := common.Params{
+		"fromAccountType": s.fromAccountType,
+		"toAccountType":   s.toAccountType,
+		"asset":           s.asset,
+		"amount":          s.amount,
+	}
+	if s.fromEmail != nil {
+		m["fromEmail"] = *s.fromEmail
+	}
+	if s.toEmail != nil {
+		m["toEmail"] = *s.toEmail
+	}
+	if s.clientTranID != nil {
+		m["clientTranId"] = *s.clientTranID
+	}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	r.SetFormParams(m)
+
+	res = new(SubAccountTransferResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountTransferResponse define sub-account transfer response
+type SubAccountTransferResponse struct {
+	TranID       int64  `json:"tranId"`
+	ClientTranID string `json:"clientTranId"`
+}
+
+// ListSubAccountTransfersService list the transfers between the master account
+// and its sub-accounts
+type ListSubAccountTransfersService struct {
+	c            *Client
+	fromEmail    *string
+	toEmail      *string
+	clientTranID *string
+	startTime    *int64
+	endTime      *int64
+	page         *int
+	limit        *int
+}
+
+// FromEmail set the email of the sub-account transferred from
+func (s *ListSubAccountTransfersService) FromEmail(fromEmail string) *ListSubAccountTransfersService {
+	s.fromEmail = &fromEmail
+	return s
+}
+
+// ToEmail set the email of the sub-account transferred to
+func (s *ListSubAccountTransfersService) ToEmail(toEmail string) *ListSubAccountTransfersService {
+	s.toEmail = &toEmail
+	return s
+}
+
+// ClientTranID set clientTranID
+func (s *ListSubAccountTransfersService) ClientTranID(clientTranID string) *ListSubAccountTransfersService {
+	s.clientTranID = &clientTranID
+	return s
+}
+
+// StartTime set start time
+func (s *ListSubAccountTransfersService) StartTime(startTime int64) *ListSubAccountTransfersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSubAccountTransfersService) EndTime(endTime int64) *ListSubAccountTransfersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Page set page, start from 1
+func (s *ListSubAccountTransfersService) Page(page int) *ListSubAccountTransfersService {
+	s.page = &page
+	return s
+}
+
+// Limit set limit, default:500 max:500
+func (s *ListSubAccountTransfersService) Limit(limit int) *ListSubAccountTransfersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send Request
+func (s *ListSubAccountTransfersService) Do(ctx context.Context, opts ...common.RequestOption) (res *SubAccountTransferHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/sub-account/universalTransfer")
+	if s.fromEmail != nil {
+		r.SetQuery("fromEmail", *s.fromEmail)
+	}
+	if s.toEmail != nil {
+		r.SetQuery("toEmail", *s.toEmail)
+	}
+	if s.clientTranID != nil {
+		r.SetQuery("clientTranId", *s.clientTranID)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.page != nil {
+		r.SetQuery("page", *s.page)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = new(SubAccountTransferHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountTransferHistory define sub-account transfer history
+type SubAccountTransferHistory struct {
+	Result     []*SubAccountTransfer `json:"result"`
+	TotalCount int64                 `json:"totalCount"`
+}
+
+// SubAccountTransfer define sub-account transfer history item
+type SubAccountTransfer struct {
+	TranID          int64                 `json:"tranId"`
+	FromEmail       string                `json:"fromEmail"`
+	ToEmail         string                `json:"toEmail"`
+	Asset           string                `json:"asset"`
+	Amount          string                `json:"amount"`
+	CreateTimeStamp int64                 `json:"createTimeStamp"`
+	FromAccountType SubAccountAccountType `json:"fromAccountType"`
+	ToAccountType   SubAccountAccountType `json:"toAccountType"`
+	Status          string                `json:"status"`
+	ClientTranID    string                `json:"clientTranId"`
+}
+
+// GetSubAccountDepositAddressService get the deposit address of a sub-account
+type GetSubAccountDepositAddressService struct {
+	c       *Client
+	email   string
+	coin    string
+	network *string
+}
+
+// Email set email
+func (s *GetSubAccountDepositAddressService) Email(email string) *GetSubAccountDepositAddressService {
+	s.email = email
+	return s
+}
+
+// Coin set coin
+func (s *GetSubAccountDepositAddressService) Coin(coin string) *GetSubAccountDepositAddressService {
+	s.coin = coin
+	return s
+}
+
+// Network set network
+func (s *GetSubAccountDepositAddressService) Network(network string) *GetSubAccountDepositAddressService {
+	s.network = &network
+	return s
+}
+
+// Do send Request
+func (s *GetSubAccountDepositAddressService) Do(ctx context.Context, opts ...common.RequestOption) (res *GetDepositAddressResponse, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/capital/deposit/subAddress")
+	r.SetQuery("email", s.email)
+	r.SetQuery("coin", s.coin)
+	if s.network != nil {
+		r.SetQuery("network", *s.network)
+	}
+
+	res = new(GetDepositAddressResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSubAccountDepositsService list the deposits of a sub-account
+type ListSubAccountDepositsService struct {
+	c         *Client
+	email     string
+	coin      *string
+	status    *int
+	startTime *int64
+	endTime   *int64
+	limit     *int
+	offset    *int
+}
+
+// Email set email
+func (s *ListSubAccountDepositsService) Email(email string) *ListSubAccountDepositsService {
+	s.email = email
+	return s
+}
+
+// Coin set coin
+func (s *ListSubAccountDepositsService) Coin(coin string) *ListSubAccountDepositsService {
+	s.coin = &coin
+	return s
+}
+
+// Status set status, 0:pending 6:credited but cannot withdraw 1:success
+func (s *ListSubAccountDepositsService) Status(status int) *ListSubAccountDepositsService {
+	s.status = &status
+	return s
+}
+
+// StartTime set start time
+func (s *ListSubAccountDepositsService) StartTime(startTime int64) *ListSubAccountDepositsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListSubAccountDepositsService) EndTime(endTime int64) *ListSubAccountDepositsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListSubAccountDepositsService) Limit(limit int) *ListSubAccountDepositsService {
+	s.limit = &limit
+	return s
+}
+
+// Offset set offset
+func (s *ListSubAccountDepositsService) Offset(offset int) *ListSubAccountDepositsService {
+	s.offset = &offset
+	return s
+}
+
+// Do send Request
+func (s *ListSubAccountDepositsService) Do(ctx context.Context, opts ...common.RequestOption) (res []*Deposit, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/capital/deposit/subHisrec")
+	r.SetQuery("email", s.email)
+	if s.coin != nil {
+		r.SetQuery("coin", *s.coin)
+	}
+	if s.status != nil {
+		r.SetQuery("status", *s.status)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+	if s.offset != nil {
+		r.SetQuery("offset", *s.offset)
+	}
+
+	res = make([]*Deposit, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type subAccountTransferServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSubAccountTransferService(t *testing.T) {
+	suite.Run(t, new(subAccountTransferServiceTestSuite))
+}
+
+func (s *subAccountTransferServiceTestSuite) TestTransfer() {
+	data := []byte(`{
+		"tranId": 11945860693,
+		"clientTranId": "test"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"fromEmail":       "a@test.com",
+			"toEmail":         "b@test.com",
+			"fromAccountType": SubAccountAccountTypeSpot,
+			"toAccountType":   SubAccountAccountTypeUSDTFuture,
+			"clientTranId":    "test",
+			"asset":           "USDT",
+			"amount":          "100",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSubAccountTransferService().FromEmail("a@test.com").ToEmail("b@test.com").
+		FromAccountType(SubAccountAccountTypeSpot).ToAccountType(SubAccountAccountTypeUSDTFuture).
+		ClientTranID("test").Asset("USDT").Amount("100").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountTransferResponse{TranID: 11945860693, ClientTranID: "test"}, res)
+}
+
+func (s *subAccountTransferServiceTestSuite) TestListTransfers() {
+	data := []byte(`{
+		"result": [
+			{
+				"tranId": 92275823339,
+				"fromEmail": "abctest@gmail.com",
+				"toEmail": "deftest@gmail.com",
+				"asset": "BNB",
+				"amount": "0.01",
+				"createTimeStamp": 1640317374000,
+				"fromAccountType": "USDT_FUTURE",
+				"toAccountType": "SPOT",
+				"status": "SUCCESS",
+				"clientTranId": "test"
+			}
+		],
+		"totalCount": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"fromEmail": "abctest@gmail.com",
+			"startTime": 1640317000000,
+			"limit":     100,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSubAccountTransfersService().FromEmail("abctest@gmail.com").
+		StartTime(1640317000000).Limit(100).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SubAccountTransferHistory{
+		Result: []*SubAccountTransfer{{
+			TranID:          92275823339,
+			FromEmail:       "abctest@gmail.com",
+			ToEmail:         "deftest@gmail.com",
+			Asset:           "BNB",
+			Amount:          "0.01",
+			CreateTimeStamp: 1640317374000,
+			FromAccountType: SubAccountAccountTypeUSDTFuture,
+			ToAccountType:   SubAccountAccountTypeSpot,
+			Status:          "SUCCESS",
+			ClientTranID:    "test",
+		}},
+		TotalCount: 1,
+	}, res)
+}
+
+func (s *subAccountTransferServiceTestSuite) TestGetDepositAddress() {
+	data := []byte(`{
+		"address": "TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+		"coin": "USDT",
+		"tag": "",
+		"url": "https://tronscan.org/#/address/TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"email":   "a@test.com",
+			"coin":    "USDT",
+			"network": "TRX",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSubAccountDepositAddressService().Email("a@test.com").Coin("USDT").Network("TRX").
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&GetDepositAddressResponse{
+		Address: "TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+		Coin:    "USDT",
+		URL:     "https://tronscan.org/#/address/TDunhSa7jkTNuKrusUTU1MUHtqXoBPKETV",
+	}, res)
+}
+
+func (s *subAccountTransferServiceTestSuite) TestListDeposits() {
+	data := []byte(`[
+		{
+			"amount": "0.00999800",
+			"coin": "PAXG",
+			"network": "ETH",
+			"status": 1,
+			"address": "0x788cabe9236ce061e5a892e1a59395a81fc8d62c",
+			"addressTag": "",
+			"txId": "0xaad4654a3234aa6118af9b4b335f5ae81c360b2394721c019b5d1e75328b09f3",
+			"insertTime": 1599621997000,
+			"transferType": 0,
+			"confirmTimes": "12/12"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"email":  "a@test.com",
+			"coin":   "PAXG",
+			"status": 1,
+			"offset": 0,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListSubAccountDepositsService().Email("a@test.com").Coin("PAXG").Status(1).Offset(0).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("0.00999800", res[0].Amount)
+	s.r().Equal("ETH", res[0].Network)
+	s.r().Equal(int64(1599621997000), res[0].InsertTime)
+	s.r().Equal("12/12", res[0].ConfirmTimes)
+}
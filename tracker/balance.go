@@ -0,0 +1,384 @@
+package tracker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+)
+
+const defaultBalanceTolerance = 1e-8
+
+// Balance is the tracked balance of one asset. Borrowed and Interest are only
+// known for margin accounts, they are refreshed by reconciliations as the user
+// data stream does not report them.
+type Balance struct {
+	Asset      string
+	Free       float64
+	Locked     float64
+	Borrowed   float64
+	Interest   float64
+	UpdateTime int64
+}
+
+// Total returns the free and locked amount.
+func (b *Balance) Total() float64 {
+	return b.Free + b.Locked
+}
+
+// NetAsset returns the total amount minus the debt.
+func (b *Balance) NetAsset() float64 {
+	return b.Free + b.Locked - b.Borrowed - b.Interest
+}
+
+// agrees tells if b and o are the same balance within tolerance.
+func (b *Balance) agrees(o *Balance, tolerance float64) bool {
+	return math.Abs(b.Free-o.Free) <= tolerance && math.Abs(b.Locked-o.Locked) <= tolerance &&
+		math.Abs(b.Borrowed-o.Borrowed) <= tolerance && math.Abs(b.Interest-o.Interest) <= tolerance
+}
+
+// balanceSource reads the balances of one account from the REST API. time is
+// the update time of the snapshot, zero when the API does not report it.
+type balanceSource interface {
+	balances(ctx context.Context) (res []Balance, time int64, err error)
+}
+
+type spotBalanceSource struct {
+	api binance.AccountAPI
+}
+
+func (s spotBalanceSource) balances(ctx context.Context) (res []Balance, time int64, err error) {
+	account, err := s.api.GetAccount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, b := range account.Balances {
+		res = append(res, Balance{Asset: b.Asset, Free: b.Free, Locked: b.Locked})
+	}
+	return res, int64(account.UpdateTime), nil
+}
+
+type marginBalanceSource struct {
+	api binance.MarginAPI
+}
+
+func (s marginBalanceSource) balances(ctx context.Context) (res []Balance, time int64, err error) {
+	account, err := s.api.GetMarginAccount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, a := range account.UserAssets {
+		res = append(res, Balance{
+			Asset:    a.Asset,
+			Free:     parseFloat(a.Free),
+			Locked:   parseFloat(a.Locked),
+			Borrowed: parseFloat(a.Borrowed),
+			Interest: parseFloat(a.Interest),
+		})
+	}
+	return res, 0, nil
+}
+
+type isolatedMarginBalanceSource struct {
+	api    binance.MarginAPI
+	symbol string
+}
+
+func (s isolatedMarginBalanceSource) balances(ctx context.Context) (res []Balance, time int64, err error) {
+	account, err := s.api.GetIsolatedMarginAccount(ctx, []string{s.symbol})
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, pair := range account.Assets {
+		if pair.Symbol != s.symbol {
+			continue
+		}
+		for _, a := range []binance.IsolatedUserAsset{pair.BaseAsset, pair.QuoteAsset} {
+			res = append(res, Balance{
+				Asset:    a.Asset,
+				Free:     parseFloat(a.Free),
+				Locked:   parseFloat(a.Locked),
+				Borrowed: parseFloat(a.Borrowed),
+				Interest: parseFloat(a.Interest),
+			})
+		}
+	}
+	return res, 0, nil
+}
+
+// BalanceTrackerConfig define the balance tracker options.
+type BalanceTrackerConfig struct {
+	// ReconcileInterval is the period of the reconciliation done by Run, one
+	// minute by default.
+	ReconcileInterval time.Duration
+	// Tolerance is the absolute difference under which a tracked and a
+	// snapshot balance agree, 1e-8 by default.
+	Tolerance float64
+	// OnChange is called after every balance change. It is called without
+	// holding any lock.
+	OnChange func(b Balance)
+	// OnDrift is called when a reconciliation finds a balance which does not
+	// agree with the snapshot, before the snapshot replaces it.
+	OnDrift func(tracked, snapshot Balance)
+	// OnError receives the reconciliation errors of Run.
+	OnError func(err error)
+	// Now returns the current time, it dates the snapshots of margin accounts.
+	Now func() time.Time
+	// TimeOffset returns the local time minus the server time in milliseconds,
+	// usually the GetTimeOffset method of the client once its time offset is
+	// set. Margin snapshots are dated with the server time so that they compare
+	// with the event times of the stream.
+	TimeOffset func() int64
+}
+
+// BalanceTracker keeps the balances of one spot, cross margin or isolated
+// margin account. Feed it with Update from the user data stream of the
+// account and call Reconcile, or Run, to bootstrap and to correct drift.
+type BalanceTracker struct {
+	config BalanceTrackerConfig
+	source balanceSource
+
+	lock     sync.Mutex
+	balances map[string]*Balance
+	// deltas holds, by asset, the balanceUpdate events applied at the update
+	// time of the balance. It is empty when that time comes from a snapshot,
+	// which already counts every delta of the same time.
+	deltas map[string]map[balanceDelta]bool
+}
+
+// balanceDelta identifies a balanceUpdate event, which carries no id.
+type balanceDelta struct {
+	time      int64
+	clearTime int64
+	delta     string
+}
+
+// NewSpotBalanceTracker creates a tracker for the spot account, api is usually
+// a *binance.Client.
+func NewSpotBalanceTracker(api binance.AccountAPI, config BalanceTrackerConfig) *BalanceTracker {
+	return newBalanceTracker(spotBalanceSource{api: api}, config)
+}
+
+// NewMarginBalanceTracker creates a tracker for the cross margin account, api
+// is usually a *binance.Client.
+func NewMarginBalanceTracker(api binance.MarginAPI, config BalanceTrackerConfig) *BalanceTracker {
+	return newBalanceTracker(marginBalanceSource{api: api}, config)
+}
+
+// NewIsolatedMarginBalanceTracker creates a tracker for the isolated margin
+// account of symbol, api is usually a *binance.Client.
+func NewIsolatedMarginBalanceTracker(api binance.MarginAPI, symbol string,
+	config BalanceTrackerConfig,
+) *BalanceTracker {
+	return newBalanceTracker(isolatedMarginBalanceSource{api: api, symbol: symbol}, config)
+}
+
+func newBalanceTracker(source balanceSource, config BalanceTrackerConfig) *BalanceTracker {
+	if config.ReconcileInterval <= 0 {
+		config.ReconcileInterval = time.Minute
+	}
+	if config.Tolerance <= 0 {
+		config.Tolerance = defaultBalanceTolerance
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &BalanceTracker{
+		config:   config,
+		source:   source,
+		balances: make(map[string]*Balance),
+		deltas:   make(map[string]map[balanceDelta]bool),
+	}
+}
+
+// Update applies an outboundAccountPosition or balanceUpdate event, other
+// events are ignored. It returns if a balance changed.
+func (t *BalanceTracker) Update(e *binance.WsUserDataEvent) bool {
+	switch {
+	case e.AccountPosition != nil:
+		return t.UpdateAccountPosition(e.AccountPosition)
+	case e.BalanceUpdate != nil:
+		return t.UpdateBalance(e.BalanceUpdate)
+	}
+	return false
+}
+
+// UpdateAccountPosition sets the free and locked amounts of the event
+// balances, balances updated after the event are kept.
+func (t *BalanceTracker) UpdateAccountPosition(e *binance.WsOutboundAccountPositionEvent) bool {
+	var changed []Balance
+	t.lock.Lock()
+	for _, b := range e.Balances {
+		current := t.balance(b.Asset)
+		if e.LastUpdateTime < current.UpdateTime {
+			continue
+		}
+		current.Free, current.Locked = parseFloat(b.Free), parseFloat(b.Locked)
+		current.UpdateTime = e.LastUpdateTime
+		delete(t.deltas, b.Asset)
+		changed = append(changed, *current)
+	}
+	t.lock.Unlock()
+	t.notify(changed...)
+	return len(changed) > 0
+}
+
+// UpdateBalance adds the delta of the event to the free amount. Deltas older
+// than the balance, or as old as a snapshot of it, are already counted and
+// ignored, as are replayed events.
+func (t *BalanceTracker) UpdateBalance(e *binance.WsBalanceUpdateEvent) bool {
+	key := balanceDelta{time: e.Time, clearTime: e.ClearTime, delta: e.Delta}
+	t.lock.Lock()
+	current := t.balance(e.Asset)
+	seen := t.deltas[e.Asset]
+	if e.ClearTime < current.UpdateTime ||
+		e.ClearTime == current.UpdateTime && (len(seen) == 0 || seen[key]) {
+		t.lock.Unlock()
+		return false
+	}
+	if e.ClearTime > current.UpdateTime {
+		seen = make(map[balanceDelta]bool)
+		t.deltas[e.Asset] = seen
+	}
+	seen[key] = true
+	current.Free += parseFloat(e.Delta)
+	current.UpdateTime = e.ClearTime
+	changed := *current
+	t.lock.Unlock()
+	t.notify(changed)
+	return true
+}
+
+func (t *BalanceTracker) balance(asset string) *Balance {
+	b, ok := t.balances[asset]
+	if !ok {
+		b = &Balance{Asset: asset}
+		t.balances[asset] = b
+	}
+	return b
+}
+
+func (t *BalanceTracker) notify(balances ...Balance) {
+	if t.config.OnChange == nil {
+		return
+	}
+	for _, b := range balances {
+		t.config.OnChange(b)
+	}
+}
+
+// Reconcile reads a snapshot of the account with the REST API and replaces
+// the tracked balances which are not newer than it, reporting the ones which
+// drifted to OnDrift. Snapshots of margin accounts are dated with the server
+// time the request is sent, see TimeOffset.
+func (t *BalanceTracker) Reconcile(ctx context.Context) error {
+	sent := t.config.Now().UnixNano() / int64(time.Millisecond)
+	if t.config.TimeOffset != nil {
+		sent -= t.config.TimeOffset()
+	}
+	snapshot, updateTime, err := t.source.balances(ctx)
+	if err != nil {
+		return err
+	}
+	if updateTime == 0 {
+		updateTime = sent
+	}
+
+	type drift struct {
+		tracked, snapshot Balance
+	}
+	var (
+		changed []Balance
+		drifts  []drift
+	)
+	t.lock.Lock()
+	listed := make(map[string]bool, len(snapshot))
+	for i := range snapshot {
+		b := &snapshot[i]
+		listed[b.Asset] = true
+		current, found := t.balances[b.Asset]
+		if found && current.UpdateTime > updateTime {
+			continue
+		}
+		if !found {
+			current = &Balance{Asset: b.Asset}
+			t.balances[b.Asset] = current
+		}
+		if current.agrees(b, t.config.Tolerance) {
+			// only debts are refreshed, without notification below tolerance.
+			current.Borrowed, current.Interest = b.Borrowed, b.Interest
+			continue
+		}
+		if found {
+			drifts = append(drifts, drift{tracked: *current, snapshot: *b})
+		}
+		b.UpdateTime = updateTime
+		*current = *b
+		delete(t.deltas, b.Asset)
+		changed = append(changed, *current)
+	}
+	for asset, current := range t.balances {
+		if !listed[asset] && current.UpdateTime <= updateTime {
+			// the snapshot omits empty balances.
+			zero := Balance{Asset: asset, UpdateTime: updateTime}
+			if !current.agrees(&zero, t.config.Tolerance) {
+				drifts = append(drifts, drift{tracked: *current, snapshot: zero})
+				changed = append(changed, zero)
+			}
+			delete(t.balances, asset)
+			delete(t.deltas, asset)
+		}
+	}
+	t.lock.Unlock()
+
+	if t.config.OnDrift != nil {
+		for _, d := range drifts {
+			t.config.OnDrift(d.tracked, d.snapshot)
+		}
+	}
+	t.notify(changed...)
+	return nil
+}
+
+// Run reconciles once, then every ReconcileInterval and whenever a value is
+// received from reconnected, until ctx is done. Errors are passed to OnError.
+func (t *BalanceTracker) Run(ctx context.Context, reconnected <-chan struct{}) {
+	ticker := time.NewTicker(t.config.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.Reconcile(ctx); err != nil && t.config.OnError != nil && ctx.Err() == nil {
+			t.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-reconnected:
+		}
+	}
+}
+
+// Balance returns the balance of an asset.
+func (t *BalanceTracker) Balance(asset string) (Balance, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	b, ok := t.balances[asset]
+	if !ok {
+		return Balance{}, false
+	}
+	return *b, true
+}
+
+// Balances returns the balances sorted by asset.
+func (t *BalanceTracker) Balances() (res []Balance) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, b := range t.balances {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
@@ -0,0 +1,142 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type balanceTrackerTestSuite struct {
+	suite.Suite
+	api     *mocks.Client
+	tracker *BalanceTracker
+	changes []Balance
+	drifts  []Balance
+}
+
+func TestBalanceTracker(t *testing.T) {
+	suite.Run(t, new(balanceTrackerTestSuite))
+}
+
+func (s *balanceTrackerTestSuite) SetupTest() {
+	s.changes, s.drifts = nil, nil
+	s.api = new(mocks.Client)
+	s.tracker = NewSpotBalanceTracker(s.api, BalanceTrackerConfig{
+		OnChange: func(b Balance) {
+			s.changes = append(s.changes, b)
+		},
+		OnDrift: func(tracked, snapshot Balance) {
+			s.drifts = append(s.drifts, tracked)
+		},
+	})
+}
+
+func accountPosition(time int64, balances ...binance.WsAccountBalance) *binance.WsUserDataEvent {
+	return &binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeOutboundAccountPosition,
+		AccountPosition: &binance.WsOutboundAccountPositionEvent{
+			LastUpdateTime: time,
+			Balances:       balances,
+		},
+	}
+}
+
+func balanceUpdate(time int64, asset, delta string) *binance.WsUserDataEvent {
+	return &binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: &binance.WsBalanceUpdateEvent{Asset: asset, Delta: delta, Time: time,
+			ClearTime: time},
+	}
+}
+
+func (s *balanceTrackerTestSuite) TestStream() {
+	s.api.On("GetAccount", mock.Anything).Return(&binance.Account{
+		UpdateTime: 10,
+		Balances:   []binance.Balance{{Asset: "BTC", Free: 1, Locked: 0.5}, {Asset: "USDT", Free: 100}},
+	}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+	s.Len(s.changes, 2)
+
+	// events already counted by the snapshot are ignored.
+	s.False(s.tracker.Update(balanceUpdate(9, "BTC", "1")))
+	s.True(s.tracker.Update(balanceUpdate(11, "BTC", "-0.25")))
+	b, _ := s.tracker.Balance("BTC")
+	s.InDelta(0.75, b.Free, 1e-9)
+	s.InDelta(1.25, b.Total(), 1e-9)
+
+	// a second delta of the same millisecond is counted, a replayed one is not.
+	s.True(s.tracker.Update(balanceUpdate(11, "BTC", "-0.1")))
+	s.False(s.tracker.Update(balanceUpdate(11, "BTC", "-0.1")))
+	b, _ = s.tracker.Balance("BTC")
+	s.InDelta(0.65, b.Free, 1e-9)
+
+	s.True(s.tracker.Update(accountPosition(12,
+		binance.WsAccountBalance{Asset: "BTC", Free: "0.5", Locked: "0.25"},
+		binance.WsAccountBalance{Asset: "ETH", Free: "2", Locked: "0"})))
+	s.False(s.tracker.Update(accountPosition(11, binance.WsAccountBalance{Asset: "BTC", Free: "9"})))
+	s.False(s.tracker.Update(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeExecutionReport}))
+
+	balances := s.tracker.Balances()
+	s.Require().Len(balances, 3)
+	s.Equal("BTC", balances[0].Asset)
+	s.InDelta(0.5, balances[0].Free, 1e-9)
+	s.Equal("ETH", balances[1].Asset)
+	s.Equal(int64(12), balances[1].UpdateTime)
+	s.Empty(s.drifts)
+}
+
+func (s *balanceTrackerTestSuite) TestDrift() {
+	s.tracker.Update(accountPosition(5, binance.WsAccountBalance{Asset: "BTC", Free: "1", Locked: "0"}))
+	s.tracker.Update(accountPosition(5, binance.WsAccountBalance{Asset: "ETH", Free: "3", Locked: "0"}))
+	s.tracker.Update(accountPosition(20, binance.WsAccountBalance{Asset: "BNB", Free: "7", Locked: "0"}))
+	s.changes = nil
+
+	s.api.On("GetAccount", mock.Anything).Return(&binance.Account{
+		UpdateTime: 10,
+		Balances:   []binance.Balance{{Asset: "BTC", Free: 0.9}, {Asset: "BNB", Free: 6}},
+	}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+
+	// BTC drifted, ETH is missing from the snapshot and BNB is newer than it.
+	s.Require().Len(s.drifts, 2)
+	b, _ := s.tracker.Balance("BTC")
+	s.InDelta(0.9, b.Free, 1e-9)
+	s.Equal(int64(10), b.UpdateTime)
+	_, ok := s.tracker.Balance("ETH")
+	s.False(ok)
+	b, _ = s.tracker.Balance("BNB")
+	s.InDelta(7, b.Free, 1e-9)
+	s.Len(s.changes, 2)
+}
+
+func (s *balanceTrackerTestSuite) TestMargin() {
+	now := time.Unix(100, 0)
+	tracker := NewIsolatedMarginBalanceTracker(s.api, "BTCUSDT", BalanceTrackerConfig{
+		Now:        func() time.Time { return now },
+		TimeOffset: func() int64 { return 2000 },
+	})
+	s.api.On("GetIsolatedMarginAccount", mock.Anything, []string{"BTCUSDT"}).
+		Return(&binance.IsolatedMarginAccount{Assets: []binance.IsolatedMarginAsset{{
+			Symbol:     "BTCUSDT",
+			BaseAsset:  binance.IsolatedUserAsset{Asset: "BTC", Free: "1", Borrowed: "0.5", Interest: "0.01"},
+			QuoteAsset: binance.IsolatedUserAsset{Asset: "USDT", Free: "100", Locked: "50"},
+		}}}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(tracker.Reconcile(context.Background()))
+
+	b, ok := tracker.Balance("BTC")
+	s.Require().True(ok)
+	s.InDelta(0.49, b.NetAsset(), 1e-9)
+	// dated with the server time, 2 seconds behind the local clock.
+	s.Equal(int64(98000), b.UpdateTime)
+	b, _ = tracker.Balance("USDT")
+	s.InDelta(150, b.Total(), 1e-9)
+}
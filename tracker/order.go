@@ -0,0 +1,530 @@
+// Package tracker keeps local views of orders, positions and balances built
+// from user data stream events and reconciled with the REST API.
+package tracker
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/futures"
+)
+
+const defaultMaxClosedOrders = 1000
+
+// Order status values, futures liquidation orders start as NEW_INSURANCE or NEW_ADL
+// and spot orders expired by self-trade prevention end as EXPIRED_IN_MATCH.
+const (
+	OrderStatusNew             = "NEW"
+	OrderStatusNewInsurance    = "NEW_INSURANCE"
+	OrderStatusNewADL          = "NEW_ADL"
+	OrderStatusPartiallyFilled = "PARTIALLY_FILLED"
+	OrderStatusFilled          = "FILLED"
+	OrderStatusCanceled        = "CANCELED"
+	OrderStatusExpired         = "EXPIRED"
+	OrderStatusExpiredInMatch  = "EXPIRED_IN_MATCH"
+	OrderStatusRejected        = "REJECTED"
+)
+
+// OrderState is the tracked state of one order. Quantities are reported as
+// they are received, Fees sums the commissions of the order trades by asset.
+type OrderState struct {
+	Symbol           string
+	OrderID          int64
+	ClientOrderID    string
+	Side             string
+	Type             string
+	TimeInForce      string
+	PositionSide     string
+	Price            string
+	OrigQuantity     string
+	ExecutedQuantity string
+	CumQuote         string
+	Status           string
+	Fees             map[string]float64
+	LastTradeID      int64
+	UpdateTime       int64
+}
+
+// IsOpen tells if the order can still be filled.
+func (s *OrderState) IsOpen() bool {
+	return !isTerminalStatus(s.Status)
+}
+
+func (s *OrderState) clone() OrderState {
+	res := *s
+	res.Fees = make(map[string]float64, len(s.Fees))
+	for asset, fee := range s.Fees {
+		res.Fees[asset] = fee
+	}
+	return res
+}
+
+func isTerminalStatus(status string) bool {
+	switch status {
+	case OrderStatusFilled, OrderStatusCanceled, OrderStatusExpired, OrderStatusExpiredInMatch,
+		OrderStatusRejected:
+		return true
+	}
+	return false
+}
+
+// statusRank orders the statuses of the state machine, an order never moves
+// to a lower rank.
+func statusRank(status string) int {
+	switch status {
+	case OrderStatusNew, OrderStatusNewInsurance, OrderStatusNewADL:
+		return 0
+	case OrderStatusPartiallyFilled:
+		return 1
+	}
+	return 2
+}
+
+// OrderUpdate is an order change received from the user data stream or read
+// from the REST API.
+type OrderUpdate struct {
+	OrderState
+	// TradeID, Commission and CommissionAsset are set for trade events only.
+	TradeID         int64
+	Commission      string
+	CommissionAsset string
+}
+
+// SpotOrderUpdate converts a spot executionReport event.
+func SpotOrderUpdate(e *binance.WsExecutionReportEvent) OrderUpdate {
+	u := OrderUpdate{
+		OrderState: OrderState{
+			Symbol:           e.Symbol,
+			OrderID:          e.ID,
+			ClientOrderID:    e.ClientOrderID,
+			Side:             string(e.Side),
+			Type:             string(e.Type),
+			TimeInForce:      string(e.TimeInForce),
+			Price:            e.OriginalPrice,
+			OrigQuantity:     e.OriginalQty,
+			ExecutedQuantity: e.AccumulatedFilledQty,
+			CumQuote:         e.AccumulatedQuoteQty,
+			Status:           string(e.Status),
+			UpdateTime:       e.TransactionTime,
+		},
+	}
+	if e.Status == binance.OrderStatusTypeCanceled && e.OrigClientOrderID != "" {
+		// canceled events carry the client id of the cancel request in "c".
+		u.ClientOrderID = e.OrigClientOrderID
+	}
+	if e.ExecutionType == binance.OrderExecutionTypeTrade {
+		u.TradeID = e.TradeID
+		u.Commission = e.Commission
+		u.CommissionAsset = e.CommissionAsset
+	}
+	return u
+}
+
+// FuturesOrderUpdate converts the order of a futures ORDER_TRADE_UPDATE event.
+func FuturesOrderUpdate(e *futures.WsOrderTradeUpdate) OrderUpdate {
+	u := OrderUpdate{
+		OrderState: OrderState{
+			Symbol:           e.Symbol,
+			OrderID:          e.ID,
+			ClientOrderID:    e.ClientOrderID,
+			Side:             string(e.Side),
+			Type:             string(e.Type),
+			TimeInForce:      string(e.TimeInForce),
+			PositionSide:     string(e.PositionSide),
+			Price:            e.OriginalPrice,
+			OrigQuantity:     e.OriginalQty,
+			ExecutedQuantity: e.AccumulatedFilledQty,
+			CumQuote:         multiply(e.AveragePrice, e.AccumulatedFilledQty),
+			Status:           string(e.Status),
+			UpdateTime:       e.TradeTime,
+		},
+	}
+	if e.ExecutionType == futures.OrderExecutionTypeTrade {
+		u.TradeID = e.TradeID
+		u.Commission = e.Commission
+		u.CommissionAsset = e.CommissionAsset
+	}
+	return u
+}
+
+func spotOrderSnapshot(o *binance.Order) OrderUpdate {
+	return OrderUpdate{
+		OrderState: OrderState{
+			Symbol:           o.Symbol,
+			OrderID:          o.OrderID,
+			ClientOrderID:    o.ClientOrderID,
+			Side:             string(o.Side),
+			Type:             string(o.Type),
+			TimeInForce:      string(o.TimeInForce),
+			Price:            o.Price,
+			OrigQuantity:     o.OrigQuantity,
+			ExecutedQuantity: o.ExecutedQuantity,
+			CumQuote:         o.CummulativeQuoteQuantity,
+			Status:           string(o.Status),
+			UpdateTime:       o.UpdateTime,
+		},
+	}
+}
+
+func futuresOrderSnapshot(o *futures.Order) OrderUpdate {
+	return OrderUpdate{
+		OrderState: OrderState{
+			Symbol:           o.Symbol,
+			OrderID:          o.OrderID,
+			ClientOrderID:    o.ClientOrderID,
+			Side:             string(o.Side),
+			Type:             string(o.Type),
+			TimeInForce:      string(o.TimeInForce),
+			PositionSide:     string(o.PositionSide),
+			Price:            o.Price,
+			OrigQuantity:     o.OrigQuantity,
+			ExecutedQuantity: o.ExecutedQuantity,
+			CumQuote:         o.CumQuote,
+			Status:           string(o.Status),
+			UpdateTime:       o.UpdateTime,
+		},
+	}
+}
+
+func parseFloat(s string) float64 {
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func multiply(a, b string) string {
+	return formatFloat(parseFloat(a) * parseFloat(b))
+}
+
+// orderSource reads orders from the REST API of one market.
+type orderSource interface {
+	openOrders(ctx context.Context) ([]OrderUpdate, error)
+	order(ctx context.Context, symbol string, orderID int64) (OrderUpdate, error)
+}
+
+type spotOrderSource struct {
+	api binance.TradingAPI
+}
+
+func (s spotOrderSource) openOrders(ctx context.Context) (res []OrderUpdate, err error) {
+	orders, err := s.api.ListOpenOrders(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		res = append(res, spotOrderSnapshot(o))
+	}
+	return res, nil
+}
+
+func (s spotOrderSource) order(ctx context.Context, symbol string, orderID int64) (OrderUpdate, error) {
+	o, err := s.api.GetOrder(ctx, binance.OrderQuery{Symbol: symbol, OrderID: orderID})
+	if err != nil {
+		return OrderUpdate{}, err
+	}
+	return spotOrderSnapshot(o), nil
+}
+
+type futuresOrderSource struct {
+	api futures.TradingAPI
+}
+
+func (s futuresOrderSource) openOrders(ctx context.Context) (res []OrderUpdate, err error) {
+	orders, err := s.api.ListOpenOrders(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		res = append(res, futuresOrderSnapshot(o))
+	}
+	return res, nil
+}
+
+func (s futuresOrderSource) order(ctx context.Context, symbol string, orderID int64) (OrderUpdate, error) {
+	o, err := s.api.GetOrder(ctx, futures.OrderQuery{Symbol: symbol, OrderID: orderID})
+	if err != nil {
+		return OrderUpdate{}, err
+	}
+	return futuresOrderSnapshot(o), nil
+}
+
+// OrderTrackerConfig define the order tracker options.
+type OrderTrackerConfig struct {
+	// ReconcileInterval is the period of the reconciliation done by Run, one
+	// minute by default.
+	ReconcileInterval time.Duration
+	// MaxClosedOrders is the number of closed orders kept for queries, 1000 by default.
+	MaxClosedOrders int
+	// OnChange is called after every state change, previous is nil for orders
+	// seen for the first time. It is called without holding any lock.
+	OnChange func(previous *OrderState, current OrderState)
+	// OnError receives the reconciliation errors of Run.
+	OnError func(err error)
+}
+
+type orderKey struct {
+	symbol  string
+	orderID int64
+}
+
+type orderChange struct {
+	previous *OrderState
+	current  OrderState
+}
+
+// OrderTracker keeps the state of the orders of one market. Feed it with
+// Update from the user data stream and call Reconcile, or Run, to catch up
+// with changes missed while the stream was down.
+type OrderTracker struct {
+	config OrderTrackerConfig
+	source orderSource
+
+	lock   sync.Mutex
+	orders map[orderKey]*OrderState
+	closed []orderKey
+}
+
+// NewSpotOrderTracker creates a tracker for spot orders, api is usually a *binance.Client.
+func NewSpotOrderTracker(api binance.TradingAPI, config OrderTrackerConfig) *OrderTracker {
+	return newOrderTracker(spotOrderSource{api: api}, config)
+}
+
+// NewFuturesOrderTracker creates a tracker for USDⓈ-M futures orders, api is
+// usually a *futures.Client.
+func NewFuturesOrderTracker(api futures.TradingAPI, config OrderTrackerConfig) *OrderTracker {
+	return newOrderTracker(futuresOrderSource{api: api}, config)
+}
+
+func newOrderTracker(source orderSource, config OrderTrackerConfig) *OrderTracker {
+	if config.ReconcileInterval <= 0 {
+		config.ReconcileInterval = time.Minute
+	}
+	if config.MaxClosedOrders <= 0 {
+		config.MaxClosedOrders = defaultMaxClosedOrders
+	}
+	return &OrderTracker{
+		config: config,
+		source: source,
+		orders: make(map[orderKey]*OrderState),
+	}
+}
+
+// Update applies an order event, stale events which would move an order back
+// in its state machine are ignored. It returns if the state changed.
+func (t *OrderTracker) Update(u OrderUpdate) bool {
+	t.lock.Lock()
+	change, ok := t.apply(u)
+	t.lock.Unlock()
+	if ok {
+		t.notify(change)
+	}
+	return ok
+}
+
+// UpdateSpot applies a spot executionReport event.
+func (t *OrderTracker) UpdateSpot(e *binance.WsExecutionReportEvent) bool {
+	return t.Update(SpotOrderUpdate(e))
+}
+
+// UpdateFutures applies a futures ORDER_TRADE_UPDATE event.
+func (t *OrderTracker) UpdateFutures(e *futures.WsUserDataEvent) bool {
+	if e.Event != futures.UserDataEventTypeOrderTradeUpdate {
+		return false
+	}
+	return t.Update(FuturesOrderUpdate(&e.OrderTradeUpdate))
+}
+
+func (t *OrderTracker) apply(u OrderUpdate) (change orderChange, ok bool) {
+	key := orderKey{symbol: u.Symbol, orderID: u.OrderID}
+	current, found := t.orders[key]
+	if found {
+		if !isNewer(current, &u.OrderState) && !isNewTrade(current, &u) {
+			return change, false
+		}
+		previous := current.clone()
+		change.previous = &previous
+	} else {
+		current = &OrderState{Fees: make(map[string]float64)}
+		t.orders[key] = current
+	}
+
+	fees, lastTradeID := current.Fees, current.LastTradeID
+	if isNewer(current, &u.OrderState) || !found {
+		*current = u.OrderState
+	}
+	current.Fees, current.LastTradeID = fees, lastTradeID
+	if isNewTrade(current, &u) {
+		current.LastTradeID = u.TradeID
+		if u.CommissionAsset != "" {
+			current.Fees[u.CommissionAsset] += parseFloat(u.Commission)
+		}
+	}
+	if !current.IsOpen() && (change.previous == nil || change.previous.IsOpen()) {
+		t.closed = append(t.closed, key)
+		t.prune()
+	}
+	change.current = current.clone()
+	return change, true
+}
+
+// isNewer tells if next moves the order forward in its state machine.
+func isNewer(current, next *OrderState) bool {
+	if isTerminalStatus(current.Status) {
+		return false
+	}
+	if rank, nextRank := statusRank(current.Status), statusRank(next.Status); nextRank != rank {
+		return nextRank > rank
+	}
+	if executed, nextExecuted := parseFloat(current.ExecutedQuantity),
+		parseFloat(next.ExecutedQuantity); nextExecuted != executed {
+		return nextExecuted > executed
+	}
+	return next.UpdateTime > current.UpdateTime
+}
+
+// isNewTrade tells if u reports a trade whose fee is not counted yet. Trade
+// ids increase within a symbol.
+func isNewTrade(current *OrderState, u *OrderUpdate) bool {
+	return u.TradeID > current.LastTradeID
+}
+
+func (t *OrderTracker) prune() {
+	over := len(t.closed) - t.config.MaxClosedOrders
+	if over <= 0 {
+		return
+	}
+	for _, key := range t.closed[:over] {
+		delete(t.orders, key)
+	}
+	t.closed = append(t.closed[:0], t.closed[over:]...)
+}
+
+func (t *OrderTracker) notify(changes ...orderChange) {
+	if t.config.OnChange == nil {
+		return
+	}
+	for _, c := range changes {
+		t.config.OnChange(c.previous, c.current)
+	}
+}
+
+// Reconcile lists the open orders with the REST API and queries the tracked
+// open orders missing from the list, to learn how they were closed. Call it
+// after the user data stream reconnects.
+func (t *OrderTracker) Reconcile(ctx context.Context) error {
+	open, err := t.source.openOrders(ctx)
+	if err != nil {
+		return err
+	}
+	listed := make(map[orderKey]bool, len(open))
+	for _, u := range open {
+		listed[orderKey{symbol: u.Symbol, orderID: u.OrderID}] = true
+		t.Update(u)
+	}
+
+	var missing []orderKey
+	t.lock.Lock()
+	for key, o := range t.orders {
+		if o.IsOpen() && !listed[key] {
+			missing = append(missing, key)
+		}
+	}
+	t.lock.Unlock()
+
+	for _, key := range missing {
+		u, err := t.source.order(ctx, key.symbol, key.orderID)
+		apiErr := new(common.APIError)
+		if errors.As(err, &apiErr) && apiErr.Code == -2013 {
+			// the order is unknown to the exchange. USDⓈ-M forgets canceled or
+			// expired orders without fills after 3 days, close it as canceled so
+			// OnChange reports its final state.
+			t.closeUnknown(key)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		t.Update(u)
+	}
+	return nil
+}
+
+func (t *OrderTracker) closeUnknown(key orderKey) {
+	t.lock.Lock()
+	var (
+		change orderChange
+		ok     bool
+	)
+	if o, found := t.orders[key]; found && o.IsOpen() {
+		u := OrderUpdate{OrderState: o.clone()}
+		u.Status = OrderStatusCanceled
+		change, ok = t.apply(u)
+	}
+	t.lock.Unlock()
+	if ok {
+		t.notify(change)
+	}
+}
+
+// Run reconciles once, then every ReconcileInterval and whenever a value is
+// received from reconnected, until ctx is done. Errors are passed to OnError.
+func (t *OrderTracker) Run(ctx context.Context, reconnected <-chan struct{}) {
+	ticker := time.NewTicker(t.config.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.Reconcile(ctx); err != nil && t.config.OnError != nil && ctx.Err() == nil {
+			t.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-reconnected:
+		}
+	}
+}
+
+// Order returns the state of an order.
+func (t *OrderTracker) Order(symbol string, orderID int64) (OrderState, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	o, ok := t.orders[orderKey{symbol: symbol, orderID: orderID}]
+	if !ok {
+		return OrderState{}, false
+	}
+	return o.clone(), true
+}
+
+// OrderByClientOrderID returns the state of the latest order with the client order id.
+func (t *OrderTracker) OrderByClientOrderID(symbol, clientOrderID string) (res OrderState, ok bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key, o := range t.orders {
+		if key.symbol == symbol && o.ClientOrderID == clientOrderID && o.OrderID >= res.OrderID {
+			res, ok = o.clone(), true
+		}
+	}
+	return res, ok
+}
+
+// OpenOrders returns the open orders of symbol, or of all symbols when empty.
+func (t *OrderTracker) OpenOrders(symbol string) (res []OrderState) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key, o := range t.orders {
+		if o.IsOpen() && (symbol == "" || key.symbol == symbol) {
+			res = append(res, o.clone())
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].OrderID < res[j].OrderID })
+	return res
+}
@@ -0,0 +1,197 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+
+	binance "github.com/crypto-zero/go-binance/v2"
+	"github.com/crypto-zero/go-binance/v2/common"
+	"github.com/crypto-zero/go-binance/v2/futures"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderTrackerTestSuite struct {
+	suite.Suite
+	api     *mocks.Client
+	tracker *OrderTracker
+	changes []OrderState
+}
+
+func TestOrderTracker(t *testing.T) {
+	suite.Run(t, new(orderTrackerTestSuite))
+}
+
+func (s *orderTrackerTestSuite) SetupTest() {
+	s.changes = nil
+	s.api = new(mocks.Client)
+	s.tracker = NewSpotOrderTracker(s.api, OrderTrackerConfig{
+		MaxClosedOrders: 2,
+		OnChange: func(previous *OrderState, current OrderState) {
+			s.changes = append(s.changes, current)
+		},
+	})
+}
+
+func executionReport(id int64, x binance.OrderExecutionType, status binance.OrderStatusType,
+	executed string, tradeID int64, time int64,
+) *binance.WsExecutionReportEvent {
+	e := &binance.WsExecutionReportEvent{
+		Event:                "executionReport",
+		Symbol:               "BTCUSDT",
+		ClientOrderID:        "client",
+		Side:                 binance.SideTypeBuy,
+		Type:                 binance.OrderTypeLimit,
+		OriginalQty:          "2",
+		OriginalPrice:        "100",
+		ExecutionType:        x,
+		Status:               status,
+		ID:                   id,
+		AccumulatedFilledQty: executed,
+		AccumulatedQuoteQty:  multiply(executed, "100"),
+		TransactionTime:      time,
+		TradeID:              -1,
+	}
+	if x == binance.OrderExecutionTypeTrade {
+		e.TradeID = tradeID
+		e.Commission = "0.1"
+		e.CommissionAsset = "BNB"
+	}
+	return e
+}
+
+func (s *orderTrackerTestSuite) TestStateMachine() {
+	s.True(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeNew,
+		binance.OrderStatusTypeNew, "0", 0, 1)))
+	s.True(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeTrade,
+		binance.OrderStatusTypePartiallyFilled, "1", 10, 2)))
+	// replayed or stale events do not move the order back.
+	s.False(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeTrade,
+		binance.OrderStatusTypePartiallyFilled, "1", 10, 2)))
+	s.False(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeNew,
+		binance.OrderStatusTypeNew, "0", 0, 1)))
+
+	open := s.tracker.OpenOrders("BTCUSDT")
+	s.Require().Len(open, 1)
+	s.Equal(OrderStatusPartiallyFilled, open[0].Status)
+	s.Equal("100", open[0].CumQuote)
+
+	s.True(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeTrade,
+		binance.OrderStatusTypeFilled, "2", 11, 3)))
+	s.False(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeCanceled,
+		binance.OrderStatusTypeCanceled, "2", 0, 4)))
+
+	o, ok := s.tracker.Order("BTCUSDT", 1)
+	s.Require().True(ok)
+	s.Equal(OrderStatusFilled, o.Status)
+	s.False(o.IsOpen())
+	s.InDelta(0.2, o.Fees["BNB"], 1e-9)
+	s.Equal(int64(11), o.LastTradeID)
+	s.Empty(s.tracker.OpenOrders(""))
+	s.Len(s.changes, 3)
+
+	o, ok = s.tracker.OrderByClientOrderID("BTCUSDT", "client")
+	s.True(ok)
+	s.Equal(int64(1), o.OrderID)
+}
+
+func (s *orderTrackerTestSuite) TestExpiredInMatch() {
+	s.True(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeNew,
+		binance.OrderStatusTypeNew, "0", 0, 1)))
+	s.True(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeTradePrevention,
+		binance.OrderStatusTypeExpiredInMatch, "0", 0, 2)))
+	// the order is closed, a later event does not reopen it.
+	s.False(s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeCanceled,
+		binance.OrderStatusTypeCanceled, "0", 0, 3)))
+
+	o, ok := s.tracker.Order("BTCUSDT", 1)
+	s.Require().True(ok)
+	s.Equal(OrderStatusExpiredInMatch, o.Status)
+	s.False(o.IsOpen())
+	s.Empty(s.tracker.OpenOrders(""))
+}
+
+func (s *orderTrackerTestSuite) TestMaxClosedOrders() {
+	for id := int64(1); id <= 3; id++ {
+		s.tracker.UpdateSpot(executionReport(id, binance.OrderExecutionTypeCanceled,
+			binance.OrderStatusTypeCanceled, "0", 0, id))
+	}
+	_, ok := s.tracker.Order("BTCUSDT", 1)
+	s.False(ok)
+	_, ok = s.tracker.Order("BTCUSDT", 3)
+	s.True(ok)
+}
+
+func (s *orderTrackerTestSuite) TestReconcile() {
+	s.tracker.UpdateSpot(executionReport(1, binance.OrderExecutionTypeNew, binance.OrderStatusTypeNew, "0", 0, 1))
+	s.tracker.UpdateSpot(executionReport(2, binance.OrderExecutionTypeNew, binance.OrderStatusTypeNew, "0", 0, 1))
+	s.tracker.UpdateSpot(executionReport(3, binance.OrderExecutionTypeNew, binance.OrderStatusTypeNew, "0", 0, 1))
+
+	s.api.On("ListOpenOrders", mock.Anything, "").Return([]*binance.Order{
+		{Symbol: "BTCUSDT", OrderID: 1, Status: binance.OrderStatusTypePartiallyFilled,
+			ExecutedQuantity: "0.5", UpdateTime: 5},
+		{Symbol: "ETHUSDT", OrderID: 7, Status: binance.OrderStatusTypeNew, ExecutedQuantity: "0"},
+	}, nil)
+	s.api.On("GetOrder", mock.Anything, binance.OrderQuery{Symbol: "BTCUSDT", OrderID: 2}).
+		Return(&binance.Order{Symbol: "BTCUSDT", OrderID: 2, Status: binance.OrderStatusTypeFilled,
+			ExecutedQuantity: "2", UpdateTime: 6}, nil)
+	s.api.On("GetOrder", mock.Anything, binance.OrderQuery{Symbol: "BTCUSDT", OrderID: 3}).
+		Return(nil, &common.APIError{Code: -2013, Message: "Order does not exist."})
+	defer s.api.AssertExpectations(s.T())
+
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+	o, _ := s.tracker.Order("BTCUSDT", 1)
+	s.Equal(OrderStatusPartiallyFilled, o.Status)
+	o, _ = s.tracker.Order("BTCUSDT", 2)
+	s.Equal(OrderStatusFilled, o.Status)
+	o, ok := s.tracker.Order("BTCUSDT", 3)
+	s.Require().True(ok)
+	s.Equal(OrderStatusCanceled, o.Status)
+	// the unknown order is reported closed instead of vanishing.
+	var closed []string
+	for _, c := range s.changes {
+		if c.OrderID == 3 {
+			closed = append(closed, c.Status)
+		}
+	}
+	s.Equal([]string{OrderStatusNew, OrderStatusCanceled}, closed)
+	s.Len(s.tracker.OpenOrders(""), 2)
+	s.Len(s.tracker.OpenOrders("ETHUSDT"), 1)
+}
+
+func (s *orderTrackerTestSuite) TestFutures() {
+	api := new(mocks.FuturesClient)
+	tracker := NewFuturesOrderTracker(api, OrderTrackerConfig{})
+	event := &futures.WsUserDataEvent{
+		Event: futures.UserDataEventTypeOrderTradeUpdate,
+		OrderTradeUpdate: futures.WsOrderTradeUpdate{
+			Symbol:               "BTCUSDT",
+			ID:                   9,
+			Status:               futures.OrderStatusTypeNewInsurance,
+			ExecutionType:        futures.OrderExecutionTypeNew,
+			AccumulatedFilledQty: "0",
+			PositionSide:         futures.PositionSideTypeLong,
+			TradeTime:            1,
+		},
+	}
+	s.True(tracker.UpdateFutures(event))
+	event.OrderTradeUpdate.Status = futures.OrderStatusTypeFilled
+	event.OrderTradeUpdate.ExecutionType = futures.OrderExecutionTypeTrade
+	event.OrderTradeUpdate.AccumulatedFilledQty = "0.2"
+	event.OrderTradeUpdate.AveragePrice = "20000"
+	event.OrderTradeUpdate.TradeID = 77
+	event.OrderTradeUpdate.Commission = "1.6"
+	event.OrderTradeUpdate.CommissionAsset = "USDT"
+	event.OrderTradeUpdate.TradeTime = 2
+	s.True(tracker.UpdateFutures(event))
+	s.False(tracker.UpdateFutures(&futures.WsUserDataEvent{Event: futures.UserDataEventTypeAccountUpdate}))
+
+	o, ok := tracker.Order("BTCUSDT", 9)
+	s.Require().True(ok)
+	s.Equal(OrderStatusFilled, o.Status)
+	s.Equal("4000", o.CumQuote)
+	s.Equal("LONG", o.PositionSide)
+	s.InDelta(1.6, o.Fees["USDT"], 1e-9)
+}
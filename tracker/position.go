@@ -0,0 +1,555 @@
+package tracker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+)
+
+// Position side values, BOTH is used in one-way mode, LONG and SHORT in hedge mode.
+const (
+	PositionSideBoth  = "BOTH"
+	PositionSideLong  = "LONG"
+	PositionSideShort = "SHORT"
+)
+
+// Position is the tracked state of one position. Amount is negative for short
+// positions. The fields after ContractSize are computed from the latest mark
+// price, they are in the quote asset for USDⓈ-M and in the margin coin for
+// COIN-M positions, whose Amount is a number of contracts.
+type Position struct {
+	Symbol         string
+	PositionSide   string
+	MarginType     string
+	MarginAsset    string
+	Amount         float64
+	EntryPrice     float64
+	MarkPrice      float64
+	Leverage       float64
+	IsolatedWallet float64
+	ContractSize   float64
+	UpdateTime     int64
+
+	UnrealizedPnL float64
+	Notional      float64
+	// InitialMargin is the absolute notional divided by the leverage.
+	InitialMargin float64
+	// ROE is the unrealized PnL divided by the initial margin.
+	ROE float64
+	// EffectiveLeverage is the absolute notional divided by the isolated
+	// wallet plus the unrealized PnL for isolated positions, or by the cross
+	// wallet balance of the margin asset for cross positions.
+	EffectiveLeverage float64
+}
+
+// IsIsolated tells if the position uses isolated margin.
+func (p *Position) IsIsolated() bool {
+	return strings.EqualFold(p.MarginType, string(futures.MarginTypeIsolated))
+}
+
+// PositionUpdate is a position change received from ACCOUNT_UPDATE or read
+// from the REST API, MarkPrice and Leverage are optional.
+type PositionUpdate struct {
+	Symbol         string
+	PositionSide   string
+	MarginType     string
+	Amount         float64
+	EntryPrice     float64
+	MarkPrice      float64
+	Leverage       float64
+	IsolatedWallet float64
+}
+
+// FuturesPositionUpdate converts a position of an ACCOUNT_UPDATE event.
+func FuturesPositionUpdate(p *futures.WsPosition) PositionUpdate {
+	return PositionUpdate{
+		Symbol:         p.Symbol,
+		PositionSide:   string(p.Side),
+		MarginType:     string(p.MarginType),
+		Amount:         parseFloat(p.Amount),
+		EntryPrice:     parseFloat(p.EntryPrice),
+		MarkPrice:      parseFloat(p.MarkPrice),
+		IsolatedWallet: parseFloat(p.IsolatedWallet),
+	}
+}
+
+type symbolInfo struct {
+	marginAsset  string
+	contractSize float64
+}
+
+// positionSource reads positions from the REST API of one market.
+type positionSource interface {
+	positions(ctx context.Context) ([]PositionUpdate, error)
+	wallets(ctx context.Context) (map[string]float64, error)
+	symbols(ctx context.Context) (map[string]symbolInfo, error)
+}
+
+type futuresPositionSource struct {
+	api futures.API
+}
+
+func (s futuresPositionSource) positions(ctx context.Context) (res []PositionUpdate, err error) {
+	positions, err := s.api.GetPositionRisk(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range positions {
+		res = append(res, PositionUpdate{
+			Symbol:         p.Symbol,
+			PositionSide:   p.PositionSide,
+			MarginType:     p.MarginType,
+			Amount:         parseFloat(p.PositionAmt),
+			EntryPrice:     parseFloat(p.EntryPrice),
+			MarkPrice:      parseFloat(p.MarkPrice),
+			Leverage:       parseFloat(p.Leverage),
+			IsolatedWallet: parseFloat(p.IsolatedWallet),
+		})
+	}
+	return res, nil
+}
+
+func (s futuresPositionSource) wallets(ctx context.Context) (map[string]float64, error) {
+	balances, err := s.api.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]float64, len(balances))
+	for _, b := range balances {
+		res[b.Asset] = parseFloat(b.CrossWalletBalance)
+	}
+	return res, nil
+}
+
+func (s futuresPositionSource) symbols(ctx context.Context) (map[string]symbolInfo, error) {
+	info, err := s.api.ExchangeInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]symbolInfo, len(info.Symbols))
+	for _, symbol := range info.Symbols {
+		res[symbol.Symbol] = symbolInfo{marginAsset: symbol.MarginAsset}
+	}
+	return res, nil
+}
+
+type deliveryPositionSource struct {
+	api delivery.API
+}
+
+func (s deliveryPositionSource) positions(ctx context.Context) (res []PositionUpdate, err error) {
+	positions, err := s.api.GetPositionRisk(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range positions {
+		res = append(res, PositionUpdate{
+			Symbol:         p.Symbol,
+			PositionSide:   p.PositionSide,
+			MarginType:     p.MarginType,
+			Amount:         parseFloat(p.PositionAmt),
+			EntryPrice:     parseFloat(p.EntryPrice),
+			MarkPrice:      parseFloat(p.MarkPrice),
+			Leverage:       parseFloat(p.Leverage),
+			IsolatedWallet: parseFloat(p.IsolatedMargin),
+		})
+	}
+	return res, nil
+}
+
+func (s deliveryPositionSource) wallets(ctx context.Context) (map[string]float64, error) {
+	balances, err := s.api.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]float64, len(balances))
+	for _, b := range balances {
+		res[b.Asset] = parseFloat(b.CrossWalletBalance)
+	}
+	return res, nil
+}
+
+func (s deliveryPositionSource) symbols(ctx context.Context) (map[string]symbolInfo, error) {
+	info, err := s.api.ExchangeInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]symbolInfo, len(info.Symbols))
+	for _, symbol := range info.Symbols {
+		res[symbol.Symbol] = symbolInfo{
+			marginAsset:  symbol.MarginAsset,
+			contractSize: float64(symbol.ContractSize),
+		}
+	}
+	return res, nil
+}
+
+// PositionTrackerConfig define the position tracker options.
+type PositionTrackerConfig struct {
+	// ReconcileInterval is the period of the reconciliation done by Run, one
+	// minute by default.
+	ReconcileInterval time.Duration
+	// OnChange is called after a position is opened, changed or closed by an
+	// account update or a reconciliation, closed positions have a zero Amount.
+	// Mark price updates do not trigger it. It is called without holding any lock.
+	OnChange func(p Position)
+	// OnError receives the reconciliation errors of Run.
+	OnError func(err error)
+	// Now returns the current time, it dates the position snapshots.
+	Now func() time.Time
+	// TimeOffset returns the local time minus the server time in milliseconds,
+	// usually the GetTimeOffset method of the client once its time offset is
+	// set. Snapshots are dated with the server time so that they compare with
+	// the event times of the stream.
+	TimeOffset func() int64
+}
+
+type positionKey struct {
+	symbol       string
+	positionSide string
+}
+
+// PositionTracker keeps the positions of one futures market by symbol and
+// position side, so it works in one-way and hedge mode. Feed it with
+// UpdateFutures from the user data stream and with mark prices, and call
+// Reconcile, or Run, to bootstrap and to catch up after reconnections.
+type PositionTracker struct {
+	config  PositionTrackerConfig
+	source  positionSource
+	inverse bool
+
+	lock      sync.Mutex
+	positions map[positionKey]*Position
+	closed    map[positionKey]int64
+	leverage  map[string]float64
+	marks     map[string]float64
+	wallets   map[string]float64
+	symbols   map[string]symbolInfo
+}
+
+// NewFuturesPositionTracker creates a tracker for USDⓈ-M futures positions,
+// api is usually a *futures.Client.
+func NewFuturesPositionTracker(api futures.API, config PositionTrackerConfig) *PositionTracker {
+	return newPositionTracker(futuresPositionSource{api: api}, false, config)
+}
+
+// NewDeliveryPositionTracker creates a tracker for COIN-M futures positions,
+// api is usually a *delivery.Client. COIN-M user data events share the layout
+// of the USDⓈ-M ones, decode them into a futures.WsUserDataEvent.
+func NewDeliveryPositionTracker(api delivery.API, config PositionTrackerConfig) *PositionTracker {
+	return newPositionTracker(deliveryPositionSource{api: api}, true, config)
+}
+
+func newPositionTracker(source positionSource, inverse bool, config PositionTrackerConfig) *PositionTracker {
+	if config.ReconcileInterval <= 0 {
+		config.ReconcileInterval = time.Minute
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &PositionTracker{
+		config:    config,
+		source:    source,
+		inverse:   inverse,
+		positions: make(map[positionKey]*Position),
+		closed:    make(map[positionKey]int64),
+		leverage:  make(map[string]float64),
+		marks:     make(map[string]float64),
+		wallets:   make(map[string]float64),
+		symbols:   make(map[string]symbolInfo),
+	}
+}
+
+// Update applies position changes which happened at time, changes older than
+// the tracked position are ignored. It returns if a position changed.
+func (t *PositionTracker) Update(time int64, updates ...PositionUpdate) bool {
+	var changed []Position
+	t.lock.Lock()
+	for _, u := range updates {
+		if p, ok := t.apply(time, u); ok {
+			changed = append(changed, p)
+		}
+	}
+	t.lock.Unlock()
+	t.notify(changed...)
+	return len(changed) > 0
+}
+
+// UpdateFutures applies the positions and balances of an ACCOUNT_UPDATE
+// event and the leverage of an ACCOUNT_CONFIG_UPDATE event.
+func (t *PositionTracker) UpdateFutures(e *futures.WsUserDataEvent) bool {
+	switch e.Event {
+	case futures.UserDataEventTypeAccountUpdate:
+		t.lock.Lock()
+		for _, b := range e.AccountUpdate.Balances {
+			t.wallets[b.Asset] = parseFloat(b.CrossWalletBalance)
+		}
+		t.lock.Unlock()
+		updates := make([]PositionUpdate, 0, len(e.AccountUpdate.Positions))
+		for i := range e.AccountUpdate.Positions {
+			updates = append(updates, FuturesPositionUpdate(&e.AccountUpdate.Positions[i]))
+		}
+		return t.Update(e.TransactionTime, updates...)
+	case futures.UserDataEventTypeAccountConfigUpdate:
+		if e.AccountConfigUpdate.Symbol == "" {
+			return false
+		}
+		t.UpdateLeverage(e.AccountConfigUpdate.Symbol, float64(e.AccountConfigUpdate.Leverage))
+		return true
+	}
+	return false
+}
+
+// UpdateLeverage sets the leverage of a symbol.
+func (t *PositionTracker) UpdateLeverage(symbol string, leverage float64) {
+	var changed []Position
+	t.lock.Lock()
+	t.leverage[symbol] = leverage
+	for key, p := range t.positions {
+		if key.symbol == symbol {
+			changed = append(changed, t.view(p))
+		}
+	}
+	t.lock.Unlock()
+	t.notify(changed...)
+}
+
+// UpdateMarkPrice sets the mark price of a symbol.
+func (t *PositionTracker) UpdateMarkPrice(symbol string, price float64) {
+	if price <= 0 {
+		return
+	}
+	t.lock.Lock()
+	t.marks[symbol] = price
+	t.lock.Unlock()
+}
+
+// UpdateFuturesMarkPrice applies a USDⓈ-M markPriceUpdate event.
+func (t *PositionTracker) UpdateFuturesMarkPrice(e *futures.WsMarkPriceEvent) {
+	t.UpdateMarkPrice(e.Symbol, parseFloat(e.MarkPrice))
+}
+
+// UpdateDeliveryMarkPrice applies a COIN-M markPriceUpdate event.
+func (t *PositionTracker) UpdateDeliveryMarkPrice(e *delivery.WsMarkPriceEvent) {
+	t.UpdateMarkPrice(e.Symbol, parseFloat(e.MarkPrice))
+}
+
+func (t *PositionTracker) apply(time int64, u PositionUpdate) (Position, bool) {
+	if u.PositionSide == "" {
+		u.PositionSide = PositionSideBoth
+	}
+	key := positionKey{symbol: u.Symbol, positionSide: u.PositionSide}
+	current, found := t.positions[key]
+	if found && time < current.UpdateTime {
+		return Position{}, false
+	}
+	if u.Leverage > 0 {
+		t.leverage[u.Symbol] = u.Leverage
+	}
+	if u.MarkPrice > 0 {
+		if _, ok := t.marks[u.Symbol]; !ok {
+			t.marks[u.Symbol] = u.MarkPrice
+		}
+	}
+	if u.Amount == 0 {
+		if !found {
+			return Position{}, false
+		}
+		delete(t.positions, key)
+		t.closed[key] = time
+		closed := t.view(current)
+		closed.Amount, closed.UpdateTime = 0, time
+		return closed, true
+	}
+	next := &Position{
+		Symbol:         u.Symbol,
+		PositionSide:   u.PositionSide,
+		MarginType:     u.MarginType,
+		Amount:         u.Amount,
+		EntryPrice:     u.EntryPrice,
+		IsolatedWallet: u.IsolatedWallet,
+		UpdateTime:     time,
+	}
+	if found && *current == *next {
+		return Position{}, false
+	}
+	t.positions[key] = next
+	return t.view(next), true
+}
+
+// view computes the derived fields of p from the latest mark price, leverage
+// and wallet balances.
+func (t *PositionTracker) view(p *Position) Position {
+	res := *p
+	info := t.symbols[p.Symbol]
+	res.MarginAsset, res.ContractSize = info.marginAsset, info.contractSize
+	res.Leverage = t.leverage[p.Symbol]
+	res.MarkPrice = t.marks[p.Symbol]
+	if res.MarkPrice <= 0 {
+		return res
+	}
+
+	if t.inverse {
+		if res.ContractSize <= 0 || res.EntryPrice <= 0 {
+			return res
+		}
+		res.Notional = res.Amount * res.ContractSize / res.MarkPrice
+		res.UnrealizedPnL = res.Amount * res.ContractSize * (1/res.EntryPrice - 1/res.MarkPrice)
+	} else {
+		res.Notional = res.Amount * res.MarkPrice
+		res.UnrealizedPnL = res.Amount * (res.MarkPrice - res.EntryPrice)
+	}
+
+	notional := math.Abs(res.Notional)
+	if res.Leverage > 0 {
+		res.InitialMargin = notional / res.Leverage
+		res.ROE = res.UnrealizedPnL / res.InitialMargin
+	}
+	margin := t.wallets[res.MarginAsset]
+	if res.IsIsolated() {
+		margin = res.IsolatedWallet + res.UnrealizedPnL
+	}
+	if margin > 0 {
+		res.EffectiveLeverage = notional / margin
+	}
+	return res
+}
+
+func (t *PositionTracker) notify(positions ...Position) {
+	if t.config.OnChange == nil {
+		return
+	}
+	for _, p := range positions {
+		t.config.OnChange(p)
+	}
+}
+
+// Reconcile replaces the tracked positions and balances with the ones of the
+// REST API, symbol details are loaded the first time. Call it to bootstrap
+// the tracker and after the user data stream reconnects. The snapshot is dated
+// with the server time the request is sent, see TimeOffset, positions opened,
+// changed or closed by the stream after that time are kept.
+func (t *PositionTracker) Reconcile(ctx context.Context) error {
+	t.lock.Lock()
+	loaded := len(t.symbols) > 0
+	t.lock.Unlock()
+	if !loaded {
+		symbols, err := t.source.symbols(ctx)
+		if err != nil {
+			return err
+		}
+		t.lock.Lock()
+		t.symbols = symbols
+		t.lock.Unlock()
+	}
+
+	wallets, err := t.source.wallets(ctx)
+	if err != nil {
+		return err
+	}
+	sent := t.config.Now().UnixNano() / int64(time.Millisecond)
+	if t.config.TimeOffset != nil {
+		sent -= t.config.TimeOffset()
+	}
+	positions, err := t.source.positions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var changed []Position
+	t.lock.Lock()
+	t.wallets = wallets
+	listed := make(map[positionKey]bool, len(positions))
+	for _, u := range positions {
+		if u.PositionSide == "" {
+			u.PositionSide = PositionSideBoth
+		}
+		key := positionKey{symbol: u.Symbol, positionSide: u.PositionSide}
+		if u.Amount != 0 {
+			listed[key] = true
+		}
+		// the stream closed the position after the request was sent.
+		if t.closed[key] > sent {
+			continue
+		}
+		if p, ok := t.apply(sent, u); ok {
+			changed = append(changed, p)
+		}
+	}
+	for key, p := range t.positions {
+		if !listed[key] && p.UpdateTime <= sent {
+			delete(t.positions, key)
+			closed := t.view(p)
+			closed.Amount, closed.UpdateTime = 0, sent
+			changed = append(changed, closed)
+		}
+	}
+	// later snapshots are sent after every close seen so far.
+	t.closed = make(map[positionKey]int64)
+	t.lock.Unlock()
+	t.notify(changed...)
+	return nil
+}
+
+// Run reconciles once, then every ReconcileInterval and whenever a value is
+// received from reconnected, until ctx is done. Errors are passed to OnError.
+func (t *PositionTracker) Run(ctx context.Context, reconnected <-chan struct{}) {
+	ticker := time.NewTicker(t.config.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		if err := t.Reconcile(ctx); err != nil && t.config.OnError != nil && ctx.Err() == nil {
+			t.config.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-reconnected:
+		}
+	}
+}
+
+// Position returns a position, positionSide is BOTH in one-way mode.
+func (t *PositionTracker) Position(symbol, positionSide string) (Position, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	p, ok := t.positions[positionKey{symbol: symbol, positionSide: positionSide}]
+	if !ok {
+		return Position{}, false
+	}
+	return t.view(p), true
+}
+
+// Positions returns the open positions of symbol, or of all symbols when empty.
+func (t *PositionTracker) Positions(symbol string) (res []Position) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key, p := range t.positions {
+		if symbol == "" || key.symbol == symbol {
+			res = append(res, t.view(p))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].PositionSide < res[j].PositionSide
+	})
+	return res
+}
+
+// UnrealizedPnL returns the sum of the unrealized PnL of the open positions
+// by margin asset.
+func (t *PositionTracker) UnrealizedPnL() map[string]float64 {
+	res := make(map[string]float64)
+	for _, p := range t.Positions("") {
+		res[p.MarginAsset] += p.UnrealizedPnL
+	}
+	return res
+}
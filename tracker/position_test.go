@@ -0,0 +1,194 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crypto-zero/go-binance/v2/delivery"
+	"github.com/crypto-zero/go-binance/v2/futures"
+	"github.com/crypto-zero/go-binance/v2/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type positionTrackerTestSuite struct {
+	suite.Suite
+	api     *mocks.FuturesClient
+	tracker *PositionTracker
+	changes []Position
+}
+
+func TestPositionTracker(t *testing.T) {
+	suite.Run(t, new(positionTrackerTestSuite))
+}
+
+func (s *positionTrackerTestSuite) SetupTest() {
+	s.changes = nil
+	s.api = new(mocks.FuturesClient)
+	s.api.On("ExchangeInfo", mock.Anything).Return(&futures.ExchangeInfo{
+		Symbols: []futures.Symbol{{Symbol: "BTCUSDT", MarginAsset: "USDT"}},
+	}, nil).Once()
+	s.tracker = NewFuturesPositionTracker(s.api, PositionTrackerConfig{
+		OnChange: func(p Position) {
+			s.changes = append(s.changes, p)
+		},
+	})
+}
+
+func accountUpdate(time int64, balances []futures.WsBalance, positions ...futures.WsPosition) *futures.WsUserDataEvent {
+	return &futures.WsUserDataEvent{
+		Event:           futures.UserDataEventTypeAccountUpdate,
+		TransactionTime: time,
+		AccountUpdate: futures.WsAccountUpdate{
+			Reason:    futures.UserDataEventReasonTypeOrder,
+			Balances:  balances,
+			Positions: positions,
+		},
+	}
+}
+
+// mockReconcile expects a reconciliation, symbols are only loaded by the first one.
+func (s *positionTrackerTestSuite) mockReconcile(positions ...*futures.PositionRisk) {
+	s.api.On("GetBalance", mock.Anything).Return([]*futures.Balance{
+		{Asset: "USDT", CrossWalletBalance: "1000"},
+	}, nil).Once()
+	s.api.On("GetPositionRisk", mock.Anything, "").Return(positions, nil).Once()
+}
+
+func (s *positionTrackerTestSuite) TestOneWay() {
+	s.mockReconcile(&futures.PositionRisk{
+		Symbol: "BTCUSDT", PositionSide: "BOTH", PositionAmt: "0", Leverage: "10", MarginType: "cross",
+	})
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+	s.Empty(s.tracker.Positions(""))
+
+	s.True(s.tracker.UpdateFutures(accountUpdate(10,
+		[]futures.WsBalance{{Asset: "USDT", Balance: "1000", CrossWalletBalance: "500"}},
+		futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "0.5",
+			EntryPrice: "20000", MarginType: "cross"})))
+	s.tracker.UpdateFuturesMarkPrice(&futures.WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "21000"})
+
+	p, ok := s.tracker.Position("BTCUSDT", PositionSideBoth)
+	s.Require().True(ok)
+	s.Equal("USDT", p.MarginAsset)
+	s.InDelta(500, p.UnrealizedPnL, 1e-9)
+	s.InDelta(10500, p.Notional, 1e-9)
+	s.InDelta(1050, p.InitialMargin, 1e-9)
+	s.InDelta(500.0/1050, p.ROE, 1e-9)
+	s.InDelta(21, p.EffectiveLeverage, 1e-9)
+	s.InDelta(500, s.tracker.UnrealizedPnL()["USDT"], 1e-9)
+
+	// stale events are ignored.
+	s.False(s.tracker.UpdateFutures(accountUpdate(5, nil,
+		futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "1"})))
+
+	s.True(s.tracker.UpdateFutures(&futures.WsUserDataEvent{
+		Event:               futures.UserDataEventTypeAccountConfigUpdate,
+		AccountConfigUpdate: futures.WsAccountConfigUpdate{Symbol: "BTCUSDT", Leverage: 20},
+	}))
+	p, _ = s.tracker.Position("BTCUSDT", PositionSideBoth)
+	s.InDelta(525, p.InitialMargin, 1e-9)
+
+	s.True(s.tracker.UpdateFutures(accountUpdate(11, nil,
+		futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "0"})))
+	s.Empty(s.tracker.Positions(""))
+	s.Require().Len(s.changes, 3)
+	s.Equal(0.0, s.changes[2].Amount)
+}
+
+func (s *positionTrackerTestSuite) TestHedge() {
+	s.mockReconcile(
+		&futures.PositionRisk{Symbol: "BTCUSDT", PositionSide: "LONG", PositionAmt: "1", EntryPrice: "100",
+			MarkPrice: "110", Leverage: "5", MarginType: "isolated", IsolatedWallet: "20"},
+		&futures.PositionRisk{Symbol: "BTCUSDT", PositionSide: "SHORT", PositionAmt: "-2", EntryPrice: "120",
+			MarkPrice: "110", Leverage: "5", MarginType: "isolated", IsolatedWallet: "48"},
+	)
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+
+	positions := s.tracker.Positions("BTCUSDT")
+	s.Require().Len(positions, 2)
+	long, short := positions[0], positions[1]
+	s.Equal(PositionSideLong, long.PositionSide)
+	s.InDelta(10, long.UnrealizedPnL, 1e-9)
+	s.InDelta(110.0/30, long.EffectiveLeverage, 1e-9)
+	s.Equal(PositionSideShort, short.PositionSide)
+	s.InDelta(20, short.UnrealizedPnL, 1e-9)
+	s.InDelta(-220, short.Notional, 1e-9)
+	s.InDelta(20/44.0, short.ROE, 1e-9)
+	s.Len(s.changes, 2)
+
+	// a position missing from the snapshot was closed while the stream was down.
+	s.mockReconcile(&futures.PositionRisk{Symbol: "BTCUSDT", PositionSide: "LONG", PositionAmt: "1",
+		EntryPrice: "100", Leverage: "5", MarginType: "isolated", IsolatedWallet: "20"})
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+	s.Len(s.tracker.Positions(""), 1)
+	s.Require().Len(s.changes, 3)
+	s.Equal(PositionSideShort, s.changes[2].PositionSide)
+}
+
+func (s *positionTrackerTestSuite) TestReconcileInterleaved() {
+	s.tracker = NewFuturesPositionTracker(s.api, PositionTrackerConfig{
+		Now:        func() time.Time { return time.UnixMilli(2100) },
+		TimeOffset: func() int64 { return 2000 },
+	})
+	s.True(s.tracker.UpdateFutures(accountUpdate(50, nil,
+		futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeLong, Amount: "1", EntryPrice: "100"},
+		futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeShort, Amount: "-1", EntryPrice: "100"})))
+
+	// the stream moves on while the snapshot, sent at 100, is in flight.
+	s.api.On("GetBalance", mock.Anything).Return([]*futures.Balance{
+		{Asset: "USDT", CrossWalletBalance: "1000"},
+	}, nil).Once()
+	s.api.On("GetPositionRisk", mock.Anything, "").Run(func(mock.Arguments) {
+		s.True(s.tracker.UpdateFutures(accountUpdate(150, nil,
+			futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeLong, Amount: "2", EntryPrice: "105"},
+			futures.WsPosition{Symbol: "BTCUSDT", Side: futures.PositionSideTypeShort, Amount: "0"},
+			futures.WsPosition{Symbol: "ETHUSDT", Side: futures.PositionSideTypeBoth, Amount: "3", EntryPrice: "10"})))
+	}).Return([]*futures.PositionRisk{
+		{Symbol: "BTCUSDT", PositionSide: "LONG", PositionAmt: "1", EntryPrice: "100"},
+		{Symbol: "BTCUSDT", PositionSide: "SHORT", PositionAmt: "-1", EntryPrice: "100"},
+	}, nil).Once()
+	defer s.api.AssertExpectations(s.T())
+	s.Require().NoError(s.tracker.Reconcile(context.Background()))
+
+	long, ok := s.tracker.Position("BTCUSDT", PositionSideLong)
+	s.Require().True(ok)
+	s.Equal(2.0, long.Amount)
+	s.Equal(int64(150), long.UpdateTime)
+	_, ok = s.tracker.Position("BTCUSDT", PositionSideShort)
+	s.False(ok)
+	eth, ok := s.tracker.Position("ETHUSDT", PositionSideBoth)
+	s.Require().True(ok)
+	s.Equal(3.0, eth.Amount)
+}
+
+func (s *positionTrackerTestSuite) TestDelivery() {
+	api := new(mocks.DeliveryClient)
+	api.On("ExchangeInfo", mock.Anything).Return(&delivery.ExchangeInfo{
+		Symbols: []delivery.Symbol{{Symbol: "BTCUSD_PERP", MarginAsset: "BTC", ContractSize: 100}},
+	}, nil)
+	api.On("GetBalance", mock.Anything).Return([]*delivery.Balance{
+		{Asset: "BTC", CrossWalletBalance: "1"},
+	}, nil)
+	api.On("GetPositionRisk", mock.Anything, "").Return([]*delivery.PositionRisk{
+		{Symbol: "BTCUSD_PERP", PositionSide: "BOTH", PositionAmt: "10", EntryPrice: "20000",
+			Leverage: "20", MarginType: "cross"},
+	}, nil)
+	defer api.AssertExpectations(s.T())
+
+	tracker := NewDeliveryPositionTracker(api, PositionTrackerConfig{})
+	s.Require().NoError(tracker.Reconcile(context.Background()))
+	tracker.UpdateDeliveryMarkPrice(&delivery.WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "25000"})
+
+	p, ok := tracker.Position("BTCUSD_PERP", PositionSideBoth)
+	s.Require().True(ok)
+	s.InDelta(100, p.ContractSize, 1e-9)
+	s.InDelta(0.04, p.Notional, 1e-12)
+	s.InDelta(1000*(1/20000.0-1/25000.0), p.UnrealizedPnL, 1e-12)
+	s.InDelta(0.002, p.InitialMargin, 1e-12)
+	s.InDelta(0.04, p.EffectiveLeverage, 1e-12)
+}
@@ -0,0 +1,172 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// UniversalTransferService transfer asset between the accounts of the user
+type UniversalTransferService struct {
+	c            *Client
+	transferType UniversalTransferType
+	asset        string
+	amount       string
+	fromSymbol   *string
+	toSymbol     *string
+}
+
+// Type set the direction of the transfer
+func (s *UniversalTransferService) Type(transferType UniversalTransferType) *UniversalTransferService {
+	s.transferType = transferType
+	return s
+}
+
+// Asset set asset being transferred, e.g., BTC
+func (s *UniversalTransferService) Asset(asset string) *UniversalTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount the amount to be transferred
+func (s *UniversalTransferService) Amount(amount string) *UniversalTransferService {
+	s.amount = amount
+	return s
+}
+
+// FromSymbol set the isolated margin symbol transferred from, mandatory for
+// ISOLATEDMARGIN_MARGIN and ISOLATEDMARGIN_ISOLATEDMARGIN
+func (s *UniversalTransferService) FromSymbol(fromSymbol string) *UniversalTransferService {
+	s.fromSymbol = &fromSymbol
+	return s
+}
+
+// ToSymbol set the isolated margin symbol transferred to, mandatory for
+// MARGIN_ISOLATEDMARGIN and ISOLATEDMARGIN_ISOLATEDMARGIN
+func (s *UniversalTransferService) ToSymbol(toSymbol string) *UniversalTransferService {
+	s.toSymbol = &toSymbol
+	return s
+}
+
+// Do send Request
+func (s *UniversalTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *TransactionResponse, err error) {
+	r := common.NewPostRequestSigned("/sapi/v1/asset/transfer")
+	m := common.Params{
+		"type":   s.transferType,
+		"asset":  s.asset,
+		"amount": s.amount,
+	}
+	if s.fromSymbol != nil {
+		m["fromSymbol"] = *s.fromSymbol
+	}
+	if s.toSymbol != nil {
+		m["toSymbol"] = *s.toSymbol
+	}
+	r.SetFormParams(m)
+
+	res = new(TransactionResponse)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListUniversalTransferService list the universal transfers of one direction
+type ListUniversalTransferService struct {
+	c            *Client
+	transferType UniversalTransferType
+	startTime    *int64
+	endTime      *int64
+	current      *int64
+	size         *int64
+	fromSymbol   *string
+	toSymbol     *string
+}
+
+// Type set the direction of the transfers
+func (s *ListUniversalTransferService) Type(transferType UniversalTransferType) *ListUniversalTransferService {
+	s.transferType = transferType
+	return s
+}
+
+// StartTime set start time
+func (s *ListUniversalTransferService) StartTime(startTime int64) *ListUniversalTransferService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set end time
+func (s *ListUniversalTransferService) EndTime(endTime int64) *ListUniversalTransferService {
+	s.endTime = &endTime
+	return s
+}
+
+// Current currently querying page. Start from 1. Default:1
+func (s *ListUniversalTransferService) Current(current int64) *ListUniversalTransferService {
+	s.current = &current
+	return s
+}
+
+// Size default:10 max:100
+func (s *ListUniversalTransferService) Size(size int64) *ListUniversalTransferService {
+	s.size = &size
+	return s
+}
+
+// FromSymbol set the isolated margin symbol transferred from
+func (s *ListUniversalTransferService) FromSymbol(fromSymbol string) *ListUniversalTransferService {
+	s.fromSymbol = &fromSymbol
+	return s
+}
+
+// ToSymbol set the isolated margin symbol transferred to
+func (s *ListUniversalTransferService) ToSymbol(toSymbol string) *ListUniversalTransferService {
+	s.toSymbol = &toSymbol
+	return s
+}
+
+// Do send Request
+func (s *ListUniversalTransferService) Do(ctx context.Context, opts ...common.RequestOption) (res *UniversalTransferHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/asset/transfer")
+	r.SetQuery("type", s.transferType)
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.current != nil {
+		r.SetQuery("current", *s.current)
+	}
+	if s.size != nil {
+		r.SetQuery("size", *s.size)
+	}
+	if s.fromSymbol != nil {
+		r.SetQuery("fromSymbol", *s.fromSymbol)
+	}
+	if s.toSymbol != nil {
+		r.SetQuery("toSymbol", *s.toSymbol)
+	}
+
+	res = new(UniversalTransferHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UniversalTransferHistory define universal transfer history
+type UniversalTransferHistory struct {
+	Total int64               `json:"total"`
+	Rows  []UniversalTransfer `json:"rows"`
+}
+
+// UniversalTransfer define universal transfer history item
+type UniversalTransfer struct {
+	Asset     string                      `json:"asset"`
+	Amount    string                      `json:"amount"`
+	Type      UniversalTransferType       `json:"type"`
+	Status    UniversalTransferStatusType `json:"status"`
+	TranID    int64                       `json:"tranId"`
+	Timestamp int64                       `json:"timestamp"`
+}
@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type universalTransferServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestUniversalTransferService(t *testing.T) {
+	suite.Run(t, new(universalTransferServiceTestSuite))
+}
+
+func (s *universalTransferServiceTestSuite) TestTransfer() {
+	data := []byte(`{
+		"tranId": 13526853623
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"type":     UniversalTransferTypeMarginIsolatedMargin,
+			"asset":    "USDT",
+			"amount":   "100",
+			"toSymbol": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewUniversalTransferService().Type(UniversalTransferTypeMarginIsolatedMargin).
+		Asset("USDT").Amount("100").ToSymbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&TransactionResponse{TranID: 13526853623}, res)
+}
+
+func (s *universalTransferServiceTestSuite) TestFuturesTransfer() {
+	data := []byte(`{
+		"tranId": 13526853624
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"type":   UniversalTransferTypeCMFutureMain,
+			"asset":  "BTC",
+			"amount": "0.1",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewFuturesTransferService().Type(FuturesTransferTypeFuturesMToSpot).
+		Asset("BTC").Amount("0.1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(13526853624), res.TranID)
+}
+
+func (s *universalTransferServiceTestSuite) TestUnknownTransferType() {
+	_, err := s.client.NewFuturesTransferService().Type(FuturesTransferType(9)).
+		Asset("BTC").Amount("0.1").Do(newContext())
+	s.r().EqualError(err, "unknown futures transfer type 9")
+	_, err = s.client.NewMarginTransferService().Type(MarginTransferType(0)).
+		Asset("BTC").Amount("0.1").Do(newContext())
+	s.r().EqualError(err, "unknown margin transfer type 0")
+}
+
+func (s *universalTransferServiceTestSuite) TestListUniversalTransfer() {
+	data := []byte(`{
+		"total": 2,
+		"rows": [
+			{
+				"asset": "USDT",
+				"amount": "1",
+				"type": "MAIN_UMFUTURE",
+				"status": "CONFIRMED",
+				"tranId": 11415955596,
+				"timestamp": 1544433328000
+			},
+			{
+				"asset": "USDT",
+				"amount": "2",
+				"type": "MAIN_UMFUTURE",
+				"status": "CONFIRMED",
+				"tranId": 11366865406,
+				"timestamp": 1544433328000
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"type":      UniversalTransferTypeMainUMFuture,
+			"startTime": 1544433300000,
+			"current":   1,
+			"size":      2,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListUniversalTransferService().Type(UniversalTransferTypeMainUMFuture).
+		StartTime(1544433300000).Current(1).Size(2).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(2), res.Total)
+	s.r().Len(res.Rows, 2)
+	s.r().Equal(UniversalTransfer{
+		Asset:     "USDT",
+		Amount:    "1",
+		Type:      UniversalTransferTypeMainUMFuture,
+		Status:    UniversalTransferStatusTypeConfirmed,
+		TranID:    11415955596,
+		Timestamp: 1544433328000,
+	}, res.Rows[0])
+}
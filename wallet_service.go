@@ -0,0 +1,298 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+)
+
+// GetAllCoinsInfoService fetches the information of all coins, including
+// their deposit and withdraw networks.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#all-coins-39-information-user_data
+type GetAllCoinsInfoService struct {
+	c *Client
+}
+
+// Do send the Request.
+func (s *GetAllCoinsInfoService) Do(ctx context.Context, opts ...common.RequestOption) (res []*CoinInfo, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/capital/config/getall")
+
+	res = make([]*CoinInfo, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CoinInfo represents the information of a coin
+type CoinInfo struct {
+	Coin              string         `json:"coin"`
+	DepositAllEnable  bool           `json:"depositAllEnable"`
+	Free              string         `json:"free"`
+	Freeze            string         `json:"freeze"`
+	Ipoable           string         `json:"ipoable"`
+	Ipoing            string         `json:"ipoing"`
+	IsLegalMoney      bool           `json:"isLegalMoney"`
+	Locked            string         `json:"locked"`
+	Name              string         `json:"name"`
+	NetworkList       []*CoinNetwork `json:"networkList"`
+	Storage           string         `json:"storage"`
+	Trading           bool           `json:"trading"`
+	WithdrawAllEnable bool           `json:"withdrawAllEnable"`
+	Withdrawing       string         `json:"withdrawing"`
+}
+
+// Network returns the network of the coin with the given name, nil when the
+// coin cannot be moved on it.
+func (c *CoinInfo) Network(network string) *CoinNetwork {
+	for _, n := range c.NetworkList {
+		if n.Network == network {
+			return n
+		}
+	}
+	return nil
+}
+
+// DefaultNetwork returns the default network of the coin, nil when it has none.
+func (c *CoinInfo) DefaultNetwork() *CoinNetwork {
+	for _, n := range c.NetworkList {
+		if n.IsDefault {
+			return n
+		}
+	}
+	return nil
+}
+
+// CoinNetwork represents a network a coin is deposited and withdrawn on
+type CoinNetwork struct {
+	AddressRegex            string `json:"addressRegex"`
+	Coin                    string `json:"coin"`
+	DepositDesc             string `json:"depositDesc"`
+	DepositEnable           bool   `json:"depositEnable"`
+	IsDefault               bool   `json:"isDefault"`
+	MemoRegex               string `json:"memoRegex"`
+	MinConfirm              int    `json:"minConfirm"`
+	Name                    string `json:"name"`
+	Network                 string `json:"network"`
+	SpecialTips             string `json:"specialTips"`
+	UnLockConfirm           int    `json:"unLockConfirm"`
+	WithdrawDesc            string `json:"withdrawDesc"`
+	WithdrawEnable          bool   `json:"withdrawEnable"`
+	WithdrawFee             string `json:"withdrawFee"`
+	WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
+	WithdrawMax             string `json:"withdrawMax"`
+	WithdrawMin             string `json:"withdrawMin"`
+	SameAddress             bool   `json:"sameAddress"`
+	EstimatedArrivalTime    int64  `json:"estimatedArrivalTime"`
+	Busy                    bool   `json:"busy"`
+	ContractAddressURL      string `json:"contractAddressUrl"`
+	ContractAddress         string `json:"contractAddress"`
+}
+
+// GetTradeFeeService fetches the trade fees of the account.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#trade-fee-user_data
+type GetTradeFeeService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol sets the symbol parameter, the fees of all symbols are fetched when unset.
+func (s *GetTradeFeeService) Symbol(symbol string) *GetTradeFeeService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send the Request.
+func (s *GetTradeFeeService) Do(ctx context.Context, opts ...common.RequestOption) (res []*TradeFee, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/asset/tradeFee")
+	if s.symbol != nil {
+		r.SetQuery("symbol", *s.symbol)
+	}
+
+	res = make([]*TradeFee, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TradeFee represents the trade fees of a symbol
+type TradeFee struct {
+	Symbol          string `json:"symbol"`
+	MakerCommission string `json:"makerCommission"`
+	TakerCommission string `json:"takerCommission"`
+}
+
+// GetSystemStatusService fetches the system status.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#system-status-system
+type GetSystemStatusService struct {
+	c *Client
+}
+
+// Do send the Request.
+func (s *GetSystemStatusService) Do(ctx context.Context, opts ...common.RequestOption) (res *SystemStatus, err error) {
+	r := common.NewGetRequestPublic("/sapi/v1/system/status")
+
+	res = new(SystemStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SystemStatus represents the system status, Status is 0 when normal and 1
+// during a maintenance
+type SystemStatus struct {
+	Status int    `json:"status"`
+	Msg    string `json:"msg"`
+}
+
+// GetAccountStatusService fetches the account status.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#account-status-user_data
+type GetAccountStatusService struct {
+	c *Client
+}
+
+// Do send the Request.
+func (s *GetAccountStatusService) Do(ctx context.Context, opts ...common.RequestOption) (res *AccountStatus, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/account/status")
+
+	res = new(AccountStatus)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountStatus represents the account status, Data is "Normal" when the
+// account can trade
+type AccountStatus struct {
+	Data string `json:"data"`
+}
+
+// GetUserAssetService fetches the non-empty balances of the spot account.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#user-asset-user_data
+type GetUserAssetService struct {
+	c                *Client
+	asset            *string
+	needBtcValuation *bool
+}
+
+// Asset sets the asset parameter.
+func (s *GetUserAssetService) Asset(asset string) *GetUserAssetService {
+	s.asset = &asset
+	return s
+}
+
+// NeedBTCValuation sets the needBtcValuation parameter.
+func (s *GetUserAssetService) NeedBTCValuation(needBtcValuation bool) *GetUserAssetService {
+	s.needBtcValuation = &needBtcValuation
+	return s
+}
+
+// Do send the Request.
+func (s *GetUserAssetService) Do(ctx context.Context, opts ...common.RequestOption) (res []*UserAssetBalance, err error) {
+	r := common.NewPostRequestSigned("/sapi/v3/asset/getUserAsset")
+	if s.asset != nil {
+		r.SetForm("asset", *s.asset)
+	}
+	if s.needBtcValuation != nil {
+		r.SetForm("needBtcValuation", *s.needBtcValuation)
+	}
+
+	res = make([]*UserAssetBalance, 0)
+	if err = s.c.CallAPI(ctx, r, &res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UserAssetBalance represents the balance of an asset of the spot account
+type UserAssetBalance struct {
+	Asset        string `json:"asset"`
+	Free         string `json:"free"`
+	Locked       string `json:"locked"`
+	Freeze       string `json:"freeze"`
+	Withdrawing  string `json:"withdrawing"`
+	Ipoable      string `json:"ipoable"`
+	BTCValuation string `json:"btcValuation"`
+}
+
+// ListAssetDividendService fetches the asset dividend records.
+//
+// See https://binance-docs.github.io/apidocs/spot/en/#asset-dividend-record-user_data
+type ListAssetDividendService struct {
+	c         *Client
+	asset     *string
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// Asset sets the asset parameter.
+func (s *ListAssetDividendService) Asset(asset string) *ListAssetDividendService {
+	s.asset = &asset
+	return s
+}
+
+// StartTime sets the startTime parameter.
+func (s *ListAssetDividendService) StartTime(startTime int64) *ListAssetDividendService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime sets the endTime parameter.
+func (s *ListAssetDividendService) EndTime(endTime int64) *ListAssetDividendService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit sets the limit parameter, default:20 max:500.
+func (s *ListAssetDividendService) Limit(limit int) *ListAssetDividendService {
+	s.limit = &limit
+	return s
+}
+
+// Do send the Request.
+func (s *ListAssetDividendService) Do(ctx context.Context, opts ...common.RequestOption) (res *AssetDividendHistory, err error) {
+	r := common.NewGetRequestSigned("/sapi/v1/asset/assetDividend")
+	if s.asset != nil {
+		r.SetQuery("asset", *s.asset)
+	}
+	if s.startTime != nil {
+		r.SetQuery("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.SetQuery("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.SetQuery("limit", *s.limit)
+	}
+
+	res = new(AssetDividendHistory)
+	if err = s.c.CallAPI(ctx, r, res, opts...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AssetDividendHistory represents the asset dividend records
+type AssetDividendHistory struct {
+	Rows  []*AssetDividend `json:"rows"`
+	Total int64            `json:"total"`
+}
+
+// AssetDividend represents an asset dividend record
+type AssetDividend struct {
+	ID      int64  `json:"id"`
+	Amount  string `json:"amount"`
+	Asset   string `json:"asset"`
+	DivTime int64  `json:"divTime"`
+	EnInfo  string `json:"enInfo"`
+	TranID  int64  `json:"tranId"`
+}
@@ -0,0 +1,220 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/crypto-zero/go-binance/v2/common"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type walletServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestWalletService(t *testing.T) {
+	suite.Run(t, new(walletServiceTestSuite))
+}
+
+func (s *walletServiceTestSuite) TestGetAllCoinsInfo() {
+	data := []byte(`[
+		{
+			"coin": "BTC",
+			"depositAllEnable": true,
+			"free": "0.08074558",
+			"freeze": "0.00000000",
+			"ipoable": "0.00000000",
+			"ipoing": "0.00000000",
+			"isLegalMoney": false,
+			"locked": "0.00000000",
+			"name": "Bitcoin",
+			"networkList": [
+				{
+					"addressRegex": "^(bnb1)[0-9a-z]{38}$",
+					"coin": "BTC",
+					"depositEnable": true,
+					"isDefault": false,
+					"minConfirm": 1,
+					"name": "BEP2",
+					"network": "BNB",
+					"unLockConfirm": 0,
+					"withdrawEnable": true,
+					"withdrawFee": "0.00000220",
+					"withdrawIntegerMultiple": "0.00000001",
+					"withdrawMax": "9999999999.99999999",
+					"withdrawMin": "0.00000440",
+					"sameAddress": true,
+					"estimatedArrivalTime": 25,
+					"busy": false
+				},
+				{
+					"coin": "BTC",
+					"depositEnable": true,
+					"isDefault": true,
+					"minConfirm": 1,
+					"name": "BTC",
+					"network": "BTC",
+					"unLockConfirm": 2,
+					"withdrawEnable": true,
+					"withdrawFee": "0.00050000",
+					"withdrawMin": "0.00100000"
+				}
+			],
+			"storage": "0.00000000",
+			"trading": true,
+			"withdrawAllEnable": true,
+			"withdrawing": "0.00000000"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAllCoinsInfoService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("Bitcoin", res[0].Name)
+	s.r().True(res[0].DepositAllEnable)
+	s.r().Len(res[0].NetworkList, 2)
+	s.r().Equal(&CoinNetwork{
+		AddressRegex:            "^(bnb1)[0-9a-z]{38}$",
+		Coin:                    "BTC",
+		DepositEnable:           true,
+		MinConfirm:              1,
+		Name:                    "BEP2",
+		Network:                 "BNB",
+		WithdrawEnable:          true,
+		WithdrawFee:             "0.00000220",
+		WithdrawIntegerMultiple: "0.00000001",
+		WithdrawMax:             "9999999999.99999999",
+		WithdrawMin:             "0.00000440",
+		SameAddress:             true,
+		EstimatedArrivalTime:    25,
+	}, res[0].Network("BNB"))
+	s.r().Equal("0.00050000", res[0].DefaultNetwork().WithdrawFee)
+	s.r().Nil(res[0].Network("ETH"))
+}
+
+func (s *walletServiceTestSuite) TestGetTradeFee() {
+	data := []byte(`[
+		{"symbol": "ADABNB", "makerCommission": "0.001", "takerCommission": "0.001"}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQuery("symbol", "ADABNB")
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetTradeFeeService().Symbol("ADABNB").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*TradeFee{{Symbol: "ADABNB", MakerCommission: "0.001", TakerCommission: "0.001"}}, res)
+}
+
+func (s *walletServiceTestSuite) TestGetSystemStatus() {
+	data := []byte(`{"status": 1, "msg": "system maintenance"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetSystemStatusService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&SystemStatus{Status: 1, Msg: "system maintenance"}, res)
+}
+
+func (s *walletServiceTestSuite) TestGetAccountStatus() {
+	data := []byte(`{"data": "Normal"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetAccountStatusService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AccountStatus{Data: "Normal"}, res)
+}
+
+func (s *walletServiceTestSuite) TestGetUserAsset() {
+	data := []byte(`[
+		{
+			"asset": "AVAX",
+			"free": "1",
+			"locked": "0",
+			"freeze": "0",
+			"withdrawing": "0",
+			"ipoable": "0",
+			"btcValuation": "0.0009"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetFormParams(common.Params{
+			"asset":            "AVAX",
+			"needBtcValuation": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetUserAssetService().Asset("AVAX").NeedBTCValuation(true).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]*UserAssetBalance{{
+		Asset:        "AVAX",
+		Free:         "1",
+		Locked:       "0",
+		Freeze:       "0",
+		Withdrawing:  "0",
+		Ipoable:      "0",
+		BTCValuation: "0.0009",
+	}}, res)
+}
+
+func (s *walletServiceTestSuite) TestListAssetDividend() {
+	data := []byte(`{
+		"rows": [
+			{
+				"id": 1637366104,
+				"amount": "10.00000000",
+				"asset": "BHFT",
+				"divTime": 1563189166000,
+				"enInfo": "BHFT distribution",
+				"tranId": 2968885920
+			}
+		],
+		"total": 1
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *common.Request) {
+		e := newSignedRequest().SetQueryParams(common.Params{
+			"asset":     "BHFT",
+			"startTime": 1563189166000,
+			"endTime":   1563189167000,
+			"limit":     20,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListAssetDividendService().Asset("BHFT").
+		StartTime(1563189166000).EndTime(1563189167000).Limit(20).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&AssetDividendHistory{
+		Rows: []*AssetDividend{{
+			ID:      1637366104,
+			Amount:  "10.00000000",
+			Asset:   "BHFT",
+			DivTime: 1563189166000,
+			EnInfo:  "BHFT distribution",
+			TranID:  2968885920,
+		}},
+		Total: 1,
+	}, res)
+}
@@ -397,6 +397,127 @@ func WsUserDataServe(listenKey string, handler WsHandler, errHandler ErrHandler)
 	return wsServe(cfg, handler, errHandler)
 }
 
+// WsExecutionReportEvent define user data stream executionReport event, pushed on every order update
+type WsExecutionReportEvent struct {
+	Event                string             `json:"e"`
+	Time                 int64              `json:"E"`
+	Symbol               string             `json:"s"`
+	ClientOrderID        string             `json:"c"`
+	Side                 SideType           `json:"S"`
+	Type                 OrderType          `json:"o"`
+	TimeInForce          TimeInForceType    `json:"f"`
+	OriginalQty          string             `json:"q"`
+	OriginalPrice        string             `json:"p"`
+	StopPrice            string             `json:"P"`
+	IcebergQty           string             `json:"F"`
+	OrderListID          int64              `json:"g"`
+	OrigClientOrderID    string             `json:"C"`
+	ExecutionType        OrderExecutionType `json:"x"`
+	Status               OrderStatusType    `json:"X"`
+	RejectReason         string             `json:"r"`
+	ID                   int64              `json:"i"`
+	LastFilledQty        string             `json:"l"`
+	AccumulatedFilledQty string             `json:"z"`
+	LastFilledPrice      string             `json:"L"`
+	Commission           string             `json:"n"`
+	CommissionAsset      string             `json:"N"`
+	TransactionTime      int64              `json:"T"`
+	TradeID              int64              `json:"t"`
+	Ignore               int64              `json:"I"` // add this field to avoid case insensitive unmarshaling
+	IsInOrderBook        bool               `json:"w"`
+	IsMaker              bool               `json:"m"`
+	Placeholder          bool               `json:"M"` // add this field to avoid case insensitive unmarshaling
+	CreateTime           int64              `json:"O"`
+	AccumulatedQuoteQty  string             `json:"Z"`
+	LastQuoteQty         string             `json:"Y"`
+	QuoteOrderQty        string             `json:"Q"`
+	// the self-trade prevention fields, the prevented ones are only set on
+	// orders expired by a prevented match
+	SelfTradePreventionMode SelfTradePreventionModeType `json:"V"`
+	PreventedMatchID        int64                       `json:"v"`
+	PreventedQuantity       string                      `json:"A"`
+	LastPreventedQuantity   string                      `json:"B"`
+	TradeGroupID            int64                       `json:"u"`
+	CounterOrderID          int64                       `json:"U"`
+	CounterSymbol           string                      `json:"Cs"`
+}
+
+// WsOutboundAccountPositionEvent define user data stream outboundAccountPosition
+// event, pushed with the balances changed by an account update
+type WsOutboundAccountPositionEvent struct {
+	Event          string             `json:"e"`
+	Time           int64              `json:"E"`
+	LastUpdateTime int64              `json:"u"`
+	Balances       []WsAccountBalance `json:"B"`
+}
+
+// WsAccountBalance define balance of outboundAccountPosition event
+type WsAccountBalance struct {
+	Asset  string `json:"a"`
+	Free   string `json:"f"`
+	Locked string `json:"l"`
+}
+
+// WsBalanceUpdateEvent define user data stream balanceUpdate event, pushed on
+// deposits, withdrawals and transfers
+type WsBalanceUpdateEvent struct {
+	Event     string `json:"e"`
+	Time      int64  `json:"E"`
+	Asset     string `json:"a"`
+	Delta     string `json:"d"`
+	ClearTime int64  `json:"T"`
+}
+
+// WsUserDataEvent define user data stream event, only the field matching Event is set
+type WsUserDataEvent struct {
+	Event           UserDataEventType
+	Time            int64
+	AccountPosition *WsOutboundAccountPositionEvent
+	BalanceUpdate   *WsBalanceUpdateEvent
+	ExecutionReport *WsExecutionReportEvent
+}
+
+// UnmarshalJSON decodes the event matching the "e" field, other events only set Event and Time
+func (e *WsUserDataEvent) UnmarshalJSON(data []byte) error {
+	var header struct {
+		Event UserDataEventType `json:"e"`
+		Time  int64             `json:"E"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	*e = WsUserDataEvent{Event: header.Event, Time: header.Time}
+	switch header.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		e.AccountPosition = new(WsOutboundAccountPositionEvent)
+		return json.Unmarshal(data, e.AccountPosition)
+	case UserDataEventTypeBalanceUpdate:
+		e.BalanceUpdate = new(WsBalanceUpdateEvent)
+		return json.Unmarshal(data, e.BalanceUpdate)
+	case UserDataEventTypeExecutionReport:
+		e.ExecutionReport = new(WsExecutionReportEvent)
+		return json.Unmarshal(data, e.ExecutionReport)
+	}
+	return nil
+}
+
+// WsUserDataEventHandler handle WsUserDataEvent
+type WsUserDataEventHandler func(event *WsUserDataEvent)
+
+// WsUserDataEventServe is similar to WsUserDataServe, but it decodes the events.
+// It works with the listen keys of the spot, cross margin and isolated margin streams
+func WsUserDataEventServe(listenKey string, handler WsUserDataEventHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	wsHandler := func(message []byte) {
+		event := new(WsUserDataEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			errHandler(err)
+			return
+		}
+		handler(event)
+	}
+	return WsUserDataServe(listenKey, wsHandler, errHandler)
+}
+
 // WsMarketStatHandler handle websocket that push single market statistics for 24hr
 type WsMarketStatHandler func(event *WsMarketStatEvent)
 
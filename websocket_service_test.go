@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -1181,3 +1182,163 @@ func (s *websocketServiceTestSuite) assertWsBookTickerEvent(e, a *WsBookTickerEv
 	r.Equal(e.BestAskPrice, a.BestAskPrice, "BestAskPrice")
 	r.Equal(e.BestAskQty, a.BestAskQty, "BestAskQty")
 }
+
+func (s *websocketServiceTestSuite) TestWsExecutionReportEvent() {
+	data := []byte(`{
+        "e": "executionReport",
+        "E": 1499405658658,
+        "s": "ETHBTC",
+        "c": "mUvoqJxFIILMdfAW5iGSOW",
+        "S": "BUY",
+        "o": "LIMIT",
+        "f": "GTC",
+        "q": "1.00000000",
+        "p": "0.10264410",
+        "P": "0.00000000",
+        "F": "0.00000000",
+        "g": -1,
+        "C": "",
+        "x": "TRADE",
+        "X": "PARTIALLY_FILLED",
+        "r": "NONE",
+        "i": 4293153,
+        "l": "0.40000000",
+        "z": "0.40000000",
+        "L": "0.10264410",
+        "n": "0.00040000",
+        "N": "ETH",
+        "T": 1499405658657,
+        "t": 101,
+        "I": 8641984,
+        "w": true,
+        "m": false,
+        "M": false,
+        "O": 1499405658657,
+        "Z": "0.04105764",
+        "Y": "0.04105764",
+        "Q": "0.00000000"
+    }`)
+	e := new(WsExecutionReportEvent)
+	s.r().NoError(json.Unmarshal(data, e))
+	s.r().Equal(&WsExecutionReportEvent{
+		Event:                "executionReport",
+		Time:                 1499405658658,
+		Symbol:               "ETHBTC",
+		ClientOrderID:        "mUvoqJxFIILMdfAW5iGSOW",
+		Side:                 SideTypeBuy,
+		Type:                 OrderTypeLimit,
+		TimeInForce:          TimeInForceTypeGTC,
+		OriginalQty:          "1.00000000",
+		OriginalPrice:        "0.10264410",
+		StopPrice:            "0.00000000",
+		IcebergQty:           "0.00000000",
+		OrderListID:          -1,
+		ExecutionType:        OrderExecutionTypeTrade,
+		Status:               OrderStatusTypePartiallyFilled,
+		RejectReason:         "NONE",
+		ID:                   4293153,
+		LastFilledQty:        "0.40000000",
+		AccumulatedFilledQty: "0.40000000",
+		LastFilledPrice:      "0.10264410",
+		Commission:           "0.00040000",
+		CommissionAsset:      "ETH",
+		TransactionTime:      1499405658657,
+		TradeID:              101,
+		Ignore:               8641984,
+		IsInOrderBook:        true,
+		CreateTime:           1499405658657,
+		AccumulatedQuoteQty:  "0.04105764",
+		LastQuoteQty:         "0.04105764",
+		QuoteOrderQty:        "0.00000000",
+	}, e)
+}
+
+func (s *websocketServiceTestSuite) TestWsExecutionReportEventPreventedMatch() {
+	data := []byte(`{
+        "e": "executionReport",
+        "E": 1499405658658,
+        "s": "BTCUSDT",
+        "i": 4293153,
+        "x": "TRADE_PREVENTION",
+        "X": "EXPIRED_IN_MATCH",
+        "z": "0.00000000",
+        "V": "EXPIRE_TAKER",
+        "v": 3,
+        "A": "1.00000000",
+        "B": "1.00000000",
+        "u": 1,
+        "U": 4293150,
+        "Cs": "BTCUSDT"
+    }`)
+	e := new(WsExecutionReportEvent)
+	s.r().NoError(json.Unmarshal(data, e))
+	s.r().Equal(OrderExecutionTypeTradePrevention, e.ExecutionType)
+	s.r().Equal(OrderStatusTypeExpiredInMatch, e.Status)
+	s.r().Equal(SelfTradePreventionModeTypeExpireTaker, e.SelfTradePreventionMode)
+	s.r().Equal(int64(3), e.PreventedMatchID)
+	s.r().Equal("1.00000000", e.PreventedQuantity)
+	s.r().Equal("1.00000000", e.LastPreventedQuantity)
+	s.r().Equal(int64(1), e.TradeGroupID)
+	s.r().Equal(int64(4293150), e.CounterOrderID)
+	s.r().Equal("BTCUSDT", e.CounterSymbol)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataEventServe() {
+	data := []byte(`{
+        "e": "outboundAccountPosition",
+        "E": 1564034571105,
+        "u": 1564034571073,
+        "B": [
+            {
+                "a": "ETH",
+                "f": "10000.000000",
+                "l": "0.000000"
+            }
+        ]
+    }`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsUserDataEventServe("listenKey", func(event *WsUserDataEvent) {
+		s.r().Equal(&WsUserDataEvent{
+			Event: UserDataEventTypeOutboundAccountPosition,
+			Time:  1564034571105,
+			AccountPosition: &WsOutboundAccountPositionEvent{
+				Event:          "outboundAccountPosition",
+				Time:           1564034571105,
+				LastUpdateTime: 1564034571073,
+				Balances:       []WsAccountBalance{{Asset: "ETH", Free: "10000.000000", Locked: "0.000000"}},
+			},
+		}, event)
+	}, func(err error) {
+		s.r().FailNow("unexpected error", err)
+	})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataEvent() {
+	e := new(WsUserDataEvent)
+	s.r().NoError(json.Unmarshal([]byte(`{
+        "e": "balanceUpdate",
+        "E": 1573200697110,
+        "a": "BTC",
+        "d": "100.00000000",
+        "T": 1573200697068
+    }`), e))
+	s.r().Equal(&WsUserDataEvent{
+		Event: UserDataEventTypeBalanceUpdate,
+		Time:  1573200697110,
+		BalanceUpdate: &WsBalanceUpdateEvent{
+			Event:     "balanceUpdate",
+			Time:      1573200697110,
+			Asset:     "BTC",
+			Delta:     "100.00000000",
+			ClearTime: 1573200697068,
+		},
+	}, e)
+
+	s.r().NoError(json.Unmarshal([]byte(`{"e":"listStatus","E":1}`), e))
+	s.r().Equal(&WsUserDataEvent{Event: "listStatus", Time: 1}, e)
+}